@@ -19,6 +19,7 @@ type Config struct {
 	DomainService grpcclient.Config `yaml:"domain_service"`
 	ChatService   grpcclient.Config `yaml:"chat_service"`
 	MLService     grpcclient.Config `yaml:"ml_service"`
+	DataService   grpcclient.Config `yaml:"data_service"`
 }
 
 // New creates new Config.
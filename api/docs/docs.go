@@ -1677,6 +1677,79 @@ const docTemplate = `{
                 }
             }
         },
+        "/api/v1/source/report/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns processing state, error and chunk count of every page and file of the source.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Get source ingestion report.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated states: queued, fetched, parsed, embedded, skipped, failed",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Object type: page or file",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, at most 50",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ingestion report",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetIngestionReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Failed to get ingestion report",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Source not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/source/{id}": {
             "get": {
                 "security": [
@@ -1829,6 +1902,177 @@ const docTemplate = `{
                 }
             }
         },
+        "/api/v1/source/{id}/chunks": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns chunks of the source by ids with neighbouring chunks of the same document, ordered by document and index.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Get document chunks.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated chunk ids, at most 50",
+                        "name": "ids",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Neighbouring chunks before and after each one, at most 5",
+                        "name": "window",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Chunks",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetChunksResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Failed to get chunks",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Source or chunks not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/source/{id}/document/{documentId}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns parsed content and metadata of the document if it belongs to the source.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Get source document.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Document ID",
+                        "name": "documentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Document",
+                        "schema": {
+                            "$ref": "#/definitions/pb.Document"
+                        }
+                    },
+                    "400": {
+                        "description": "Failed to get document",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Source or document not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/source/{id}/document/{documentId}/original": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Streams the uploaded file or the raw html snapshot of the page the document was parsed from in a sandbox.",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "source"
+                ],
+                "summary": "Download original document.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Document ID",
+                        "name": "documentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Original file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Failed to download original",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Source, document or original not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/user/": {
             "post": {
                 "security": [
@@ -2120,6 +2364,81 @@ const docTemplate = `{
                 }
             }
         },
+        "pb.ChunkSizeUnit": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2
+            ],
+            "x-enum-varnames": [
+                "ChunkSizeUnit_UNIT_UNDEFINED",
+                "ChunkSizeUnit_UNIT_CHARS",
+                "ChunkSizeUnit_UNIT_TOKENS"
+            ]
+        },
+        "pb.ChunkingParams": {
+            "type": "object",
+            "properties": {
+                "chunkOverlap": {
+                    "type": "integer"
+                },
+                "chunkSize": {
+                    "type": "integer"
+                },
+                "strategy": {
+                    "$ref": "#/definitions/pb.ChunkingStrategy"
+                },
+                "unit": {
+                    "$ref": "#/definitions/pb.ChunkSizeUnit"
+                }
+            }
+        },
+        "pb.ChunkingStrategy": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2
+            ],
+            "x-enum-varnames": [
+                "ChunkingStrategy_CHUNKING_UNDEFINED",
+                "ChunkingStrategy_CHUNKING_RECURSIVE",
+                "ChunkingStrategy_CHUNKING_MARKDOWN"
+            ]
+        },
+        "pb.Citation": {
+            "type": "object",
+            "properties": {
+                "chunkId": {
+                    "type": "string"
+                },
+                "documentId": {
+                    "type": "string"
+                },
+                "documentName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "responseId": {
+                    "type": "integer"
+                },
+                "similarity": {
+                    "type": "number"
+                },
+                "snippet": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
         "pb.Content": {
             "type": "object",
             "properties": {
@@ -2182,6 +2501,9 @@ const docTemplate = `{
         "pb.CreateSourceRequest": {
             "type": "object",
             "properties": {
+                "chunking": {
+                    "$ref": "#/definitions/pb.ChunkingParams"
+                },
                 "content": {
                     "type": "array",
                     "items": {
@@ -2236,6 +2558,103 @@ const docTemplate = `{
                 }
             }
         },
+        "pb.Document": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "date": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "extension": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "objectId": {
+                    "description": "page or file the document was parsed from",
+                    "type": "string"
+                },
+                "objectType": {
+                    "description": "page or file",
+                    "type": "string"
+                },
+                "sourceId": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "url": {
+                    "description": "page url or file path",
+                    "type": "string"
+                }
+            }
+        },
+        "pb.DocumentChunk": {
+            "type": "object",
+            "properties": {
+                "chunkIds": {
+                    "description": "chunks of an expanded passage in document order",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content": {
+                    "type": "string"
+                },
+                "documentId": {
+                    "type": "string"
+                },
+                "documentName": {
+                    "description": "file name or page title, set in search results",
+                    "type": "string"
+                },
+                "documentUrl": {
+                    "description": "page url or file path inside the source, set in search results",
+                    "type": "string"
+                },
+                "endIndex": {
+                    "description": "index of the last chunk of an expanded passage, equals index otherwise",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "metadata": {
+                    "description": "encoded json<any,any>",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "score": {
+                    "description": "fused rank score in hybrid search",
+                    "type": "number"
+                },
+                "similarity": {
+                    "type": "number"
+                }
+            }
+        },
         "pb.Domain": {
             "type": "object",
             "properties": {
@@ -2265,6 +2684,132 @@ const docTemplate = `{
                 }
             }
         },
+        "pb.GetChunksResponse": {
+            "type": "object",
+            "properties": {
+                "chunks": {
+                    "description": "requested chunks and their neighbours ordered by document and index",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.DocumentChunk"
+                    }
+                },
+                "missingIds": {
+                    "description": "requested ids that were not found",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "pb.GetIngestionReportResponse": {
+            "type": "object",
+            "properties": {
+                "counts": {
+                    "description": "per state, ignoring the state filter",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.IngestionStateCount"
+                    }
+                },
+                "objects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.IngestionObject"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "sourceId": {
+                    "type": "string"
+                },
+                "total": {
+                    "description": "objects matching the filter",
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.IngestionObject": {
+            "type": "object",
+            "properties": {
+                "chunkCount": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                },
+                "error": {
+                    "description": "failure or skip reason",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "page url or file path",
+                    "type": "string"
+                },
+                "state": {
+                    "$ref": "#/definitions/pb.IngestionState"
+                },
+                "type": {
+                    "$ref": "#/definitions/pb.IngestionObjectType"
+                },
+                "updatedAt": {
+                    "$ref": "#/definitions/timestamppb.Timestamp"
+                }
+            }
+        },
+        "pb.IngestionObjectType": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2
+            ],
+            "x-enum-varnames": [
+                "IngestionObjectType_OBJECT_UNDEFINED",
+                "IngestionObjectType_OBJECT_PAGE",
+                "IngestionObjectType_OBJECT_FILE"
+            ]
+        },
+        "pb.IngestionState": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2,
+                3,
+                4,
+                5,
+                6
+            ],
+            "x-enum-varnames": [
+                "IngestionState_INGESTION_UNDEFINED",
+                "IngestionState_INGESTION_QUEUED",
+                "IngestionState_INGESTION_FETCHED",
+                "IngestionState_INGESTION_PARSED",
+                "IngestionState_INGESTION_EMBEDDED",
+                "IngestionState_INGESTION_SKIPPED",
+                "IngestionState_INGESTION_FAILED"
+            ]
+        },
+        "pb.IngestionStateCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "state": {
+                    "$ref": "#/definitions/pb.IngestionState"
+                }
+            }
+        },
         "pb.ListChatsResponse": {
             "type": "object",
             "properties": {
@@ -2454,6 +2999,12 @@ const docTemplate = `{
                 "chatId": {
                     "type": "string"
                 },
+                "citations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.Citation"
+                    }
+                },
                 "content": {
                     "type": "string"
                 },
@@ -33,6 +33,22 @@ var (
 			Msg:    "failed listing available sources",
 			Status: fiber.StatusBadRequest,
 		},
+		shared.ErrGetIngestionReport: {
+			Msg:    "failed getting source ingestion report",
+			Status: fiber.StatusBadRequest,
+		},
+		shared.ErrGetDocument: {
+			Msg:    "failed getting document",
+			Status: fiber.StatusBadRequest,
+		},
+		shared.ErrGetChunks: {
+			Msg:    "failed getting document chunks",
+			Status: fiber.StatusBadRequest,
+		},
+		shared.ErrDownloadOriginal: {
+			Msg:    "failed downloading original document",
+			Status: fiber.StatusBadRequest,
+		},
 		shared.ErrCreateDomain: {
 			Msg:    "failed creating domain",
 			Status: fiber.StatusBadRequest,
@@ -148,6 +164,10 @@ var (
 			Msg:    "source not found",
 			Status: fiber.StatusNotFound,
 		},
+		shared.ErrDocumentNotFound: {
+			Msg:    "document not found",
+			Status: fiber.StatusNotFound,
+		},
 		shared.ErrDomainNotFound: {
 			Msg:    "domain not found",
 			Status: fiber.StatusNotFound,
@@ -93,6 +93,14 @@ func (h *Handler) receiveChunk(stream grpc.ServerStreamingClient[pb.ChunkedRespo
 		return nil, errs.WrapErr(err, "receive next chunk")
 	}
 
+	if citations := chunk.GetCitations(); len(citations) > 0 {
+		log.Debug().Int("count", len(citations)).Msg("got citations")
+		return &model.SocketMessage{
+			Type:      model.TypeCitations,
+			Citations: citations,
+		}, nil
+	}
+
 	msg := model.SocketMessage{
 		Type:      model.TypeChunk,
 		Content:   chunk.GetContent(),
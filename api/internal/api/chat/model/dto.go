@@ -1,5 +1,7 @@
 package model
 
+import "github.com/larek-tech/diploma/api/internal/chat/pb"
+
 // SocketMessageType enum defining type of socket message.
 type SocketMessageType string
 
@@ -10,6 +12,8 @@ const (
 	TypeQuery SocketMessageType = "query"
 	// TypeChunk content is chunked response.
 	TypeChunk SocketMessageType = "chunk"
+	// TypeCitations content is empty, citations contain sources of response.
+	TypeCitations SocketMessageType = "citations"
 	// TypeError content is empty, got error, chat is stopped.
 	TypeError SocketMessageType = "error"
 )
@@ -23,4 +27,5 @@ type SocketMessage struct {
 	DomainID   int64             `json:"domainID"`
 	ScenarioID int64             `json:"scenarioID"`
 	Err        string            `json:"error,omitempty"`
+	Citations  []*pb.Citation    `json:"citations,omitempty"`
 }
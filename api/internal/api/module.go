@@ -17,6 +17,7 @@ import (
 	uh "github.com/larek-tech/diploma/api/internal/api/user/handler"
 	authpb "github.com/larek-tech/diploma/api/internal/auth/pb"
 	chatpb "github.com/larek-tech/diploma/api/internal/chat/pb"
+	datapb "github.com/larek-tech/diploma/api/internal/data/pb"
 	domainpb "github.com/larek-tech/diploma/api/internal/domain/pb"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
@@ -25,12 +26,12 @@ import (
 // SetupRoutes maps api routes.
 func SetupRoutes(
 	api fiber.Router,
-	domainConn, chatConn, authConn, mlConn *grpc.ClientConn,
+	domainConn, chatConn, authConn, mlConn, dataConn *grpc.ClientConn,
 	tracer trace.Tracer,
 	wsConfig websocket.Config,
 ) {
 	sourceRouter := api.Group("/source")
-	sourceHandler := sh.New(domainpb.NewSourceServiceClient(domainConn))
+	sourceHandler := sh.New(domainpb.NewSourceServiceClient(domainConn), datapb.NewDataServiceClient(dataConn))
 	source.SetupRoutes(sourceRouter, sourceHandler)
 
 	domainRouter := api.Group("/domain")
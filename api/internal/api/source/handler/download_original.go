@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+
+	"github.com/gofiber/fiber/v2"
+	datapb "github.com/larek-tech/diploma/api/internal/data/pb"
+	"github.com/larek-tech/diploma/api/internal/shared"
+	"github.com/rs/zerolog/log"
+	"github.com/yogenyslav/pkg/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DownloadOriginal godoc
+//
+//	@Summary		Download original document.
+//	@Description	Streams the uploaded file or the raw html snapshot of the page the document was parsed from in a sandbox.
+//	@Tags			source
+//	@Produce		octet-stream
+//	@Security		ApiKeyAuth
+//	@Param			id			path		int		true	"Source ID"
+//	@Param			documentId	path		string	true	"Document ID"
+//	@Success		200			{file}		binary	"Original file"
+//	@Failure		400			{object}	string	"Failed to download original"
+//	@Failure		404			{object}	string	"Source, document or original not found"
+//	@Router			/api/v1/source/{id}/document/{documentId}/original [get]
+func (h *Handler) DownloadOriginal(c *fiber.Ctx) error {
+	sourceID, err := c.ParamsInt(sourceIDParam)
+	if err != nil {
+		return errs.WrapErr(shared.ErrInvalidParams, err.Error())
+	}
+	documentID := c.Params(documentIDParam)
+	if documentID == "" {
+		return errs.WrapErr(shared.ErrInvalidParams, "empty document id")
+	}
+
+	dataSourceID, err := h.dataSourceID(c, int64(sourceID), shared.ErrDownloadOriginal)
+	if err != nil {
+		return err
+	}
+	// the body is streamed after the handler returns, the stream is canceled once the copy is over
+	ctx, cancel := context.WithCancel(c.UserContext())
+	stream, err := h.dataService.DownloadOriginal(ctx, &datapb.DownloadOriginalRequest{
+		DocumentId: documentID,
+		SourceIds:  []string{dataSourceID},
+	})
+	if err != nil {
+		cancel()
+		return errs.WrapErr(shared.ErrDownloadOriginal, err.Error())
+	}
+	// errors are returned with the first message, info is expected before any data
+	first, err := stream.Recv()
+	if err != nil {
+		cancel()
+		if status.Code(err) == codes.NotFound {
+			return errs.WrapErr(shared.ErrDocumentNotFound, err.Error())
+		}
+		return errs.WrapErr(shared.ErrDownloadOriginal, err.Error())
+	}
+	info := first.GetInfo()
+	if info == nil {
+		cancel()
+		return errs.WrapErr(shared.ErrDownloadOriginal, "missing original info")
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		defer cancel()
+		for {
+			msg, recvErr := stream.Recv()
+			if errors.Is(recvErr, io.EOF) {
+				pw.Close()
+				return
+			}
+			if recvErr != nil {
+				log.Error().Err(recvErr).Str("document", documentID).Msg("download original")
+				pw.CloseWithError(recvErr)
+				return
+			}
+			// fails once the response body reader is closed by the server
+			if _, writeErr := pw.Write(msg.GetData()); writeErr != nil {
+				return
+			}
+		}
+	}()
+
+	c.Set(fiber.HeaderContentType, info.GetContentType())
+	// originals are third-party content served inline from the api origin: scripts in crawled html or uploaded svg
+	// must not run with access to the frontend auth token, so the document is sandboxed and its type is not sniffed
+	c.Set(fiber.HeaderContentSecurityPolicy, "sandbox")
+	c.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+	c.Set(fiber.HeaderContentDisposition, mime.FormatMediaType("inline", map[string]string{"filename": info.GetFilename()}))
+	c.Set("X-Original-Type", info.GetObjectType())
+	return c.Status(fiber.StatusOK).SendStream(pr, int(info.GetSize()))
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	datapb "github.com/larek-tech/diploma/api/internal/data/pb"
+	"github.com/larek-tech/diploma/api/internal/shared"
+	"github.com/yogenyslav/pkg/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetChunks godoc
+//
+//	@Summary		Get document chunks.
+//	@Description	Returns chunks of the source by ids with neighbouring chunks of the same document, ordered by document and index.
+//	@Tags			source
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id		path		int							true	"Source ID"
+//	@Param			ids		query		string						true	"Comma separated chunk ids, at most 50"
+//	@Param			window	query		uint						false	"Neighbouring chunks before and after each one, at most 5"
+//	@Success		200		{object}	datapb.GetChunksResponse	"Chunks"
+//	@Failure		400		{object}	string						"Failed to get chunks"
+//	@Failure		404		{object}	string						"Source or chunks not found"
+//	@Router			/api/v1/source/{id}/chunks [get]
+func (h *Handler) GetChunks(c *fiber.Ctx) error {
+	sourceID, err := c.ParamsInt(sourceIDParam)
+	if err != nil {
+		return errs.WrapErr(shared.ErrInvalidParams, err.Error())
+	}
+	var ids []string
+	for _, id := range strings.Split(c.Query(idsParam), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return errs.WrapErr(shared.ErrInvalidParams, "empty chunk ids")
+	}
+	window := c.QueryInt(windowParam, 0)
+	if window < 0 {
+		return errs.WrapErr(shared.ErrInvalidParams, fmt.Sprintf("window=%d", window))
+	}
+
+	dataSourceID, err := h.dataSourceID(c, int64(sourceID), shared.ErrGetChunks)
+	if err != nil {
+		return err
+	}
+	resp, err := h.dataService.GetChunks(c.UserContext(), &datapb.GetChunksRequest{
+		Ids:       ids,
+		SourceIds: []string{dataSourceID},
+		Window:    uint32(window),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return errs.WrapErr(shared.ErrDocumentNotFound, err.Error())
+		}
+		return errs.WrapErr(shared.ErrGetChunks, err.Error())
+	}
+
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
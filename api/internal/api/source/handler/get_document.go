@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	datapb "github.com/larek-tech/diploma/api/internal/data/pb"
+	"github.com/larek-tech/diploma/api/internal/shared"
+	"github.com/yogenyslav/pkg/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetDocument godoc
+//
+//	@Summary		Get source document.
+//	@Description	Returns parsed content and metadata of the document if it belongs to the source.
+//	@Tags			source
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id			path		int				true	"Source ID"
+//	@Param			documentId	path		string			true	"Document ID"
+//	@Success		200			{object}	datapb.Document	"Document"
+//	@Failure		400			{object}	string			"Failed to get document"
+//	@Failure		404			{object}	string			"Source or document not found"
+//	@Router			/api/v1/source/{id}/document/{documentId} [get]
+func (h *Handler) GetDocument(c *fiber.Ctx) error {
+	sourceID, err := c.ParamsInt(sourceIDParam)
+	if err != nil {
+		return errs.WrapErr(shared.ErrInvalidParams, err.Error())
+	}
+	documentID := c.Params(documentIDParam)
+	if documentID == "" {
+		return errs.WrapErr(shared.ErrInvalidParams, "empty document id")
+	}
+
+	dataSourceID, err := h.dataSourceID(c, int64(sourceID), shared.ErrGetDocument)
+	if err != nil {
+		return err
+	}
+	resp, err := h.dataService.GetDocument(c.UserContext(), &datapb.GetDocumentRequest{
+		Id:        documentID,
+		SourceIds: []string{dataSourceID},
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return errs.WrapErr(shared.ErrDocumentNotFound, err.Error())
+		}
+		return errs.WrapErr(shared.ErrGetDocument, err.Error())
+	}
+
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
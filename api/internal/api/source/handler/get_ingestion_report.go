@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	datapb "github.com/larek-tech/diploma/api/internal/data/pb"
+	"github.com/larek-tech/diploma/api/internal/shared"
+	"github.com/yogenyslav/pkg/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetIngestionReport godoc
+//
+//	@Summary		Get source ingestion report.
+//	@Description	Returns processing state, error and chunk count of every page and file of the source.
+//	@Tags			source
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id		path		int									true	"Source ID"
+//	@Param			state	query		string								false	"Comma separated states: queued, fetched, parsed, embedded, skipped, failed"
+//	@Param			type	query		string								false	"Object type: page or file"
+//	@Param			page	query		uint								false	"Page number"
+//	@Param			size	query		uint								false	"Page size, at most 50"
+//	@Success		200		{object}	datapb.GetIngestionReportResponse	"Ingestion report"
+//	@Failure		400		{object}	string								"Failed to get ingestion report"
+//	@Failure		404		{object}	string								"Source not found"
+//	@Router			/api/v1/source/report/{id} [get]
+func (h *Handler) GetIngestionReport(c *fiber.Ctx) error {
+	sourceID, err := c.ParamsInt(sourceIDParam)
+	if err != nil {
+		return errs.WrapErr(shared.ErrInvalidParams, err.Error())
+	}
+	page := c.QueryInt(pageParam, 1)
+	size := c.QueryInt(sizeParam, 20)
+	if page < 0 || size < 0 {
+		return errs.WrapErr(shared.ErrInvalidParams, fmt.Sprintf("page=%d, size=%d", page, size))
+	}
+
+	req := datapb.GetIngestionReportRequest{
+		Page: uint32(page),
+		Size: uint32(size),
+	}
+	if states := c.Query(stateParam); states != "" {
+		for _, state := range strings.Split(states, ",") {
+			value, ok := datapb.IngestionState_value["INGESTION_"+strings.ToUpper(strings.TrimSpace(state))]
+			if !ok || value == int32(datapb.IngestionState_INGESTION_UNDEFINED) {
+				return errs.WrapErr(shared.ErrInvalidParams, "state="+state)
+			}
+			req.States = append(req.States, datapb.IngestionState(value))
+		}
+	}
+	if objectType := c.Query(typeParam); objectType != "" {
+		value, ok := datapb.IngestionObjectType_value["OBJECT_"+strings.ToUpper(objectType)]
+		if !ok || value == int32(datapb.IngestionObjectType_OBJECT_UNDEFINED) {
+			return errs.WrapErr(shared.ErrInvalidParams, "type="+objectType)
+		}
+		req.Type = datapb.IngestionObjectType(value)
+	}
+
+	req.SourceId, err = h.dataSourceID(c, int64(sourceID), shared.ErrGetIngestionReport)
+	if err != nil {
+		return err
+	}
+
+	resp, err := h.dataService.GetIngestionReport(c.UserContext(), &req)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return errs.WrapErr(shared.ErrSourceNotFound, err.Error())
+		}
+		return errs.WrapErr(shared.ErrGetIngestionReport, err.Error())
+	}
+
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
@@ -1,7 +1,13 @@
 package handler
 
 import (
+	"github.com/gofiber/fiber/v2"
+	datapb "github.com/larek-tech/diploma/api/internal/data/pb"
 	"github.com/larek-tech/diploma/api/internal/domain/pb"
+	"github.com/larek-tech/diploma/api/internal/shared"
+	"github.com/yogenyslav/pkg/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -9,16 +15,42 @@ const (
 	domainIDParam = "id"
 	offsetParam   = "offset"
 	limitParam    = "limit"
+	pageParam     = "page"
+	sizeParam     = "size"
+	stateParam    = "state"
+	typeParam     = "type"
+
+	documentIDParam = "documentId"
+	idsParam        = "ids"
+	windowParam     = "window"
 )
 
 // Handler implements source methods on transport level.
 type Handler struct {
 	sourceService pb.SourceServiceClient
+	dataService   datapb.DataServiceClient
 }
 
 // New creates new Handler.
-func New(sourceService pb.SourceServiceClient) *Handler {
+func New(sourceService pb.SourceServiceClient, dataService datapb.DataServiceClient) *Handler {
 	return &Handler{
 		sourceService: sourceService,
+		dataService:   dataService,
+	}
+}
+
+// dataSourceID returns the data service id of the source.
+// Domain service checks access to the source while resolving it, errGet wraps unexpected failures.
+func (h *Handler) dataSourceID(c *fiber.Ctx, sourceID int64, errGet error) (string, error) {
+	ids, err := h.sourceService.GetSourceIDs(c.UserContext(), &pb.GetSourceIDsRequest{SourceIds: []int64{sourceID}})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", errs.WrapErr(shared.ErrSourceNotFound, err.Error())
+		}
+		return "", errs.WrapErr(errGet, err.Error())
+	}
+	if len(ids.GetSourceIds()) == 0 {
+		return "", errs.WrapErr(shared.ErrSourceNotFound)
 	}
+	return ids.GetSourceIds()[0], nil
 }
@@ -11,6 +11,10 @@ type sourceHandler interface {
 	DeleteSource(c *fiber.Ctx) error
 	ListSources(c *fiber.Ctx) error
 	ListSourcesByDomain(c *fiber.Ctx) error
+	GetIngestionReport(c *fiber.Ctx) error
+	GetDocument(c *fiber.Ctx) error
+	GetChunks(c *fiber.Ctx) error
+	DownloadOriginal(c *fiber.Ctx) error
 	GetPermittedUsers(c *fiber.Ctx) error
 	GetPermittedRoles(c *fiber.Ctx) error
 	UpdatePermittedUsers(c *fiber.Ctx) error
@@ -22,6 +26,10 @@ func SetupRoutes(api fiber.Router, h sourceHandler) {
 	api.Post("/", h.CreateSource)
 	api.Get("/list", h.ListSources)
 	api.Get("/list_by_domain/:id", h.ListSourcesByDomain)
+	api.Get("/report/:id", h.GetIngestionReport)
+	api.Get("/:id/document/:documentId", h.GetDocument)
+	api.Get("/:id/document/:documentId/original", h.DownloadOriginal)
+	api.Get("/:id/chunks", h.GetChunks)
 	api.Get("/permissions/users/:id", h.GetPermittedUsers)
 	api.Get("/permissions/roles/:id", h.GetPermittedRoles)
 	api.Put("/permissions/users/:id", h.UpdatePermittedUsers)
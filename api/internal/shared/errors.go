@@ -19,6 +19,14 @@ var (
 	ErrDeleteSource = errors.New("failed to delete source")
 	// ErrListSources is an error when failed to list sources.
 	ErrListSources = errors.New("failed to list sources")
+	// ErrGetIngestionReport is an error when failed to get source ingestion report.
+	ErrGetIngestionReport = errors.New("failed to get ingestion report")
+	// ErrGetDocument is an error when failed to get source document.
+	ErrGetDocument = errors.New("failed to get document")
+	// ErrGetChunks is an error when failed to get document chunks.
+	ErrGetChunks = errors.New("failed to get chunks")
+	// ErrDownloadOriginal is an error when failed to download original file or page of the document.
+	ErrDownloadOriginal = errors.New("failed to download original")
 
 	// ErrCreateDomain is an error when failed to create domain.
 	ErrCreateDomain = errors.New("failed to create domain")
@@ -92,6 +100,8 @@ var (
 var (
 	// ErrSourceNotFound is an error when no source was found.
 	ErrSourceNotFound = errors.New("source not found")
+	// ErrDocumentNotFound is an error when no document, chunk or original was found in the source.
+	ErrDocumentNotFound = errors.New("document not found")
 	// ErrDomainNotFound is an error when no scenario was found.
 	ErrDomainNotFound = errors.New("domain not found")
 	// ErrScenarioNotFound is an error when no scenario was found.
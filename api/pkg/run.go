@@ -120,6 +120,16 @@ func Run() error {
 		return errs.WrapErr(err, "create ml service client")
 	}
 
+	dataConn, err := grpcclient.NewGrpcClient(
+		&cfg.DataService,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		return errs.WrapErr(err, "connect to data service")
+	}
+	defer dataConn.Close()
+
 	// Api routes with JWT middleware
 	apiRouter := srv.GetSrv().Group("/api/v1")
 	apiRouter.Use(middleware.Jwt(authService))
@@ -129,6 +139,7 @@ func Run() error {
 		chatConn.Conn(),
 		authConn.Conn(),
 		mlConn.Conn(),
+		dataConn.Conn(),
 		tracer,
 		cfg.Server.WsConfig(),
 	)
@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"github.com/larek-tech/diploma/chat/internal/chat/model"
+	"github.com/larek-tech/diploma/chat/internal/chat/pb"
+	mlpb "github.com/larek-tech/diploma/chat/internal/domain/pb"
+)
+
+// maxSnippetLen is a max length of citation snippet in runes.
+const maxSnippetLen = 300
+
+// citationsFromML converts sources returned by ml service into data models.
+// Sources in deprecated sourceIds field are treated as plain snippets.
+func citationsFromML(r *mlpb.ProcessQueryResponse) []model.CitationDao {
+	if len(r.GetCitations()) == 0 {
+		citations := make([]model.CitationDao, len(r.GetSourceIds()))
+		for idx, source := range r.GetSourceIds() {
+			citations[idx] = model.CitationDao{Snippet: snippet(source)}
+		}
+		return citations
+	}
+
+	citations := make([]model.CitationDao, len(r.GetCitations()))
+	for idx, c := range r.GetCitations() {
+		citations[idx] = model.CitationDao{
+			DocumentID:   c.GetDocumentId(),
+			DocumentName: c.GetDocumentName(),
+			URL:          c.GetUrl(),
+			ChunkID:      c.GetChunkId(),
+			Snippet:      snippet(c.GetSnippet()),
+			Similarity:   c.GetSimilarity(),
+			Page:         c.Page,
+		}
+	}
+	return citations
+}
+
+// uniqueCitations returns citations which weren't sent before in the same response and marks them as seen.
+// Ml service may repeat sources in several stream messages, each source is saved only once.
+func uniqueCitations(citations []model.CitationDao, seen map[string]struct{}) []model.CitationDao {
+	res := make([]model.CitationDao, 0, len(citations))
+	for _, c := range citations {
+		key := citationKey(c)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		res = append(res, c)
+	}
+	return res
+}
+
+// citationKey identifies citation by chunk id, citations without chunk id are compared by document and snippet.
+func citationKey(c model.CitationDao) string {
+	if c.ChunkID != "" {
+		return "chunk:" + c.ChunkID
+	}
+	return "snippet:" + c.DocumentID + "\x00" + c.Snippet
+}
+
+func citationsToProto(citations []model.CitationDao) []*pb.Citation {
+	res := make([]*pb.Citation, len(citations))
+	for idx := range citations {
+		res[idx] = citations[idx].ToProto()
+	}
+	return res
+}
+
+func snippet(content string) string {
+	runes := []rune(content)
+	if len(runes) <= maxSnippetLen {
+		return content
+	}
+	return string(runes[:maxSnippetLen]) + "..."
+}
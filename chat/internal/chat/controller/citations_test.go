@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/larek-tech/diploma/chat/internal/chat/model"
+	mlpb "github.com/larek-tech/diploma/chat/internal/domain/pb"
+)
+
+func TestCitationsFromML(t *testing.T) {
+	t.Parallel()
+
+	page := int32(3)
+	long := strings.Repeat("я", maxSnippetLen+10)
+
+	tests := []struct {
+		name string
+		resp *mlpb.ProcessQueryResponse
+		want []model.CitationDao
+	}{
+		{
+			name: "no sources",
+			resp: &mlpb.ProcessQueryResponse{},
+			want: []model.CitationDao{},
+		},
+		{
+			name: "deprecated source ids",
+			resp: &mlpb.ProcessQueryResponse{SourceIds: []string{"first", long}},
+			want: []model.CitationDao{
+				{Snippet: "first"},
+				{Snippet: strings.Repeat("я", maxSnippetLen) + "..."},
+			},
+		},
+		{
+			name: "citations take precedence over source ids",
+			resp: &mlpb.ProcessQueryResponse{
+				SourceIds: []string{"ignored"},
+				Citations: []*mlpb.Citation{
+					{
+						DocumentId:   "doc",
+						DocumentName: "report.pdf",
+						Url:          "reports/report.pdf",
+						ChunkId:      "chunk",
+						Snippet:      "snippet",
+						Similarity:   0.75,
+						Page:         &page,
+					},
+				},
+			},
+			want: []model.CitationDao{
+				{
+					DocumentID:   "doc",
+					DocumentName: "report.pdf",
+					URL:          "reports/report.pdf",
+					ChunkID:      "chunk",
+					Snippet:      "snippet",
+					Similarity:   0.75,
+					Page:         &page,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := citationsFromML(tt.resp)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("citationsFromML() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUniqueCitations(t *testing.T) {
+	t.Parallel()
+
+	seen := make(map[string]struct{})
+
+	first := uniqueCitations([]model.CitationDao{
+		{DocumentID: "doc", ChunkID: "a", Snippet: "first"},
+		{DocumentID: "doc", ChunkID: "a", Snippet: "first"},
+		{DocumentID: "doc", ChunkID: "b", Snippet: "second"},
+		{Snippet: "legacy"},
+	}, seen)
+	want := []model.CitationDao{
+		{DocumentID: "doc", ChunkID: "a", Snippet: "first"},
+		{DocumentID: "doc", ChunkID: "b", Snippet: "second"},
+		{Snippet: "legacy"},
+	}
+	if !reflect.DeepEqual(first, want) {
+		t.Errorf("first message citations = %+v, want %+v", first, want)
+	}
+
+	// next stream message repeats the same sources
+	second := uniqueCitations([]model.CitationDao{
+		{DocumentID: "doc", ChunkID: "b", Snippet: "second"},
+		{Snippet: "legacy"},
+		{DocumentID: "doc", ChunkID: "c", Snippet: "third"},
+	}, seen)
+	want = []model.CitationDao{
+		{DocumentID: "doc", ChunkID: "c", Snippet: "third"},
+	}
+	if !reflect.DeepEqual(second, want) {
+		t.Errorf("second message citations = %+v, want %+v", second, want)
+	}
+}
@@ -20,6 +20,7 @@ type chatRepo interface {
 	InsertChat(ctx context.Context, chat model.ChatDao) (uuid.UUID, error)
 	InsertQuery(ctx context.Context, q model.QueryDao) (int64, error)
 	InsertResponse(ctx context.Context, resp model.ResponseDao) (int64, error)
+	InsertCitations(ctx context.Context, respID int64, citations []model.CitationDao) error
 	GetChat(ctx context.Context, chatID uuid.UUID) (model.ChatDao, error)
 	GetChatUserID(ctx context.Context, chatID uuid.UUID) (int64, error)
 	GetResponseByID(ctx context.Context, respID int64) (model.ResponseDao, error)
@@ -132,6 +132,7 @@ func (ctrl *Controller) ProcessQuery(
 
 	var (
 		contentBuff        strings.Builder
+		seenCitations      = make(map[string]struct{})
 		streamCtx, success = context.WithCancel(ctx)
 	)
 	defer success()
@@ -156,7 +157,7 @@ func (ctrl *Controller) ProcessQuery(
 			log.Info().Int64("queryID", queryID).Msg("stream successfully finished")
 			return
 		default:
-			if err = ctrl.receiveChunk(streamCtx, success, stream, out, &resp, &contentBuff); err != nil {
+			if err = ctrl.receiveChunk(streamCtx, success, stream, out, &resp, &contentBuff, seenCitations); err != nil {
 				errCh <- errs.WrapErr(err)
 				return
 			}
@@ -171,6 +172,7 @@ func (ctrl *Controller) receiveChunk(
 	out chan *pb.ChunkedResponse,
 	resp *model.ResponseDao,
 	buff *strings.Builder,
+	seenCitations map[string]struct{},
 ) error {
 	r, err := stream.Recv()
 	if err == io.EOF {
@@ -186,11 +188,25 @@ func (ctrl *Controller) receiveChunk(
 		return errs.WrapErr(err, "streaming error")
 	}
 
-	content := r.GetChunk().GetContent()
+	if citations := uniqueCitations(citationsFromML(r), seenCitations); len(citations) > 0 {
+		for idx := range citations {
+			citations[idx].ResponseID = resp.ID
+		}
+		if err = ctrl.cr.InsertCitations(ctx, resp.ID, citations); err != nil {
+			return errs.WrapErr(err, "save citations")
+		}
 
-	sourceIDs := r.GetSourceIds()
-	if sourceIDs != nil {
-		content += "\nИсточники: [" + strings.Join(sourceIDs, ", ") + "]"
+		log.Debug().Int64("queryID", resp.QueryID).Int("count", len(citations)).Msg("got citations")
+
+		out <- &pb.ChunkedResponse{
+			QueryId:   resp.QueryID,
+			Citations: citationsToProto(citations),
+		}
+	}
+
+	content := r.GetChunk().GetContent()
+	if content == "" {
+		return nil
 	}
 
 	_, err = buff.WriteString(content)
@@ -36,15 +36,22 @@ func (c *ChatDao) ToProto() *pb.Chat {
 }
 
 type ChatContent struct {
-	Query    QueryDao    `db:"query"`
-	Response ResponseDao `db:"response"`
+	Query     QueryDao      `db:"query"`
+	Response  ResponseDao   `db:"response"`
+	Citations []CitationDao `db:"-"`
 }
 
 // ToProto converts data model into protobuf format.
 func (c *ChatContent) ToProto() *pb.Content {
+	resp := c.Response.ToProto()
+	resp.Citations = make([]*pb.Citation, len(c.Citations))
+	for idx := range c.Citations {
+		resp.Citations[idx] = c.Citations[idx].ToProto()
+	}
+
 	return &pb.Content{
 		Query:    c.Query.ToProto(),
-		Response: c.Response.ToProto(),
+		Response: resp,
 	}
 }
 
@@ -95,3 +102,32 @@ func (r *ResponseDao) ToProto() *pb.Response {
 		UpdatedAt: timestamppb.New(r.UpdatedAt),
 	}
 }
+
+// CitationDao is a model for response source on data layer.
+type CitationDao struct {
+	ID           int64     `db:"id"`
+	ResponseID   int64     `db:"response_id"`
+	DocumentID   string    `db:"document_id"`
+	DocumentName string    `db:"document_name"`
+	URL          string    `db:"url"`
+	ChunkID      string    `db:"chunk_id"`
+	Snippet      string    `db:"snippet"`
+	Similarity   float32   `db:"similarity"`
+	Page         *int32    `db:"page"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+// ToProto converts data model into protobuf format.
+func (c *CitationDao) ToProto() *pb.Citation {
+	return &pb.Citation{
+		Id:           c.ID,
+		ResponseId:   c.ResponseID,
+		DocumentId:   c.DocumentID,
+		DocumentName: c.DocumentName,
+		Url:          c.URL,
+		ChunkId:      c.ChunkID,
+		Snippet:      c.Snippet,
+		Similarity:   c.Similarity,
+		Page:         c.Page,
+	}
+}
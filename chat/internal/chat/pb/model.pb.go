@@ -172,6 +172,114 @@ func (x *Query) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+type Citation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResponseId    int64                  `protobuf:"varint,2,opt,name=responseId,proto3" json:"responseId,omitempty"`
+	DocumentId    string                 `protobuf:"bytes,3,opt,name=documentId,proto3" json:"documentId,omitempty"`
+	DocumentName  string                 `protobuf:"bytes,4,opt,name=documentName,proto3" json:"documentName,omitempty"`
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	ChunkId       string                 `protobuf:"bytes,6,opt,name=chunkId,proto3" json:"chunkId,omitempty"`
+	Snippet       string                 `protobuf:"bytes,7,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Similarity    float32                `protobuf:"fixed32,8,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	Page          *int32                 `protobuf:"varint,9,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Citation) Reset() {
+	*x = Citation{}
+	mi := &file_chat_v1_model_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Citation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Citation) ProtoMessage() {}
+
+func (x *Citation) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_model_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Citation.ProtoReflect.Descriptor instead.
+func (*Citation) Descriptor() ([]byte, []int) {
+	return file_chat_v1_model_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Citation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Citation) GetResponseId() int64 {
+	if x != nil {
+		return x.ResponseId
+	}
+	return 0
+}
+
+func (x *Citation) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *Citation) GetDocumentName() string {
+	if x != nil {
+		return x.DocumentName
+	}
+	return ""
+}
+
+func (x *Citation) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Citation) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *Citation) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *Citation) GetSimilarity() float32 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *Citation) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
 type Response struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -181,13 +289,14 @@ type Response struct {
 	Status        ResponseStatus         `protobuf:"varint,5,opt,name=status,proto3,enum=chat.v1.ResponseStatus" json:"status,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Citations     []*Citation            `protobuf:"bytes,8,rep,name=citations,proto3" json:"citations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Response) Reset() {
 	*x = Response{}
-	mi := &file_chat_v1_model_proto_msgTypes[1]
+	mi := &file_chat_v1_model_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -199,7 +308,7 @@ func (x *Response) String() string {
 func (*Response) ProtoMessage() {}
 
 func (x *Response) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_model_proto_msgTypes[1]
+	mi := &file_chat_v1_model_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,7 +321,7 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Response.ProtoReflect.Descriptor instead.
 func (*Response) Descriptor() ([]byte, []int) {
-	return file_chat_v1_model_proto_rawDescGZIP(), []int{1}
+	return file_chat_v1_model_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Response) GetId() int64 {
@@ -264,6 +373,13 @@ func (x *Response) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Response) GetCitations() []*Citation {
+	if x != nil {
+		return x.Citations
+	}
+	return nil
+}
+
 type Content struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Query         *Query                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
@@ -274,7 +390,7 @@ type Content struct {
 
 func (x *Content) Reset() {
 	*x = Content{}
-	mi := &file_chat_v1_model_proto_msgTypes[2]
+	mi := &file_chat_v1_model_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -286,7 +402,7 @@ func (x *Content) String() string {
 func (*Content) ProtoMessage() {}
 
 func (x *Content) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_model_proto_msgTypes[2]
+	mi := &file_chat_v1_model_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,7 +415,7 @@ func (x *Content) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Content.ProtoReflect.Descriptor instead.
 func (*Content) Descriptor() ([]byte, []int) {
-	return file_chat_v1_model_proto_rawDescGZIP(), []int{2}
+	return file_chat_v1_model_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Content) GetQuery() *Query {
@@ -330,7 +446,7 @@ type Chat struct {
 
 func (x *Chat) Reset() {
 	*x = Chat{}
-	mi := &file_chat_v1_model_proto_msgTypes[3]
+	mi := &file_chat_v1_model_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -342,7 +458,7 @@ func (x *Chat) String() string {
 func (*Chat) ProtoMessage() {}
 
 func (x *Chat) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_model_proto_msgTypes[3]
+	mi := &file_chat_v1_model_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -355,7 +471,7 @@ func (x *Chat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Chat.ProtoReflect.Descriptor instead.
 func (*Chat) Descriptor() ([]byte, []int) {
-	return file_chat_v1_model_proto_rawDescGZIP(), []int{3}
+	return file_chat_v1_model_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Chat) GetId() string {
@@ -405,13 +521,14 @@ type ChunkedResponse struct {
 	QueryId       int64                  `protobuf:"varint,1,opt,name=queryId,proto3" json:"queryId,omitempty"`
 	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	SourceIds     []string               `protobuf:"bytes,3,rep,name=sourceIds,proto3" json:"sourceIds,omitempty"`
+	Citations     []*Citation            `protobuf:"bytes,4,rep,name=citations,proto3" json:"citations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ChunkedResponse) Reset() {
 	*x = ChunkedResponse{}
-	mi := &file_chat_v1_model_proto_msgTypes[4]
+	mi := &file_chat_v1_model_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -423,7 +540,7 @@ func (x *ChunkedResponse) String() string {
 func (*ChunkedResponse) ProtoMessage() {}
 
 func (x *ChunkedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_model_proto_msgTypes[4]
+	mi := &file_chat_v1_model_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -436,7 +553,7 @@ func (x *ChunkedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChunkedResponse.ProtoReflect.Descriptor instead.
 func (*ChunkedResponse) Descriptor() ([]byte, []int) {
-	return file_chat_v1_model_proto_rawDescGZIP(), []int{4}
+	return file_chat_v1_model_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ChunkedResponse) GetQueryId() int64 {
@@ -460,6 +577,13 @@ func (x *ChunkedResponse) GetSourceIds() []string {
 	return nil
 }
 
+func (x *ChunkedResponse) GetCitations() []*Citation {
+	if x != nil {
+		return x.Citations
+	}
+	return nil
+}
+
 type GetChatRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ChatId        string                 `protobuf:"bytes,1,opt,name=chatId,proto3" json:"chatId,omitempty"`
@@ -469,7 +593,7 @@ type GetChatRequest struct {
 
 func (x *GetChatRequest) Reset() {
 	*x = GetChatRequest{}
-	mi := &file_chat_v1_model_proto_msgTypes[5]
+	mi := &file_chat_v1_model_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -481,7 +605,7 @@ func (x *GetChatRequest) String() string {
 func (*GetChatRequest) ProtoMessage() {}
 
 func (x *GetChatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_model_proto_msgTypes[5]
+	mi := &file_chat_v1_model_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -494,7 +618,7 @@ func (x *GetChatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChatRequest.ProtoReflect.Descriptor instead.
 func (*GetChatRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_model_proto_rawDescGZIP(), []int{5}
+	return file_chat_v1_model_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetChatRequest) GetChatId() string {
@@ -514,7 +638,7 @@ type RenameChatRequest struct {
 
 func (x *RenameChatRequest) Reset() {
 	*x = RenameChatRequest{}
-	mi := &file_chat_v1_model_proto_msgTypes[6]
+	mi := &file_chat_v1_model_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -526,7 +650,7 @@ func (x *RenameChatRequest) String() string {
 func (*RenameChatRequest) ProtoMessage() {}
 
 func (x *RenameChatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_model_proto_msgTypes[6]
+	mi := &file_chat_v1_model_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,7 +663,7 @@ func (x *RenameChatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameChatRequest.ProtoReflect.Descriptor instead.
 func (*RenameChatRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_model_proto_rawDescGZIP(), []int{6}
+	return file_chat_v1_model_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RenameChatRequest) GetChatId() string {
@@ -565,7 +689,7 @@ type DeleteChatRequest struct {
 
 func (x *DeleteChatRequest) Reset() {
 	*x = DeleteChatRequest{}
-	mi := &file_chat_v1_model_proto_msgTypes[7]
+	mi := &file_chat_v1_model_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -577,7 +701,7 @@ func (x *DeleteChatRequest) String() string {
 func (*DeleteChatRequest) ProtoMessage() {}
 
 func (x *DeleteChatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_model_proto_msgTypes[7]
+	mi := &file_chat_v1_model_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -590,7 +714,7 @@ func (x *DeleteChatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteChatRequest.ProtoReflect.Descriptor instead.
 func (*DeleteChatRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_model_proto_rawDescGZIP(), []int{7}
+	return file_chat_v1_model_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteChatRequest) GetChatId() string {
@@ -609,7 +733,7 @@ type CleanupChatRequest struct {
 
 func (x *CleanupChatRequest) Reset() {
 	*x = CleanupChatRequest{}
-	mi := &file_chat_v1_model_proto_msgTypes[8]
+	mi := &file_chat_v1_model_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -621,7 +745,7 @@ func (x *CleanupChatRequest) String() string {
 func (*CleanupChatRequest) ProtoMessage() {}
 
 func (x *CleanupChatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_model_proto_msgTypes[8]
+	mi := &file_chat_v1_model_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -634,7 +758,7 @@ func (x *CleanupChatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CleanupChatRequest.ProtoReflect.Descriptor instead.
 func (*CleanupChatRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_model_proto_rawDescGZIP(), []int{8}
+	return file_chat_v1_model_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CleanupChatRequest) GetChatId() string {
@@ -654,7 +778,7 @@ type ListChatsRequest struct {
 
 func (x *ListChatsRequest) Reset() {
 	*x = ListChatsRequest{}
-	mi := &file_chat_v1_model_proto_msgTypes[9]
+	mi := &file_chat_v1_model_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -666,7 +790,7 @@ func (x *ListChatsRequest) String() string {
 func (*ListChatsRequest) ProtoMessage() {}
 
 func (x *ListChatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_model_proto_msgTypes[9]
+	mi := &file_chat_v1_model_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,7 +803,7 @@ func (x *ListChatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChatsRequest.ProtoReflect.Descriptor instead.
 func (*ListChatsRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_model_proto_rawDescGZIP(), []int{9}
+	return file_chat_v1_model_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListChatsRequest) GetOffset() uint64 {
@@ -705,7 +829,7 @@ type ListChatsResponse struct {
 
 func (x *ListChatsResponse) Reset() {
 	*x = ListChatsResponse{}
-	mi := &file_chat_v1_model_proto_msgTypes[10]
+	mi := &file_chat_v1_model_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -717,7 +841,7 @@ func (x *ListChatsResponse) String() string {
 func (*ListChatsResponse) ProtoMessage() {}
 
 func (x *ListChatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_model_proto_msgTypes[10]
+	mi := &file_chat_v1_model_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,7 +854,7 @@ func (x *ListChatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChatsResponse.ProtoReflect.Descriptor instead.
 func (*ListChatsResponse) Descriptor() ([]byte, []int) {
-	return file_chat_v1_model_proto_rawDescGZIP(), []int{10}
+	return file_chat_v1_model_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListChatsResponse) GetChats() []*Chat {
@@ -754,7 +878,7 @@ type ProcessQueryRequest struct {
 
 func (x *ProcessQueryRequest) Reset() {
 	*x = ProcessQueryRequest{}
-	mi := &file_chat_v1_model_proto_msgTypes[11]
+	mi := &file_chat_v1_model_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -766,7 +890,7 @@ func (x *ProcessQueryRequest) String() string {
 func (*ProcessQueryRequest) ProtoMessage() {}
 
 func (x *ProcessQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_model_proto_msgTypes[11]
+	mi := &file_chat_v1_model_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,7 +903,7 @@ func (x *ProcessQueryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessQueryRequest.ProtoReflect.Descriptor instead.
 func (*ProcessQueryRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_model_proto_rawDescGZIP(), []int{11}
+	return file_chat_v1_model_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ProcessQueryRequest) GetUserId() int64 {
@@ -833,7 +957,7 @@ type CancelProcessingRequest struct {
 
 func (x *CancelProcessingRequest) Reset() {
 	*x = CancelProcessingRequest{}
-	mi := &file_chat_v1_model_proto_msgTypes[12]
+	mi := &file_chat_v1_model_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -845,7 +969,7 @@ func (x *CancelProcessingRequest) String() string {
 func (*CancelProcessingRequest) ProtoMessage() {}
 
 func (x *CancelProcessingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_model_proto_msgTypes[12]
+	mi := &file_chat_v1_model_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -858,7 +982,7 @@ func (x *CancelProcessingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelProcessingRequest.ProtoReflect.Descriptor instead.
 func (*CancelProcessingRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_model_proto_rawDescGZIP(), []int{12}
+	return file_chat_v1_model_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CancelProcessingRequest) GetQueryId() int64 {
@@ -882,7 +1006,24 @@ const file_chat_v1_model_proto_rawDesc = "" +
 	"\n" +
 	"scenarioId\x18\x06 \x01(\x03R\n" +
 	"scenarioId\x128\n" +
-	"\tcreatedAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8b\x02\n" +
+	"\tcreatedAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x86\x02\n" +
+	"\bCitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
+	"\n" +
+	"responseId\x18\x02 \x01(\x03R\n" +
+	"responseId\x12\x1e\n" +
+	"\n" +
+	"documentId\x18\x03 \x01(\tR\n" +
+	"documentId\x12\"\n" +
+	"\fdocumentName\x18\x04 \x01(\tR\fdocumentName\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\x12\x18\n" +
+	"\achunkId\x18\x06 \x01(\tR\achunkId\x12\x18\n" +
+	"\asnippet\x18\a \x01(\tR\asnippet\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\b \x01(\x02R\n" +
+	"similarity\x12\x17\n" +
+	"\x04page\x18\t \x01(\x05H\x00R\x04page\x88\x01\x01B\a\n" +
+	"\x05_page\"\xbc\x02\n" +
 	"\bResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
 	"\aqueryId\x18\x02 \x01(\x03R\aqueryId\x12\x16\n" +
@@ -890,7 +1031,8 @@ const file_chat_v1_model_proto_rawDesc = "" +
 	"\acontent\x18\x04 \x01(\tR\acontent\x12/\n" +
 	"\x06status\x18\x05 \x01(\x0e2\x17.chat.v1.ResponseStatusR\x06status\x128\n" +
 	"\tcreatedAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
-	"\tupdatedAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"^\n" +
+	"\tupdatedAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12/\n" +
+	"\tcitations\x18\b \x03(\v2\x11.chat.v1.CitationR\tcitations\"^\n" +
 	"\aContent\x12$\n" +
 	"\x05query\x18\x01 \x01(\v2\x0e.chat.v1.QueryR\x05query\x12-\n" +
 	"\bresponse\x18\x02 \x01(\v2\x11.chat.v1.ResponseR\bresponse\"\xe4\x01\n" +
@@ -900,11 +1042,12 @@ const file_chat_v1_model_proto_rawDesc = "" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12*\n" +
 	"\acontent\x18\x04 \x03(\v2\x10.chat.v1.ContentR\acontent\x128\n" +
 	"\tcreatedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
-	"\tupdatedAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"c\n" +
+	"\tupdatedAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x94\x01\n" +
 	"\x0fChunkedResponse\x12\x18\n" +
 	"\aqueryId\x18\x01 \x01(\x03R\aqueryId\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1c\n" +
-	"\tsourceIds\x18\x03 \x03(\tR\tsourceIds\"(\n" +
+	"\tsourceIds\x18\x03 \x03(\tR\tsourceIds\x12/\n" +
+	"\tcitations\x18\x04 \x03(\v2\x11.chat.v1.CitationR\tcitations\"(\n" +
 	"\x0eGetChatRequest\x12\x16\n" +
 	"\x06chatId\x18\x01 \x01(\tR\x06chatId\"A\n" +
 	"\x11RenameChatRequest\x12\x16\n" +
@@ -949,40 +1092,43 @@ func file_chat_v1_model_proto_rawDescGZIP() []byte {
 }
 
 var file_chat_v1_model_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_chat_v1_model_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_chat_v1_model_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_chat_v1_model_proto_goTypes = []any{
 	(ResponseStatus)(0),             // 0: chat.v1.ResponseStatus
 	(*Query)(nil),                   // 1: chat.v1.Query
-	(*Response)(nil),                // 2: chat.v1.Response
-	(*Content)(nil),                 // 3: chat.v1.Content
-	(*Chat)(nil),                    // 4: chat.v1.Chat
-	(*ChunkedResponse)(nil),         // 5: chat.v1.ChunkedResponse
-	(*GetChatRequest)(nil),          // 6: chat.v1.GetChatRequest
-	(*RenameChatRequest)(nil),       // 7: chat.v1.RenameChatRequest
-	(*DeleteChatRequest)(nil),       // 8: chat.v1.DeleteChatRequest
-	(*CleanupChatRequest)(nil),      // 9: chat.v1.CleanupChatRequest
-	(*ListChatsRequest)(nil),        // 10: chat.v1.ListChatsRequest
-	(*ListChatsResponse)(nil),       // 11: chat.v1.ListChatsResponse
-	(*ProcessQueryRequest)(nil),     // 12: chat.v1.ProcessQueryRequest
-	(*CancelProcessingRequest)(nil), // 13: chat.v1.CancelProcessingRequest
-	(*timestamppb.Timestamp)(nil),   // 14: google.protobuf.Timestamp
+	(*Citation)(nil),                // 2: chat.v1.Citation
+	(*Response)(nil),                // 3: chat.v1.Response
+	(*Content)(nil),                 // 4: chat.v1.Content
+	(*Chat)(nil),                    // 5: chat.v1.Chat
+	(*ChunkedResponse)(nil),         // 6: chat.v1.ChunkedResponse
+	(*GetChatRequest)(nil),          // 7: chat.v1.GetChatRequest
+	(*RenameChatRequest)(nil),       // 8: chat.v1.RenameChatRequest
+	(*DeleteChatRequest)(nil),       // 9: chat.v1.DeleteChatRequest
+	(*CleanupChatRequest)(nil),      // 10: chat.v1.CleanupChatRequest
+	(*ListChatsRequest)(nil),        // 11: chat.v1.ListChatsRequest
+	(*ListChatsResponse)(nil),       // 12: chat.v1.ListChatsResponse
+	(*ProcessQueryRequest)(nil),     // 13: chat.v1.ProcessQueryRequest
+	(*CancelProcessingRequest)(nil), // 14: chat.v1.CancelProcessingRequest
+	(*timestamppb.Timestamp)(nil),   // 15: google.protobuf.Timestamp
 }
 var file_chat_v1_model_proto_depIdxs = []int32{
-	14, // 0: chat.v1.Query.createdAt:type_name -> google.protobuf.Timestamp
+	15, // 0: chat.v1.Query.createdAt:type_name -> google.protobuf.Timestamp
 	0,  // 1: chat.v1.Response.status:type_name -> chat.v1.ResponseStatus
-	14, // 2: chat.v1.Response.createdAt:type_name -> google.protobuf.Timestamp
-	14, // 3: chat.v1.Response.updatedAt:type_name -> google.protobuf.Timestamp
-	1,  // 4: chat.v1.Content.query:type_name -> chat.v1.Query
-	2,  // 5: chat.v1.Content.response:type_name -> chat.v1.Response
-	3,  // 6: chat.v1.Chat.content:type_name -> chat.v1.Content
-	14, // 7: chat.v1.Chat.createdAt:type_name -> google.protobuf.Timestamp
-	14, // 8: chat.v1.Chat.updatedAt:type_name -> google.protobuf.Timestamp
-	4,  // 9: chat.v1.ListChatsResponse.chats:type_name -> chat.v1.Chat
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	15, // 2: chat.v1.Response.createdAt:type_name -> google.protobuf.Timestamp
+	15, // 3: chat.v1.Response.updatedAt:type_name -> google.protobuf.Timestamp
+	2,  // 4: chat.v1.Response.citations:type_name -> chat.v1.Citation
+	1,  // 5: chat.v1.Content.query:type_name -> chat.v1.Query
+	3,  // 6: chat.v1.Content.response:type_name -> chat.v1.Response
+	4,  // 7: chat.v1.Chat.content:type_name -> chat.v1.Content
+	15, // 8: chat.v1.Chat.createdAt:type_name -> google.protobuf.Timestamp
+	15, // 9: chat.v1.Chat.updatedAt:type_name -> google.protobuf.Timestamp
+	2,  // 10: chat.v1.ChunkedResponse.citations:type_name -> chat.v1.Citation
+	5,  // 11: chat.v1.ListChatsResponse.chats:type_name -> chat.v1.Chat
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_chat_v1_model_proto_init() }
@@ -990,13 +1136,14 @@ func file_chat_v1_model_proto_init() {
 	if File_chat_v1_model_proto != nil {
 		return
 	}
+	file_chat_v1_model_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_chat_v1_model_proto_rawDesc), len(file_chat_v1_model_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   13,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -31,6 +31,18 @@ const getContentForChat = `
 	order by q.id;
 `
 
+const getCitationsForChat = `
+	select
+		c.id, c.response_id, c.document_id, c.document_name, c.url,
+		c.chunk_id, c.snippet, c.similarity, c.page, c.created_at
+	from chat.citation c
+	join
+		chat.response r
+		on c.response_id = r.id
+	where r.chat_id = $1
+	order by c.id;
+`
+
 // GetChat returns chat by id.
 func (r *Repo) GetChat(ctx context.Context, chatID uuid.UUID) (model.ChatDao, error) {
 	var chat model.ChatDao
@@ -43,5 +55,18 @@ func (r *Repo) GetChat(ctx context.Context, chatID uuid.UUID) (model.ChatDao, er
 		return chat, errs.WrapErr(err, "get chat content")
 	}
 
+	var citations []model.CitationDao
+	if err := r.pg.QuerySlice(ctx, &citations, getCitationsForChat, chatID); err != nil {
+		return chat, errs.WrapErr(err, "get chat citations")
+	}
+
+	byResponse := make(map[int64][]model.CitationDao, len(chat.Content))
+	for _, c := range citations {
+		byResponse[c.ResponseID] = append(byResponse[c.ResponseID], c)
+	}
+	for idx := range chat.Content {
+		chat.Content[idx].Citations = byResponse[chat.Content[idx].Response.ID]
+	}
+
 	return chat, nil
 }
@@ -0,0 +1,58 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/larek-tech/diploma/chat/internal/chat/model"
+	"github.com/yogenyslav/pkg/errs"
+)
+
+const insertCitations = `
+	insert into chat.citation(response_id, document_id, document_name, url, chunk_id, snippet, similarity, page)
+	select $1, c.document_id, c.document_name, c.url, c.chunk_id, c.snippet, c.similarity, c.page
+	from unnest($2::text[], $3::text[], $4::text[], $5::text[], $6::text[], $7::real[], $8::int[])
+		with ordinality as c(document_id, document_name, url, chunk_id, snippet, similarity, page, position)
+	order by c.position;
+`
+
+// InsertCitations saves sources used to generate response.
+func (r *Repo) InsertCitations(ctx context.Context, respID int64, citations []model.CitationDao) error {
+	if len(citations) == 0 {
+		return nil
+	}
+
+	var (
+		documentIDs   = make([]string, len(citations))
+		documentNames = make([]string, len(citations))
+		urls          = make([]string, len(citations))
+		chunkIDs      = make([]string, len(citations))
+		snippets      = make([]string, len(citations))
+		similarities  = make([]float32, len(citations))
+		pages         = make([]*int32, len(citations))
+	)
+	for idx, c := range citations {
+		documentIDs[idx] = c.DocumentID
+		documentNames[idx] = c.DocumentName
+		urls[idx] = c.URL
+		chunkIDs[idx] = c.ChunkID
+		snippets[idx] = c.Snippet
+		similarities[idx] = c.Similarity
+		pages[idx] = c.Page
+	}
+
+	if _, err := r.pg.Exec(
+		ctx,
+		insertCitations,
+		respID,
+		documentIDs,
+		documentNames,
+		urls,
+		chunkIDs,
+		snippets,
+		similarities,
+		pages,
+	); err != nil {
+		return errs.WrapErr(err, "insert citations")
+	}
+	return nil
+}
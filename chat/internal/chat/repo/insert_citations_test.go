@@ -0,0 +1,78 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/larek-tech/diploma/chat/internal/chat/model"
+	"github.com/yogenyslav/pkg/storage"
+)
+
+// execRecorder records Exec calls, other methods of storage.SQLDatabase are not used by the tests.
+type execRecorder struct {
+	storage.SQLDatabase
+	calls [][]any
+	err   error
+}
+
+func (r *execRecorder) Exec(_ context.Context, _ string, args ...any) (int64, error) {
+	r.calls = append(r.calls, args)
+	return int64(len(r.calls)), r.err
+}
+
+func TestInsertCitations(t *testing.T) {
+	t.Parallel()
+
+	page := int32(2)
+	db := &execRecorder{}
+	citations := []model.CitationDao{
+		{DocumentID: "doc1", DocumentName: "a.pdf", URL: "files/a.pdf", ChunkID: "c1", Snippet: "first", Similarity: 0.9, Page: &page},
+		{DocumentID: "doc2", DocumentName: "b", URL: "https://example.com/b", ChunkID: "c2", Snippet: "second", Similarity: 0.5},
+	}
+
+	if err := New(db).InsertCitations(context.Background(), 42, citations); err != nil {
+		t.Fatalf("InsertCitations() error = %v", err)
+	}
+	if len(db.calls) != 1 {
+		t.Fatalf("Exec calls = %d, want 1", len(db.calls))
+	}
+
+	want := []any{
+		int64(42),
+		[]string{"doc1", "doc2"},
+		[]string{"a.pdf", "b"},
+		[]string{"files/a.pdf", "https://example.com/b"},
+		[]string{"c1", "c2"},
+		[]string{"first", "second"},
+		[]float32{0.9, 0.5},
+		[]*int32{&page, nil},
+	}
+	if !reflect.DeepEqual(db.calls[0], want) {
+		t.Errorf("Exec args = %+v, want %+v", db.calls[0], want)
+	}
+}
+
+func TestInsertCitationsEmpty(t *testing.T) {
+	t.Parallel()
+
+	db := &execRecorder{}
+	if err := New(db).InsertCitations(context.Background(), 42, nil); err != nil {
+		t.Fatalf("InsertCitations() error = %v", err)
+	}
+	if len(db.calls) != 0 {
+		t.Errorf("Exec calls = %d, want 0", len(db.calls))
+	}
+}
+
+func TestInsertCitationsError(t *testing.T) {
+	t.Parallel()
+
+	errExec := errors.New("exec failed")
+	db := &execRecorder{err: errExec}
+	err := New(db).InsertCitations(context.Background(), 42, []model.CitationDao{{Snippet: "first"}})
+	if !errors.Is(err, errExec) {
+		t.Errorf("InsertCitations() error = %v, want %v", err, errExec)
+	}
+}
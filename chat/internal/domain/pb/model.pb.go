@@ -226,18 +226,120 @@ func (x *LlmModel) GetSystemPrompt() string {
 	return ""
 }
 
+// SearchFilter ограничения векторного поиска, передаются в data.v1.SearchFilter
+type SearchFilter struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Types              []string               `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`             // web.page, file
+	Extensions         []string               `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`   // .pdf, docx
+	UrlPrefixes        []string               `protobuf:"bytes,3,rep,name=urlPrefixes,proto3" json:"urlPrefixes,omitempty"` // url страницы или путь файла в источнике
+	DateFrom           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=dateFrom,proto3,oneof" json:"dateFrom,omitempty"`
+	DateTo             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dateTo,proto3,oneof" json:"dateTo,omitempty"`
+	Languages          []string               `protobuf:"bytes,6,rep,name=languages,proto3" json:"languages,omitempty"`                   // ru, en
+	DocumentPredicates []string               `protobuf:"bytes,7,rep,name=documentPredicates,proto3" json:"documentPredicates,omitempty"` // jsonpath по метаданным документа
+	ChunkPredicates    []string               `protobuf:"bytes,8,rep,name=chunkPredicates,proto3" json:"chunkPredicates,omitempty"`       // jsonpath по метаданным чанка
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SearchFilter) Reset() {
+	*x = SearchFilter{}
+	mi := &file_ml_v1_model_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFilter) ProtoMessage() {}
+
+func (x *SearchFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_ml_v1_model_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFilter.ProtoReflect.Descriptor instead.
+func (*SearchFilter) Descriptor() ([]byte, []int) {
+	return file_ml_v1_model_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchFilter) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetExtensions() []string {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetUrlPrefixes() []string {
+	if x != nil {
+		return x.UrlPrefixes
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetDocumentPredicates() []string {
+	if x != nil {
+		return x.DocumentPredicates
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetChunkPredicates() []string {
+	if x != nil {
+		return x.ChunkPredicates
+	}
+	return nil
+}
+
 type VectorSearch struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TopN          int64                  `protobuf:"varint,1,opt,name=topN,proto3" json:"topN,omitempty"` // Сколько чанков забирать при векторном поиске.
 	Threshold     float32                `protobuf:"fixed32,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
 	SearchByQuery bool                   `protobuf:"varint,3,opt,name=searchByQuery,proto3" json:"searchByQuery,omitempty"`
+	Filter        *SearchFilter          `protobuf:"bytes,4,opt,name=filter,proto3,oneof" json:"filter,omitempty"` // Фильтры сценария по умолчанию
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *VectorSearch) Reset() {
 	*x = VectorSearch{}
-	mi := &file_ml_v1_model_proto_msgTypes[3]
+	mi := &file_ml_v1_model_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -249,7 +351,7 @@ func (x *VectorSearch) String() string {
 func (*VectorSearch) ProtoMessage() {}
 
 func (x *VectorSearch) ProtoReflect() protoreflect.Message {
-	mi := &file_ml_v1_model_proto_msgTypes[3]
+	mi := &file_ml_v1_model_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -262,7 +364,7 @@ func (x *VectorSearch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VectorSearch.ProtoReflect.Descriptor instead.
 func (*VectorSearch) Descriptor() ([]byte, []int) {
-	return file_ml_v1_model_proto_rawDescGZIP(), []int{3}
+	return file_ml_v1_model_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *VectorSearch) GetTopN() int64 {
@@ -286,6 +388,13 @@ func (x *VectorSearch) GetSearchByQuery() bool {
 	return false
 }
 
+func (x *VectorSearch) GetFilter() *SearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 type Scenario struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -304,7 +413,7 @@ type Scenario struct {
 
 func (x *Scenario) Reset() {
 	*x = Scenario{}
-	mi := &file_ml_v1_model_proto_msgTypes[4]
+	mi := &file_ml_v1_model_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -316,7 +425,7 @@ func (x *Scenario) String() string {
 func (*Scenario) ProtoMessage() {}
 
 func (x *Scenario) ProtoReflect() protoreflect.Message {
-	mi := &file_ml_v1_model_proto_msgTypes[4]
+	mi := &file_ml_v1_model_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +438,7 @@ func (x *Scenario) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Scenario.ProtoReflect.Descriptor instead.
 func (*Scenario) Descriptor() ([]byte, []int) {
-	return file_ml_v1_model_proto_rawDescGZIP(), []int{4}
+	return file_ml_v1_model_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Scenario) GetId() int64 {
@@ -413,7 +522,7 @@ type Query struct {
 
 func (x *Query) Reset() {
 	*x = Query{}
-	mi := &file_ml_v1_model_proto_msgTypes[5]
+	mi := &file_ml_v1_model_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -425,7 +534,7 @@ func (x *Query) String() string {
 func (*Query) ProtoMessage() {}
 
 func (x *Query) ProtoReflect() protoreflect.Message {
-	mi := &file_ml_v1_model_proto_msgTypes[5]
+	mi := &file_ml_v1_model_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,7 +547,7 @@ func (x *Query) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Query.ProtoReflect.Descriptor instead.
 func (*Query) Descriptor() ([]byte, []int) {
-	return file_ml_v1_model_proto_rawDescGZIP(), []int{5}
+	return file_ml_v1_model_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Query) GetId() int64 {
@@ -473,7 +582,7 @@ type ProcessQueryRequest struct {
 
 func (x *ProcessQueryRequest) Reset() {
 	*x = ProcessQueryRequest{}
-	mi := &file_ml_v1_model_proto_msgTypes[6]
+	mi := &file_ml_v1_model_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -485,7 +594,7 @@ func (x *ProcessQueryRequest) String() string {
 func (*ProcessQueryRequest) ProtoMessage() {}
 
 func (x *ProcessQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ml_v1_model_proto_msgTypes[6]
+	mi := &file_ml_v1_model_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,7 +607,7 @@ func (x *ProcessQueryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessQueryRequest.ProtoReflect.Descriptor instead.
 func (*ProcessQueryRequest) Descriptor() ([]byte, []int) {
-	return file_ml_v1_model_proto_rawDescGZIP(), []int{6}
+	return file_ml_v1_model_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ProcessQueryRequest) GetQuery() *Query {
@@ -531,7 +640,7 @@ type Chunk struct {
 
 func (x *Chunk) Reset() {
 	*x = Chunk{}
-	mi := &file_ml_v1_model_proto_msgTypes[7]
+	mi := &file_ml_v1_model_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -543,7 +652,7 @@ func (x *Chunk) String() string {
 func (*Chunk) ProtoMessage() {}
 
 func (x *Chunk) ProtoReflect() protoreflect.Message {
-	mi := &file_ml_v1_model_proto_msgTypes[7]
+	mi := &file_ml_v1_model_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -556,7 +665,7 @@ func (x *Chunk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Chunk.ProtoReflect.Descriptor instead.
 func (*Chunk) Descriptor() ([]byte, []int) {
-	return file_ml_v1_model_proto_rawDescGZIP(), []int{7}
+	return file_ml_v1_model_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Chunk) GetContent() string {
@@ -566,17 +675,110 @@ func (x *Chunk) GetContent() string {
 	return ""
 }
 
+type Citation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    string                 `protobuf:"bytes,1,opt,name=documentId,proto3" json:"documentId,omitempty"`
+	DocumentName  string                 `protobuf:"bytes,2,opt,name=documentName,proto3" json:"documentName,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"` // url страницы или путь к файлу
+	ChunkId       string                 `protobuf:"bytes,4,opt,name=chunkId,proto3" json:"chunkId,omitempty"`
+	Snippet       string                 `protobuf:"bytes,5,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Similarity    float32                `protobuf:"fixed32,6,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	Page          *int32                 `protobuf:"varint,7,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Citation) Reset() {
+	*x = Citation{}
+	mi := &file_ml_v1_model_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Citation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Citation) ProtoMessage() {}
+
+func (x *Citation) ProtoReflect() protoreflect.Message {
+	mi := &file_ml_v1_model_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Citation.ProtoReflect.Descriptor instead.
+func (*Citation) Descriptor() ([]byte, []int) {
+	return file_ml_v1_model_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Citation) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *Citation) GetDocumentName() string {
+	if x != nil {
+		return x.DocumentName
+	}
+	return ""
+}
+
+func (x *Citation) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Citation) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *Citation) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *Citation) GetSimilarity() float32 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *Citation) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
 type ProcessQueryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Chunk         *Chunk                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
-	SourceIds     []string               `protobuf:"bytes,2,rep,name=sourceIds,proto3" json:"sourceIds,omitempty"`
+	SourceIds     []string               `protobuf:"bytes,2,rep,name=sourceIds,proto3" json:"sourceIds,omitempty"` // deprecated: use citations
+	Citations     []*Citation            `protobuf:"bytes,3,rep,name=citations,proto3" json:"citations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProcessQueryResponse) Reset() {
 	*x = ProcessQueryResponse{}
-	mi := &file_ml_v1_model_proto_msgTypes[8]
+	mi := &file_ml_v1_model_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -588,7 +790,7 @@ func (x *ProcessQueryResponse) String() string {
 func (*ProcessQueryResponse) ProtoMessage() {}
 
 func (x *ProcessQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ml_v1_model_proto_msgTypes[8]
+	mi := &file_ml_v1_model_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -601,7 +803,7 @@ func (x *ProcessQueryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessQueryResponse.ProtoReflect.Descriptor instead.
 func (*ProcessQueryResponse) Descriptor() ([]byte, []int) {
-	return file_ml_v1_model_proto_rawDescGZIP(), []int{8}
+	return file_ml_v1_model_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ProcessQueryResponse) GetChunk() *Chunk {
@@ -618,6 +820,13 @@ func (x *ProcessQueryResponse) GetSourceIds() []string {
 	return nil
 }
 
+func (x *ProcessQueryResponse) GetCitations() []*Citation {
+	if x != nil {
+		return x.Citations
+	}
+	return nil
+}
+
 type ModelParams struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MultiQuery    *MultiQuery            `protobuf:"bytes,1,opt,name=multiQuery,proto3,oneof" json:"multiQuery,omitempty"`
@@ -630,7 +839,7 @@ type ModelParams struct {
 
 func (x *ModelParams) Reset() {
 	*x = ModelParams{}
-	mi := &file_ml_v1_model_proto_msgTypes[9]
+	mi := &file_ml_v1_model_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -642,7 +851,7 @@ func (x *ModelParams) String() string {
 func (*ModelParams) ProtoMessage() {}
 
 func (x *ModelParams) ProtoReflect() protoreflect.Message {
-	mi := &file_ml_v1_model_proto_msgTypes[9]
+	mi := &file_ml_v1_model_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -655,7 +864,7 @@ func (x *ModelParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModelParams.ProtoReflect.Descriptor instead.
 func (*ModelParams) Descriptor() ([]byte, []int) {
-	return file_ml_v1_model_proto_rawDescGZIP(), []int{9}
+	return file_ml_v1_model_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ModelParams) GetMultiQuery() *MultiQuery {
@@ -695,7 +904,7 @@ type GetOptimalParamsRequest struct {
 
 func (x *GetOptimalParamsRequest) Reset() {
 	*x = GetOptimalParamsRequest{}
-	mi := &file_ml_v1_model_proto_msgTypes[10]
+	mi := &file_ml_v1_model_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -707,7 +916,7 @@ func (x *GetOptimalParamsRequest) String() string {
 func (*GetOptimalParamsRequest) ProtoMessage() {}
 
 func (x *GetOptimalParamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ml_v1_model_proto_msgTypes[10]
+	mi := &file_ml_v1_model_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,7 +929,7 @@ func (x *GetOptimalParamsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOptimalParamsRequest.ProtoReflect.Descriptor instead.
 func (*GetOptimalParamsRequest) Descriptor() ([]byte, []int) {
-	return file_ml_v1_model_proto_rawDescGZIP(), []int{10}
+	return file_ml_v1_model_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetOptimalParamsRequest) GetSourceIds() []string {
@@ -739,7 +948,7 @@ type ProcessFirstQueryRequest struct {
 
 func (x *ProcessFirstQueryRequest) Reset() {
 	*x = ProcessFirstQueryRequest{}
-	mi := &file_ml_v1_model_proto_msgTypes[11]
+	mi := &file_ml_v1_model_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -751,7 +960,7 @@ func (x *ProcessFirstQueryRequest) String() string {
 func (*ProcessFirstQueryRequest) ProtoMessage() {}
 
 func (x *ProcessFirstQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ml_v1_model_proto_msgTypes[11]
+	mi := &file_ml_v1_model_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,7 +973,7 @@ func (x *ProcessFirstQueryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessFirstQueryRequest.ProtoReflect.Descriptor instead.
 func (*ProcessFirstQueryRequest) Descriptor() ([]byte, []int) {
-	return file_ml_v1_model_proto_rawDescGZIP(), []int{11}
+	return file_ml_v1_model_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ProcessFirstQueryRequest) GetQuery() string {
@@ -783,7 +992,7 @@ type ProcessFirstQueryResponse struct {
 
 func (x *ProcessFirstQueryResponse) Reset() {
 	*x = ProcessFirstQueryResponse{}
-	mi := &file_ml_v1_model_proto_msgTypes[12]
+	mi := &file_ml_v1_model_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -795,7 +1004,7 @@ func (x *ProcessFirstQueryResponse) String() string {
 func (*ProcessFirstQueryResponse) ProtoMessage() {}
 
 func (x *ProcessFirstQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ml_v1_model_proto_msgTypes[12]
+	mi := &file_ml_v1_model_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,7 +1017,7 @@ func (x *ProcessFirstQueryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessFirstQueryResponse.ProtoReflect.Descriptor instead.
 func (*ProcessFirstQueryResponse) Descriptor() ([]byte, []int) {
-	return file_ml_v1_model_proto_rawDescGZIP(), []int{12}
+	return file_ml_v1_model_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ProcessFirstQueryResponse) GetQuery() string {
@@ -839,11 +1048,26 @@ const file_ml_v1_model_proto_rawDesc = "" +
 	"\vtemperature\x18\x02 \x01(\x02R\vtemperature\x12\x12\n" +
 	"\x04topK\x18\x03 \x01(\x03R\x04topK\x12\x12\n" +
 	"\x04topP\x18\x04 \x01(\x02R\x04topP\x12\"\n" +
-	"\fsystemPrompt\x18\x05 \x01(\tR\fsystemPrompt\"f\n" +
+	"\fsystemPrompt\x18\x05 \x01(\tR\fsystemPrompt\"\xec\x02\n" +
+	"\fSearchFilter\x12\x14\n" +
+	"\x05types\x18\x01 \x03(\tR\x05types\x12\x1e\n" +
+	"\n" +
+	"extensions\x18\x02 \x03(\tR\n" +
+	"extensions\x12 \n" +
+	"\vurlPrefixes\x18\x03 \x03(\tR\vurlPrefixes\x12;\n" +
+	"\bdateFrom\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\bdateFrom\x88\x01\x01\x127\n" +
+	"\x06dateTo\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x06dateTo\x88\x01\x01\x12\x1c\n" +
+	"\tlanguages\x18\x06 \x03(\tR\tlanguages\x12.\n" +
+	"\x12documentPredicates\x18\a \x03(\tR\x12documentPredicates\x12(\n" +
+	"\x0fchunkPredicates\x18\b \x03(\tR\x0fchunkPredicatesB\v\n" +
+	"\t_dateFromB\t\n" +
+	"\a_dateTo\"\xa3\x01\n" +
 	"\fVectorSearch\x12\x12\n" +
 	"\x04topN\x18\x01 \x01(\x03R\x04topN\x12\x1c\n" +
 	"\tthreshold\x18\x02 \x01(\x02R\tthreshold\x12$\n" +
-	"\rsearchByQuery\x18\x03 \x01(\bR\rsearchByQuery\"\xde\x03\n" +
+	"\rsearchByQuery\x18\x03 \x01(\bR\rsearchByQuery\x120\n" +
+	"\x06filter\x18\x04 \x01(\v2\x13.pb.ml.SearchFilterH\x00R\x06filter\x88\x01\x01B\t\n" +
+	"\a_filter\"\xde\x03\n" +
 	"\bScenario\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x126\n" +
 	"\n" +
@@ -871,10 +1095,24 @@ const file_ml_v1_model_proto_rawDesc = "" +
 	"\tsourceIds\x18\x03 \x03(\tR\tsourceIdsB\v\n" +
 	"\t_scenario\"!\n" +
 	"\x05Chunk\x12\x18\n" +
-	"\acontent\x18\x01 \x01(\tR\acontent\"X\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\"\xd6\x01\n" +
+	"\bCitation\x12\x1e\n" +
+	"\n" +
+	"documentId\x18\x01 \x01(\tR\n" +
+	"documentId\x12\"\n" +
+	"\fdocumentName\x18\x02 \x01(\tR\fdocumentName\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x18\n" +
+	"\achunkId\x18\x04 \x01(\tR\achunkId\x12\x18\n" +
+	"\asnippet\x18\x05 \x01(\tR\asnippet\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x06 \x01(\x02R\n" +
+	"similarity\x12\x17\n" +
+	"\x04page\x18\a \x01(\x05H\x00R\x04page\x88\x01\x01B\a\n" +
+	"\x05_page\"\x87\x01\n" +
 	"\x14ProcessQueryResponse\x12\"\n" +
 	"\x05chunk\x18\x01 \x01(\v2\f.pb.ml.ChunkR\x05chunk\x12\x1c\n" +
-	"\tsourceIds\x18\x02 \x03(\tR\tsourceIds\"\x89\x02\n" +
+	"\tsourceIds\x18\x02 \x03(\tR\tsourceIds\x12-\n" +
+	"\tcitations\x18\x03 \x03(\v2\x0f.pb.ml.CitationR\tcitations\"\x89\x02\n" +
 	"\vModelParams\x126\n" +
 	"\n" +
 	"multiQuery\x18\x01 \x01(\v2\x11.pb.ml.MultiQueryH\x00R\n" +
@@ -904,42 +1142,48 @@ func file_ml_v1_model_proto_rawDescGZIP() []byte {
 	return file_ml_v1_model_proto_rawDescData
 }
 
-var file_ml_v1_model_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_ml_v1_model_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_ml_v1_model_proto_goTypes = []any{
 	(*MultiQuery)(nil),                // 0: pb.ml.MultiQuery
 	(*Reranker)(nil),                  // 1: pb.ml.Reranker
 	(*LlmModel)(nil),                  // 2: pb.ml.LlmModel
-	(*VectorSearch)(nil),              // 3: pb.ml.VectorSearch
-	(*Scenario)(nil),                  // 4: pb.ml.Scenario
-	(*Query)(nil),                     // 5: pb.ml.Query
-	(*ProcessQueryRequest)(nil),       // 6: pb.ml.ProcessQueryRequest
-	(*Chunk)(nil),                     // 7: pb.ml.Chunk
-	(*ProcessQueryResponse)(nil),      // 8: pb.ml.ProcessQueryResponse
-	(*ModelParams)(nil),               // 9: pb.ml.ModelParams
-	(*GetOptimalParamsRequest)(nil),   // 10: pb.ml.GetOptimalParamsRequest
-	(*ProcessFirstQueryRequest)(nil),  // 11: pb.ml.ProcessFirstQueryRequest
-	(*ProcessFirstQueryResponse)(nil), // 12: pb.ml.ProcessFirstQueryResponse
-	(*timestamppb.Timestamp)(nil),     // 13: google.protobuf.Timestamp
+	(*SearchFilter)(nil),              // 3: pb.ml.SearchFilter
+	(*VectorSearch)(nil),              // 4: pb.ml.VectorSearch
+	(*Scenario)(nil),                  // 5: pb.ml.Scenario
+	(*Query)(nil),                     // 6: pb.ml.Query
+	(*ProcessQueryRequest)(nil),       // 7: pb.ml.ProcessQueryRequest
+	(*Chunk)(nil),                     // 8: pb.ml.Chunk
+	(*Citation)(nil),                  // 9: pb.ml.Citation
+	(*ProcessQueryResponse)(nil),      // 10: pb.ml.ProcessQueryResponse
+	(*ModelParams)(nil),               // 11: pb.ml.ModelParams
+	(*GetOptimalParamsRequest)(nil),   // 12: pb.ml.GetOptimalParamsRequest
+	(*ProcessFirstQueryRequest)(nil),  // 13: pb.ml.ProcessFirstQueryRequest
+	(*ProcessFirstQueryResponse)(nil), // 14: pb.ml.ProcessFirstQueryResponse
+	(*timestamppb.Timestamp)(nil),     // 15: google.protobuf.Timestamp
 }
 var file_ml_v1_model_proto_depIdxs = []int32{
-	0,  // 0: pb.ml.Scenario.multiQuery:type_name -> pb.ml.MultiQuery
-	1,  // 1: pb.ml.Scenario.reranker:type_name -> pb.ml.Reranker
-	3,  // 2: pb.ml.Scenario.vectorSearch:type_name -> pb.ml.VectorSearch
-	2,  // 3: pb.ml.Scenario.model:type_name -> pb.ml.LlmModel
-	13, // 4: pb.ml.Scenario.createdAt:type_name -> google.protobuf.Timestamp
-	13, // 5: pb.ml.Scenario.updatedAt:type_name -> google.protobuf.Timestamp
-	5,  // 6: pb.ml.ProcessQueryRequest.query:type_name -> pb.ml.Query
-	4,  // 7: pb.ml.ProcessQueryRequest.scenario:type_name -> pb.ml.Scenario
-	7,  // 8: pb.ml.ProcessQueryResponse.chunk:type_name -> pb.ml.Chunk
-	0,  // 9: pb.ml.ModelParams.multiQuery:type_name -> pb.ml.MultiQuery
-	1,  // 10: pb.ml.ModelParams.reranker:type_name -> pb.ml.Reranker
-	3,  // 11: pb.ml.ModelParams.vectorSearch:type_name -> pb.ml.VectorSearch
-	2,  // 12: pb.ml.ModelParams.model:type_name -> pb.ml.LlmModel
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	15, // 0: pb.ml.SearchFilter.dateFrom:type_name -> google.protobuf.Timestamp
+	15, // 1: pb.ml.SearchFilter.dateTo:type_name -> google.protobuf.Timestamp
+	3,  // 2: pb.ml.VectorSearch.filter:type_name -> pb.ml.SearchFilter
+	0,  // 3: pb.ml.Scenario.multiQuery:type_name -> pb.ml.MultiQuery
+	1,  // 4: pb.ml.Scenario.reranker:type_name -> pb.ml.Reranker
+	4,  // 5: pb.ml.Scenario.vectorSearch:type_name -> pb.ml.VectorSearch
+	2,  // 6: pb.ml.Scenario.model:type_name -> pb.ml.LlmModel
+	15, // 7: pb.ml.Scenario.createdAt:type_name -> google.protobuf.Timestamp
+	15, // 8: pb.ml.Scenario.updatedAt:type_name -> google.protobuf.Timestamp
+	6,  // 9: pb.ml.ProcessQueryRequest.query:type_name -> pb.ml.Query
+	5,  // 10: pb.ml.ProcessQueryRequest.scenario:type_name -> pb.ml.Scenario
+	8,  // 11: pb.ml.ProcessQueryResponse.chunk:type_name -> pb.ml.Chunk
+	9,  // 12: pb.ml.ProcessQueryResponse.citations:type_name -> pb.ml.Citation
+	0,  // 13: pb.ml.ModelParams.multiQuery:type_name -> pb.ml.MultiQuery
+	1,  // 14: pb.ml.ModelParams.reranker:type_name -> pb.ml.Reranker
+	4,  // 15: pb.ml.ModelParams.vectorSearch:type_name -> pb.ml.VectorSearch
+	2,  // 16: pb.ml.ModelParams.model:type_name -> pb.ml.LlmModel
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_ml_v1_model_proto_init() }
@@ -948,16 +1192,19 @@ func file_ml_v1_model_proto_init() {
 		return
 	}
 	file_ml_v1_model_proto_msgTypes[0].OneofWrappers = []any{}
+	file_ml_v1_model_proto_msgTypes[3].OneofWrappers = []any{}
 	file_ml_v1_model_proto_msgTypes[4].OneofWrappers = []any{}
-	file_ml_v1_model_proto_msgTypes[6].OneofWrappers = []any{}
+	file_ml_v1_model_proto_msgTypes[5].OneofWrappers = []any{}
+	file_ml_v1_model_proto_msgTypes[7].OneofWrappers = []any{}
 	file_ml_v1_model_proto_msgTypes[9].OneofWrappers = []any{}
+	file_ml_v1_model_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ml_v1_model_proto_rawDesc), len(file_ml_v1_model_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -11,25 +11,41 @@ import (
 	"strconv"
 	"sync"
 
-	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/larek-tech/diploma/data/internal/config"
 	"github.com/larek-tech/diploma/data/internal/data/pb"
+	embeddingService "github.com/larek-tech/diploma/data/internal/domain/embedding/service"
+	"github.com/larek-tech/diploma/data/internal/domain/file/archive"
 	sitemap "github.com/larek-tech/diploma/data/internal/domain/sitemap/service"
 	"github.com/larek-tech/diploma/data/internal/domain/source"
 	sourceService "github.com/larek-tech/diploma/data/internal/domain/source/service"
+	"github.com/larek-tech/diploma/data/internal/grpc/dead_letters"
+	"github.com/larek-tech/diploma/data/internal/grpc/embedding_models"
 	"github.com/larek-tech/diploma/data/internal/grpc/get_documents"
+	"github.com/larek-tech/diploma/data/internal/grpc/ingestion_report"
+	"github.com/larek-tech/diploma/data/internal/grpc/retrieval"
+	"github.com/larek-tech/diploma/data/internal/grpc/structured_tables"
 	"github.com/larek-tech/diploma/data/internal/grpc/vector_search"
 	"github.com/larek-tech/diploma/data/internal/infrastructure/grpc/server"
 	"github.com/larek-tech/diploma/data/internal/infrastructure/kafka"
-	"github.com/larek-tech/diploma/data/internal/infrastructure/ollama"
 	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
 	"github.com/larek-tech/diploma/data/internal/infrastructure/s3"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/secret"
 	chunkStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/chunk"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/storage/deadletter"
 	documentStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/document"
+	embeddingStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/embedding"
+	embeddingModelStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/embedding_model"
 	fileStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/file"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/storage/ingestion"
+	objectStoreStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/object_store"
 	pageStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/page"
 	sourceStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/source"
+	structuredStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/structured"
 	"github.com/larek-tech/diploma/data/internal/worker/kafka/create_source"
+	"github.com/larek-tech/diploma/data/internal/worker/kafka/delete_source"
+	"github.com/larek-tech/diploma/data/internal/worker/kafka/update_source"
+	"github.com/larek-tech/diploma/data/internal/worker/qaas/refresh_source"
 	"github.com/larek-tech/diploma/data/pkg/metric"
 	"github.com/larek-tech/storage/postgres"
 	"github.com/yogenyslav/pkg/infrastructure/tracing"
@@ -49,13 +65,13 @@ func main() {
 func run() int {
 	ctx := context.Background()
 	slog.Info("Starting server")
-	kafkaCfg, err := getKafkaConfig()
+	kafkaCfg, err := config.Kafka()
 	slog.Info("kafka config", "cfg", kafkaCfg)
 	if err != nil {
 		slog.Error(err.Error())
 		return -1
 	}
-	exporter, err := tracing.NewExporter(ctx, getTracingEndpoint())
+	exporter, err := tracing.NewExporter(ctx, config.TracingEndpoint())
 	if err != nil {
 		slog.Error(err.Error())
 		return -1
@@ -72,7 +88,7 @@ func run() int {
 	}
 	tracer := provider.Tracer(serviceName)
 
-	pgCfg, err := getPGConfig()
+	pgCfg, err := config.Postgres()
 	if err != nil {
 		slog.Error(err.Error())
 		return -1
@@ -102,13 +118,15 @@ func run() int {
 		qaas.ParseS3Queue,
 		qaas.ParseS3ResultQueue,
 		qaas.EmbedResultQueue,
+		qaas.RefreshSourceQueue,
+		qaas.ReembedQueue,
 	})
 	if err != nil {
 		slog.Error("failed to create all tables", "error", err)
 		return 1
 	}
 
-	objectStorage, err := s3.New(getS3Credentials())
+	objectStorage, err := s3.New(config.S3Credentials())
 	if err != nil {
 		slog.Error("failed to create s3 client", "error", err)
 		return -1
@@ -123,17 +141,26 @@ func run() int {
 	}
 
 	fileStore := fileStorage.New(pg, objectStorage)
+	pageStore := pageStorage.New(pg, objectStorage)
 	sourceStore := sourceStorage.New(pg)
-	srcService := sourceService.New(sourceStore, fileStore, sitemap.New(), pub, trManager, tracer)
+	secrets, err := secret.New(os.Getenv("OBJECT_STORAGE_ENCRYPTION_KEY"))
+	if err != nil {
+		slog.Error("failed to create object storage secret cipher, set OBJECT_STORAGE_ENCRYPTION_KEY generated by `openssl rand -base64 32`", "error", err)
+		return 1
+	}
+	objectStore := objectStoreStorage.NewStorage(pg, secrets)
+	if err = objectStore.EncryptSecrets(ctx); err != nil {
+		slog.Error("failed to encrypt object storage secrets", "error", err)
+		return 1
+	}
+	srcService := sourceService.New(sourceStore, fileStore, pageStore, objectStore, sitemap.New(), pub, trManager, tracer, getArchiveLimits())
 	documentStore := documentStorage.New(pg)
 	chunkStore := chunkStorage.New(pg, trManager)
-	embedderURL, embedderModel, embeddingsSize := getEmbedderConfig()
-	embedderService, err := ollama.New(embedderURL, &ollama.Config{
-		EmbeddingSize:   embeddingsSize,
-		EmbeddingsModel: embedderModel,
-	})
-	if err != nil {
-		slog.Error("failed to create ollama client", "error", err)
+	structuredStore := structuredStorage.New(pg, trManager)
+	embeddingModelStore := embeddingModelStorage.New(pg, trManager)
+	embedders := embeddingService.NewRegistry(embeddingModelStore)
+	if _, err = config.RegisterEmbedders(ctx, embedders, embeddingStorage.New(pg, trManager), tracer); err != nil {
+		slog.Error("failed to configure embedding models", "error", err)
 		return 1
 	}
 	kafkaProducer, err := kafka.NewProducer(kafkaCfg)
@@ -142,7 +169,9 @@ func run() int {
 		return 1
 	}
 	kafkaHandlers := map[string]kafka.HandlerFunc{
-		"source": create_source.New(srcService, kafkaProducer).Handle,
+		"source":        create_source.New(srcService, kafkaProducer).Handle,
+		"source_delete": delete_source.New(srcService, kafkaProducer).Handle,
+		"source_update": update_source.New(srcService, kafkaProducer).Handle,
 	}
 	kafkaConsumer, err := kafka.NewConsumer(kafkaCfg, "crawler", kafkaHandlers, tracer)
 	if err != nil {
@@ -234,7 +263,14 @@ func run() int {
 		if payload.Threshold == 0 {
 			payload.Threshold = 0.1
 		}
-		embedding, err := embedderService.CreateEmbedding(reqCtx, []string{payload.Query})
+		model, embedder, err := embedders.Active(reqCtx)
+		if err != nil {
+			span.RecordError(err)
+			slog.Error("Failed to get active embedding model", "error", err)
+			http.Error(w, "Internal server error:"+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		queryEmbedding, err := embedder.CreateEmbedding(reqCtx, []string{payload.Query})
 		if err != nil {
 			err = fmt.Errorf("failed to create embedding: %w", err)
 			span.RecordError(err, trace.WithAttributes(
@@ -250,7 +286,7 @@ func run() int {
 			return
 		}
 
-		res, err := chunkStore.Search(ctx, embedding[0], payload.SourceIDs, payload.Threshold, int(payload.TopK), payload.UseQ)
+		res, err := chunkStore.Search(ctx, model, queryEmbedding[0], payload.SourceIDs, nil, payload.Threshold, int(payload.TopK), payload.UseQ)
 		if err != nil {
 			slog.Error("Failed to search chunks", "error", err)
 			http.Error(w, "Internal server error:"+err.Error(), http.StatusInternalServerError)
@@ -293,8 +329,13 @@ func run() int {
 	pb.RegisterDataServiceServer(
 		srv.GetSrv(),
 		server.NewHandlers(
-			vector_search.New(chunkStore, embedderService, tracer),
+			vector_search.New(chunkStore, embedders, tracer),
 			get_documents.New(documentStore, tracer),
+			retrieval.New(documentStore, chunkStore, fileStore, pageStore, tracer),
+			structured_tables.New(structuredStore, tracer),
+			ingestion_report.New(ingestion.New(pg), sourceStore, tracer),
+			dead_letters.New(deadletter.New(pg), tracer),
+			embedding_models.New(embeddingModelStore, embedders, pub, tracer),
 		),
 	)
 	reflection.Register(srv.GetSrv())
@@ -329,6 +370,15 @@ func run() int {
 		}
 	}()
 	wg.Add(1)
+	// scheduled source refresh
+	go func() {
+		defer wg.Done()
+		refreshErr := qaas.NewConsumer(sqlDB, qaas.WithRetryPolicy(config.RetryPolicy())).Run(ctx, qaas.RefreshSourceQueue, refresh_source.New(srcService, kafkaProducer, tracer))
+		if refreshErr != nil {
+			slog.Error("failed to run refresh source consumer", "error", refreshErr)
+		}
+	}()
+	wg.Add(1)
 	go func() {
 		defer wg.Done()
 		slog.Info("Starting server on :8080")
@@ -340,14 +390,6 @@ func run() int {
 	return 0
 }
 
-func getS3Credentials() s3.Credentials {
-	endpoint := os.Getenv("S3_ENDPOINT")
-	if endpoint == "" {
-		endpoint = "localhost:9000"
-	}
-	return s3.NewCredentials(endpoint, os.Getenv("S3_ACCESS_KEY_ID"), os.Getenv("S3_SECRET_ACCESS_KEY"), true)
-}
-
 type SearchQuery struct {
 	Query     string   `json:"query"`
 	SourceIDs []string `json:"sourceIds"`
@@ -362,47 +404,25 @@ func getSqlCon(db *postgres.DB) *sql.DB {
 	return sqlCon
 }
 
-func getEmbedderConfig() (string, string, int) {
-	host := os.Getenv("OLLAMA_EMBEDDER_ENDPOINT")
-	if host == "" {
-		host = "http://localhost:11434"
+// getArchiveLimits читает ограничения распаковки архивов, незаданные значения берутся по умолчанию.
+func getArchiveLimits() archive.Limits {
+	limits := archive.DefaultLimits()
+	if v, err := strconv.Atoi(config.Env("ARCHIVE_MAX_DEPTH")); err == nil {
+		limits.MaxDepth = v
 	}
-	model := os.Getenv("OLLAMA_EMBEDDER_MODEL")
-	if model == "" {
-		model = "bge-m3:latest"
+	if v, err := strconv.Atoi(config.Env("ARCHIVE_MAX_ENTRIES")); err == nil {
+		limits.MaxEntries = v
 	}
-	embeddingSize := os.Getenv("OLLAMA_EMBEDDER_SIZE")
-	size, err := strconv.Atoi(embeddingSize)
-	if err != nil {
-		size = 514
+	if v, err := strconv.ParseInt(config.Env("ARCHIVE_MAX_FILE_SIZE"), 10, 64); err == nil {
+		limits.MaxFileSize = v
 	}
-
-	return host, model, size
-}
-
-func getPGConfig() (*postgres.Cfg, error) {
-	var pgCfg postgres.Cfg
-	if err := cleanenv.ReadEnv(&pgCfg); err != nil {
-		return nil, fmt.Errorf("failed to read postgresql config: %w", err)
-
+	if v, err := strconv.ParseInt(config.Env("ARCHIVE_MAX_TOTAL_SIZE"), 10, 64); err == nil {
+		limits.MaxTotalSize = v
 	}
-	return &pgCfg, nil
-}
-
-func getKafkaConfig() (kafka.Config, error) {
-	var cfg kafka.Config
-	if err := cleanenv.ReadEnv(&cfg); err != nil {
-		return cfg, fmt.Errorf("failed to read kafka config: %w", err)
-	}
-	return cfg, nil
-}
-
-func getTracingEndpoint() string {
-	tracingEndpoint := os.Getenv("TRACING_ENDPOINT")
-	if tracingEndpoint == "" {
-		tracingEndpoint = "localhost:4318"
+	if v, err := strconv.ParseFloat(config.Env("ARCHIVE_MAX_RATIO"), 64); err == nil {
+		limits.MaxRatio = v
 	}
-	return tracingEndpoint
+	return limits
 }
 
 func logError(w http.ResponseWriter, msg string, err error, code int) {
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/larek-tech/diploma/data/internal/data/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const usage = `Usage: dlq [-addr host:port] <command> [arguments]
+
+Commands:
+  list -queue <queue> [-page n] [-size n]  list dead-lettered jobs of the queue
+  show -queue <queue> <id>                 show job payload, metadata and last error
+  replay -queue <queue> <id>...            return jobs to the queue with a reset attempt counter
+`
+
+func main() {
+	os.Exit(run())
+}
+
+func run() int {
+	addr := flag.String("addr", "localhost:50051", "data service grpc address")
+	timeout := flag.Duration("timeout", 10*time.Second, "request timeout")
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		return 2
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		slog.Error("failed to create grpc client", "error", err)
+		return 1
+	}
+	defer conn.Close()
+	client := pb.NewDataServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	cmd, args := flag.Arg(0), flag.Args()[1:]
+	switch cmd {
+	case "list":
+		err = list(ctx, client, args)
+	case "show":
+		err = show(ctx, client, args)
+	case "replay":
+		err = replay(ctx, client, args)
+	default:
+		flag.Usage()
+		return 2
+	}
+	if err != nil {
+		slog.Error("command failed", "command", cmd, "error", err)
+		return 1
+	}
+	return 0
+}
+
+func list(ctx context.Context, client pb.DataServiceClient, args []string) error {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	queue := fs.String("queue", "", "queue name")
+	page := fs.Uint("page", 1, "page number")
+	size := fs.Uint("size", 20, "page size, at most 50")
+	_ = fs.Parse(args)
+
+	res, err := client.ListDeadLetters(ctx, &pb.ListDeadLettersRequest{
+		Queue: *queue,
+		Page:  uint32(*page),
+		Size:  uint32(*size),
+	})
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tATTEMPTS\tCREATED\tREPLAYED\tERROR")
+	for _, job := range res.DeadLetters {
+		replayed := "-"
+		if job.ReplayedAt != nil {
+			replayed = job.ReplayedAt.AsTime().Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n",
+			job.Id,
+			job.Attempts,
+			job.CreatedAt.AsTime().Format(time.RFC3339),
+			replayed,
+			firstLine(job.Error),
+		)
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("page %d, %d of %d\n", res.Page, len(res.DeadLetters), res.Total)
+	return nil
+}
+
+func show(ctx context.Context, client pb.DataServiceClient, args []string) error {
+	fs := flag.NewFlagSet("show", flag.ExitOnError)
+	queue := fs.String("queue", "", "queue name")
+	_ = fs.Parse(args)
+	if fs.NArg() != 1 {
+		return fmt.Errorf("expected exactly one job id")
+	}
+
+	job, err := client.GetDeadLetter(ctx, &pb.GetDeadLetterRequest{
+		Queue: *queue,
+		Id:    fs.Arg(0),
+	})
+	if err != nil {
+		return err
+	}
+	out, err := json.MarshalIndent(struct {
+		ID         string            `json:"id"`
+		Queue      string            `json:"queue"`
+		Attempts   uint32            `json:"attempts"`
+		CreatedAt  time.Time         `json:"createdAt"`
+		ReplayedAt *time.Time        `json:"replayedAt,omitempty"`
+		Error      string            `json:"error"`
+		Metadata   map[string]string `json:"metadata"`
+		Payload    json.RawMessage   `json:"payload"`
+	}{
+		ID:         job.Id,
+		Queue:      job.Queue,
+		Attempts:   job.Attempts,
+		CreatedAt:  job.CreatedAt.AsTime(),
+		ReplayedAt: replayedAt(job),
+		Error:      job.Error,
+		Metadata:   job.Metadata,
+		Payload:    json.RawMessage(job.Payload),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+func replay(ctx context.Context, client pb.DataServiceClient, args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	queue := fs.String("queue", "", "queue name")
+	_ = fs.Parse(args)
+	if fs.NArg() == 0 {
+		return fmt.Errorf("expected at least one job id")
+	}
+
+	res, err := client.ReplayDeadLetters(ctx, &pb.ReplayDeadLettersRequest{
+		Queue: *queue,
+		Ids:   fs.Args(),
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("replayed %d of %d jobs\n", res.Replayed, fs.NArg())
+	return nil
+}
+
+func replayedAt(job *pb.DeadLetter) *time.Time {
+	if job.ReplayedAt == nil {
+		return nil
+	}
+	t := job.ReplayedAt.AsTime()
+	return &t
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+	return line
+}
@@ -43,6 +43,9 @@ func run() int {
 		qaas.ParsePageResultQueue,
 		qaas.ParsePageQueue,
 		qaas.EmbedResultQueue,
+		qaas.ParseS3Queue,
+		qaas.RefreshSourceQueue,
+		qaas.ReembedQueue,
 	})
 	if err != nil {
 		slog.Error("failed to create all tables", "error", err)
@@ -8,23 +8,37 @@ import (
 	"os"
 	"strconv"
 	"sync"
+	"time"
 
-	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/larek-tech/diploma/data/internal/config"
 	documentService "github.com/larek-tech/diploma/data/internal/domain/document/service"
+	embeddingService "github.com/larek-tech/diploma/data/internal/domain/embedding/service"
+	"github.com/larek-tech/diploma/data/internal/domain/file/archive"
+	objectStoreService "github.com/larek-tech/diploma/data/internal/domain/object_store/service"
 	questionService "github.com/larek-tech/diploma/data/internal/domain/question/service"
 	"github.com/larek-tech/diploma/data/internal/domain/site/service/crawler"
+	sitemap "github.com/larek-tech/diploma/data/internal/domain/sitemap/service"
+	sourceService "github.com/larek-tech/diploma/data/internal/domain/source/service"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/backend"
 	"github.com/larek-tech/diploma/data/internal/infrastructure/kafka"
 	"github.com/larek-tech/diploma/data/internal/infrastructure/ocr"
-	"github.com/larek-tech/diploma/data/internal/infrastructure/ollama"
 	"github.com/larek-tech/diploma/data/internal/infrastructure/s3"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/secret"
 	chunkStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/chunk"
 	documentStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/document"
+	embeddingStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/embedding"
+	embeddingModelStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/embedding_model"
 	fileStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/file"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/storage/filejob"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/storage/hostlimit"
+	objectStoreStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/object_store"
 	pageStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/page"
 	questionStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/question"
 	siteStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/site"
 	"github.com/larek-tech/diploma/data/internal/infrastructure/storage/sitejob"
+	sourceStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/source"
+	structuredStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/structured"
 	"github.com/larek-tech/diploma/data/pkg/metric"
 	"github.com/otiai10/gosseract"
 	"github.com/yogenyslav/pkg/infrastructure/tracing"
@@ -32,8 +46,10 @@ import (
 	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
 	"github.com/larek-tech/diploma/data/internal/worker/qaas/embed_document"
 	"github.com/larek-tech/diploma/data/internal/worker/qaas/parse_page"
+	"github.com/larek-tech/diploma/data/internal/worker/qaas/parse_s3"
 	"github.com/larek-tech/diploma/data/internal/worker/qaas/parse_site"
 	"github.com/larek-tech/diploma/data/internal/worker/qaas/parse_site_status"
+	"github.com/larek-tech/diploma/data/internal/worker/qaas/reembed"
 	"github.com/larek-tech/storage/postgres"
 )
 
@@ -47,7 +63,7 @@ func main() {
 
 func run() int {
 	ctx := context.Background()
-	exporter, err := tracing.NewExporter(ctx, getTracingEndpoint())
+	exporter, err := tracing.NewExporter(ctx, config.TracingEndpoint())
 	if err != nil {
 		slog.Error(err.Error())
 		return -1
@@ -64,9 +80,10 @@ func run() int {
 	}
 	tracer := provider.Tracer(serviceName)
 
-	var pgCfg postgres.Cfg
-	if err := cleanenv.ReadEnv(&pgCfg); err != nil {
-		slog.Error("failed to read env", "error", err)
+	pgCfg, err := config.Postgres()
+	if err != nil {
+		slog.Error(err.Error())
+		return -1
 	}
 
 	pg, trManager, err := postgres.New(ctx, pgCfg,
@@ -83,9 +100,9 @@ func run() int {
 		slog.Error("Failed to get SQL connection")
 		return 1
 	}
-	var kafkaCfg kafka.Config
-	if err := cleanenv.ReadEnv(&kafkaCfg); err != nil {
-		slog.Error("failed to read env", "error", err)
+	kafkaCfg, err := config.Kafka()
+	if err != nil {
+		slog.Error(err.Error())
 		return -1
 	}
 	kafkaProducer, err := kafka.NewProducer(kafkaCfg)
@@ -102,24 +119,11 @@ func run() int {
 		},
 		Jar: nil,
 	}
-	endpoint, llmModel, contextSize := getLLMConfig()
-	llm, err := ollama.New(endpoint, &ollama.Config{
-		LLMModel:       llmModel,
-		LLMContextSize: contextSize,
-	})
+	llm, err := backend.NewLLM(getLLMConfig())
 	if err != nil {
 		slog.Error("failed to create LLM", "error", err)
 		return -1
 	}
-	embedderURL, embedderModel, embeddingsSize := getEmbedderConfig()
-	embedderService, err := ollama.New(embedderURL, &ollama.Config{
-		EmbeddingSize:   embeddingsSize,
-		EmbeddingsModel: embedderModel,
-	})
-	if err != nil {
-		slog.Error("failed to create embedder", "error", err)
-		return -1
-	}
 	pub := qaas.NewPublisher(sqlDB)
 	err = pub.CreateAllTables([]qaas.Queue{
 		qaas.ParseSiteQueue,
@@ -127,13 +131,16 @@ func run() int {
 		qaas.ParsePageQueue,
 		qaas.EmbedResultQueue,
 		qaas.ParseSiteStatusQueue,
+		qaas.ParseFileQueue,
+		qaas.ParseS3Queue,
+		qaas.ReembedQueue,
 	})
 	if err != nil {
 		slog.Error("failed to create tables", "error", err)
 		return -1
 	}
 
-	objectStorage, err := s3.New(getS3Credentials())
+	objectStorage, err := s3.New(config.S3Credentials())
 	if err != nil {
 		slog.Error("failed to create s3 client", "error", err)
 		return -1
@@ -159,10 +166,29 @@ func run() int {
 	chunkStore := chunkStorage.New(pg, trManager)
 	pageStore := pageStorage.New(pg, objectStorage)
 	siteJobStore := sitejob.New(pg)
-	pageService := crawler.New(httpClient, siteStore, pageStore, siteJobStore, trManager, tracer)
-	questionSrv := questionService.New(llm, embedderService)
-	embeddingService := documentService.New(documentStore, chunkStore, questionStore, questionSrv, embedderService, ocr, trManager, tracer)
-	consumer := qaas.NewConsumer(sqlDB)
+	secrets, err := secret.New(os.Getenv("OBJECT_STORAGE_ENCRYPTION_KEY"))
+	if err != nil {
+		slog.Error("failed to create object storage secret cipher, set OBJECT_STORAGE_ENCRYPTION_KEY generated by `openssl rand -base64 32`", "error", err)
+		return -1
+	}
+	objectStore := objectStoreStorage.NewStorage(pg, secrets)
+	bucketService := objectStoreService.New(objectStore, fileStorage, pub, trManager, tracer)
+	pageService := crawler.New(getCrawlerConfig(), httpClient, siteStore, pageStore, siteJobStore, hostlimit.New(pg), trManager, tracer)
+	embeddingModelStore := embeddingModelStorage.New(pg, trManager)
+	embedders := embeddingService.NewRegistry(embeddingModelStore)
+	cachedEmbedder, err := config.RegisterEmbedders(ctx, embedders, embeddingStorage.New(pg, trManager), tracer)
+	if err != nil {
+		slog.Error("failed to configure embedding models", "error", err)
+		return -1
+	}
+	questionSrv := questionService.New(llm, cachedEmbedder)
+	sourceStore := sourceStorage.New(pg)
+	structuredStore := structuredStorage.New(pg, trManager)
+	documentSrv := documentService.New(documentStore, sourceStore, chunkStore, structuredStore, questionStore, questionSrv, embedders, cachedEmbedder, ocr, trManager, tracer)
+	// сервис источников используется парсером только для переключения поколений индекса
+	srcService := sourceService.New(sourceStore, fileStorage, pageStore, objectStore, sitemap.New(), pub, trManager, tracer, archive.DefaultLimits())
+	fileJobStore := filejob.New(pg, trManager)
+	consumer := qaas.NewConsumer(sqlDB, qaas.WithRetryPolicy(config.RetryPolicy()))
 
 	slog.Info("Starting consumer")
 	wg := &sync.WaitGroup{}
@@ -176,7 +202,7 @@ func run() int {
 	// site parser
 	go func() {
 		defer wg.Done()
-		err = consumer.Run(ctx, qaas.ParseSiteQueue, parse_site.New(siteStore, pub))
+		err = consumer.Run(ctx, qaas.ParseSiteQueue, parse_site.New(siteStore, siteJobStore, sourceStore, pub))
 		if err != nil {
 			slog.Error("failed to run consumer", "error", err)
 		}
@@ -185,7 +211,7 @@ func run() int {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		err = consumer.Run(ctx, qaas.ParsePageQueue, parse_page.New(pageStore, pageService, pub, tracer))
+		err = consumer.Run(ctx, qaas.ParsePageQueue, parse_page.New(pageStore, pageService, sourceStore, pub, tracer))
 		if err != nil {
 			slog.Error("failed to run consumer", "error", err)
 		}
@@ -194,7 +220,24 @@ func run() int {
 
 	go func() {
 		defer wg.Done()
-		err = consumer.Run(ctx, qaas.ParsePageResultQueue, embed_document.New(embeddingService, pageStore, siteStore, fileStorage))
+		err = consumer.Run(ctx, qaas.ParsePageResultQueue, embed_document.New(documentSrv, pageStore, siteStore, fileStorage, fileJobStore, srcService, kafkaProducer))
+		if err != nil {
+			slog.Error("failed to run consumer", "error", err)
+		}
+	}()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err = consumer.Run(ctx, qaas.ParseFileQueue, embed_document.New(documentSrv, pageStore, siteStore, fileStorage, fileJobStore, srcService, kafkaProducer))
+		if err != nil {
+			slog.Error("failed to run consumer", "error", err)
+		}
+	}()
+	wg.Add(1)
+	// s3 bucket sync
+	go func() {
+		defer wg.Done()
+		err = consumer.Run(ctx, qaas.ParseS3Queue, parse_s3.New(bucketService, objectStore, srcService, sourceStore, kafkaProducer, tracer))
 		if err != nil {
 			slog.Error("failed to run consumer", "error", err)
 		}
@@ -202,15 +245,16 @@ func run() int {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		err = consumer.Run(ctx, qaas.ParseFileQueue, embed_document.New(embeddingService, pageStore, siteStore, fileStorage))
+		err = consumer.Run(ctx, qaas.ParseSiteStatusQueue, parse_site_status.New(pub, siteJobStore, pageService, srcService, kafkaProducer))
 		if err != nil {
 			slog.Error("failed to run consumer", "error", err)
 		}
 	}()
 	wg.Add(1)
+	// пересчет векторов в новую модель эмбеддингов
 	go func() {
 		defer wg.Done()
-		err = consumer.Run(ctx, qaas.ParseSiteStatusQueue, parse_site_status.New(pub, siteJobStore, kafkaProducer))
+		err = consumer.Run(ctx, qaas.ReembedQueue, reembed.New(embeddingModelStore, embedders, pub, tracer))
 		if err != nil {
 			slog.Error("failed to run consumer", "error", err)
 		}
@@ -226,54 +270,50 @@ func getSqlCon(db *postgres.DB) *sql.DB {
 	return sqlCon
 }
 
-func getEmbedderConfig() (string, string, int) {
-	host := os.Getenv("OLLAMA_EMBEDDER_ENDPOINT")
-	if host == "" {
-		host = "http://localhost:11434"
+func getLLMConfig() backend.Config {
+	cfg := backend.Config{
+		Backend:  backend.Backend(config.Env("LLM_BACKEND")),
+		Endpoint: config.Env("LLM_ENDPOINT", "OLLAMA_LLM_ENDPOINT"),
+		APIKey:   config.Env("LLM_API_KEY"),
+		Model:    config.Env("LLM_MODEL", "OLLAMA_LLM_MODEL"),
 	}
-	model := os.Getenv("OLLAMA_EMBEDDER_MODEL")
-	if model == "" {
-		model = "bge-m3:latest"
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = "http://localhost:11434"
 	}
-	embeddingSize := os.Getenv("OLLAMA_EMBEDDER_SIZE")
-	size, err := strconv.Atoi(embeddingSize)
+	if cfg.Model == "" {
+		cfg.Model = "llama3:latest"
+	}
+	var err error
+	cfg.ContextSize, err = strconv.Atoi(config.Env("OLLAMA_LLM_CONTEXT_SIZE"))
 	if err != nil {
-		size = 514
+		cfg.ContextSize = 32000
 	}
-
-	return host, model, size
+	return cfg
 }
 
-func getLLMConfig() (string, string, int) {
-	host := os.Getenv("OLLAMA_LLM_ENDPOINT")
-	if host == "" {
-		host = "http://localhost:11434"
-	}
-	model := os.Getenv("OLLAMA_LLM_MODEL")
-	if model == "" {
-		model = "llama3:latest"
+func getCrawlerConfig() crawler.Config {
+	maxDepth, err := strconv.Atoi(os.Getenv("CRAWLER_MAX_DEPTH"))
+	if err != nil {
+		maxDepth = 5
 	}
-	contextSize := os.Getenv("OLLAMA_LLM_CONTEXT_SIZE")
-	size, err := strconv.Atoi(contextSize)
+	maxPages, err := strconv.Atoi(os.Getenv("CRAWLER_MAX_PAGES"))
 	if err != nil {
-		size = 32000
+		maxPages = 5000
 	}
 
-	return host, model, size
-}
-
-func getS3Credentials() s3.Credentials {
-	endpoint := os.Getenv("S3_ENDPOINT")
-	if endpoint == "" {
-		endpoint = "localhost:9000"
+	hostConcurrency, err := strconv.Atoi(os.Getenv("CRAWLER_HOST_CONCURRENCY"))
+	if err != nil {
+		hostConcurrency = 2
+	}
+	hostRequestInterval, err := time.ParseDuration(os.Getenv("CRAWLER_HOST_REQUEST_INTERVAL"))
+	if err != nil {
+		hostRequestInterval = time.Millisecond * 500
 	}
-	return s3.NewCredentials(endpoint, os.Getenv("S3_ACCESS_KEY_ID"), os.Getenv("S3_SECRET_ACCESS_KEY"), true)
-}
 
-func getTracingEndpoint() string {
-	tracingEndpoint := os.Getenv("TRACING_ENDPOINT")
-	if tracingEndpoint == "" {
-		tracingEndpoint = "localhost:4318"
+	return crawler.Config{
+		MaxDepth:            maxDepth,
+		MaxPages:            maxPages,
+		HostConcurrency:     hostConcurrency,
+		HostRequestInterval: hostRequestInterval,
 	}
-	return tracingEndpoint
 }
@@ -0,0 +1,54 @@
+// Package config читает общие настройки сервисов data из переменных окружения.
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/kafka"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/s3"
+	"github.com/larek-tech/storage/postgres"
+)
+
+// Env возвращает значение первой заданной переменной окружения.
+func Env(keys ...string) string {
+	for _, key := range keys {
+		if value := os.Getenv(key); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func Postgres() (*postgres.Cfg, error) {
+	var cfg postgres.Cfg
+	if err := cleanenv.ReadEnv(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to read postgresql config: %w", err)
+	}
+	return &cfg, nil
+}
+
+func Kafka() (kafka.Config, error) {
+	var cfg kafka.Config
+	if err := cleanenv.ReadEnv(&cfg); err != nil {
+		return cfg, fmt.Errorf("failed to read kafka config: %w", err)
+	}
+	return cfg, nil
+}
+
+func S3Credentials() s3.Credentials {
+	endpoint := os.Getenv("S3_ENDPOINT")
+	if endpoint == "" {
+		endpoint = "localhost:9000"
+	}
+	return s3.NewCredentials(endpoint, os.Getenv("S3_ACCESS_KEY_ID"), os.Getenv("S3_SECRET_ACCESS_KEY"), true)
+}
+
+func TracingEndpoint() string {
+	tracingEndpoint := os.Getenv("TRACING_ENDPOINT")
+	if tracingEndpoint == "" {
+		tracingEndpoint = "localhost:4318"
+	}
+	return tracingEndpoint
+}
@@ -0,0 +1,107 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/larek-tech/diploma/data/internal/domain/embedding"
+	embeddingService "github.com/larek-tech/diploma/data/internal/domain/embedding/service"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/backend"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/ollama"
+	embeddingStorage "github.com/larek-tech/diploma/data/internal/infrastructure/storage/embedding"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func Embedder() backend.Config {
+	cfg := backend.Config{
+		Backend:  backend.Backend(Env("EMBEDDER_BACKEND")),
+		Endpoint: Env("EMBEDDER_ENDPOINT", "OLLAMA_EMBEDDER_ENDPOINT"),
+		APIKey:   Env("EMBEDDER_API_KEY"),
+		Model:    Env("EMBEDDER_MODEL", "OLLAMA_EMBEDDER_MODEL"),
+	}
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = "http://localhost:11434"
+	}
+	if cfg.Model == "" {
+		cfg.Model = "bge-m3:latest"
+	}
+	var err error
+	cfg.ContextSize, err = strconv.Atoi(Env("OLLAMA_EMBEDDER_SIZE"))
+	if err != nil {
+		cfg.ContextSize = 514
+	}
+	cfg.Dimensions, err = strconv.Atoi(Env("EMBEDDER_DIMENSIONS"))
+	if err != nil {
+		cfg.Dimensions = 0
+	}
+	cfg.BatchSize, err = strconv.Atoi(Env("EMBEDDER_BATCH_SIZE", "OLLAMA_EMBEDDER_BATCH_SIZE"))
+	if err != nil {
+		cfg.BatchSize = ollama.EmbedBatchSize
+	}
+	cfg.Concurrency, err = strconv.Atoi(Env("EMBEDDER_CONCURRENCY", "OLLAMA_EMBEDDER_CONCURRENCY"))
+	if err != nil {
+		cfg.Concurrency = ollama.EmbedConcurrency
+	}
+	return cfg
+}
+
+// NextEmbedder читает настройки следующей модели эмбеддингов из EMBEDDER_NEXT_*,
+// незаданные значения берутся у основной модели. false - если следующая модель не задана.
+func NextEmbedder() (backend.Config, bool) {
+	cfg := Embedder()
+	cfg.Model = Env("EMBEDDER_NEXT_MODEL")
+	if cfg.Model == "" {
+		return cfg, false
+	}
+	if b := Env("EMBEDDER_NEXT_BACKEND"); b != "" {
+		cfg.Backend = backend.Backend(b)
+	}
+	if endpoint := Env("EMBEDDER_NEXT_ENDPOINT"); endpoint != "" {
+		cfg.Endpoint = endpoint
+	}
+	if apiKey := Env("EMBEDDER_NEXT_API_KEY"); apiKey != "" {
+		cfg.APIKey = apiKey
+	}
+	if dimensions, err := strconv.Atoi(Env("EMBEDDER_NEXT_DIMENSIONS")); err == nil {
+		cfg.Dimensions = dimensions
+	}
+	return cfg, true
+}
+
+// RegisterEmbedders регистрирует основную модель эмбеддингов и следующую, если она задана,
+// и возвращает эмбеддер основной модели с кэшем.
+// Поиск выполняется активной моделью, поэтому набор моделей у краулера и парсера совпадает.
+func RegisterEmbedders(ctx context.Context, registry *embeddingService.Registry, cache *embeddingStorage.Cache, tracer trace.Tracer) (*embeddingService.Service, error) {
+	primary, err := registerEmbedder(ctx, registry, Embedder(), "EMBEDDER", cache, tracer)
+	if err != nil {
+		return nil, err
+	}
+	if cfg, ok := NextEmbedder(); ok {
+		if _, err = registerEmbedder(ctx, registry, cfg, "EMBEDDER_NEXT", cache, tracer); err != nil {
+			return nil, err
+		}
+	}
+	return primary, nil
+}
+
+// registerEmbedder регистрирует модель, версия и тип индекса читаются из <prefix>_MODEL_VERSION и <prefix>_INDEX.
+func registerEmbedder(ctx context.Context, registry *embeddingService.Registry, cfg backend.Config, prefix string, cache *embeddingStorage.Cache, tracer trace.Tracer) (*embeddingService.Service, error) {
+	index, err := embedding.ParseIndexType(Env(prefix + "_INDEX"))
+	if err != nil {
+		return nil, err
+	}
+	raw, err := backend.NewEmbedder(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create embedder %s: %w", cfg.Model, err)
+	}
+	version := Env(prefix + "_MODEL_VERSION")
+	embedder := embeddingService.New(raw, cache, version, cfg.Dimensions, tracer)
+	model, err := registry.Register(ctx, cfg.Model, version, index, embedder)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("embedding model configured", "model", model.Key(), "dimensions", model.Dimensions, "index", model.IndexType, "active", model.Active)
+	return embedder, nil
+}
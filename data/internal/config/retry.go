@@ -0,0 +1,24 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
+)
+
+// RetryPolicy читает политику повторов задач очередей, незаданные значения берутся из qaas.DefaultRetryPolicy.
+func RetryPolicy() qaas.RetryPolicy {
+	policy := qaas.DefaultRetryPolicy()
+	if maxAttempts, err := strconv.Atoi(os.Getenv("QAAS_MAX_ATTEMPTS")); err == nil && maxAttempts > 0 {
+		policy.MaxAttempts = maxAttempts
+	}
+	if baseDelay, err := time.ParseDuration(os.Getenv("QAAS_RETRY_BASE_DELAY")); err == nil {
+		policy.BaseDelay = baseDelay
+	}
+	if maxDelay, err := time.ParseDuration(os.Getenv("QAAS_RETRY_MAX_DELAY")); err == nil {
+		policy.MaxDelay = maxDelay
+	}
+	return policy
+}
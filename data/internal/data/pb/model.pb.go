@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,32 +22,2950 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AggregateFunction int32
+
+const (
+	AggregateFunction_AGGREGATE_UNDEFINED AggregateFunction = 0
+	AggregateFunction_AGGREGATE_COUNT     AggregateFunction = 1
+	AggregateFunction_AGGREGATE_SUM       AggregateFunction = 2
+	AggregateFunction_AGGREGATE_AVG       AggregateFunction = 3
+	AggregateFunction_AGGREGATE_MIN       AggregateFunction = 4
+	AggregateFunction_AGGREGATE_MAX       AggregateFunction = 5
+)
+
+// Enum value maps for AggregateFunction.
+var (
+	AggregateFunction_name = map[int32]string{
+		0: "AGGREGATE_UNDEFINED",
+		1: "AGGREGATE_COUNT",
+		2: "AGGREGATE_SUM",
+		3: "AGGREGATE_AVG",
+		4: "AGGREGATE_MIN",
+		5: "AGGREGATE_MAX",
+	}
+	AggregateFunction_value = map[string]int32{
+		"AGGREGATE_UNDEFINED": 0,
+		"AGGREGATE_COUNT":     1,
+		"AGGREGATE_SUM":       2,
+		"AGGREGATE_AVG":       3,
+		"AGGREGATE_MIN":       4,
+		"AGGREGATE_MAX":       5,
+	}
+)
+
+func (x AggregateFunction) Enum() *AggregateFunction {
+	p := new(AggregateFunction)
+	*p = x
+	return p
+}
+
+func (x AggregateFunction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AggregateFunction) Descriptor() protoreflect.EnumDescriptor {
+	return file_data_v1_model_proto_enumTypes[0].Descriptor()
+}
+
+func (AggregateFunction) Type() protoreflect.EnumType {
+	return &file_data_v1_model_proto_enumTypes[0]
+}
+
+func (x AggregateFunction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AggregateFunction.Descriptor instead.
+func (AggregateFunction) EnumDescriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{0}
+}
+
+type FilterOperator int32
+
+const (
+	FilterOperator_FILTER_UNDEFINED FilterOperator = 0
+	FilterOperator_FILTER_EQ        FilterOperator = 1
+	FilterOperator_FILTER_NE        FilterOperator = 2
+	FilterOperator_FILTER_GT        FilterOperator = 3
+	FilterOperator_FILTER_GTE       FilterOperator = 4
+	FilterOperator_FILTER_LT        FilterOperator = 5
+	FilterOperator_FILTER_LTE       FilterOperator = 6
+	FilterOperator_FILTER_IN        FilterOperator = 7
+)
+
+// Enum value maps for FilterOperator.
+var (
+	FilterOperator_name = map[int32]string{
+		0: "FILTER_UNDEFINED",
+		1: "FILTER_EQ",
+		2: "FILTER_NE",
+		3: "FILTER_GT",
+		4: "FILTER_GTE",
+		5: "FILTER_LT",
+		6: "FILTER_LTE",
+		7: "FILTER_IN",
+	}
+	FilterOperator_value = map[string]int32{
+		"FILTER_UNDEFINED": 0,
+		"FILTER_EQ":        1,
+		"FILTER_NE":        2,
+		"FILTER_GT":        3,
+		"FILTER_GTE":       4,
+		"FILTER_LT":        5,
+		"FILTER_LTE":       6,
+		"FILTER_IN":        7,
+	}
+)
+
+func (x FilterOperator) Enum() *FilterOperator {
+	p := new(FilterOperator)
+	*p = x
+	return p
+}
+
+func (x FilterOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FilterOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_data_v1_model_proto_enumTypes[1].Descriptor()
+}
+
+func (FilterOperator) Type() protoreflect.EnumType {
+	return &file_data_v1_model_proto_enumTypes[1]
+}
+
+func (x FilterOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FilterOperator.Descriptor instead.
+func (FilterOperator) EnumDescriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{1}
+}
+
+type IngestionState int32
+
+const (
+	IngestionState_INGESTION_UNDEFINED IngestionState = 0
+	IngestionState_INGESTION_QUEUED    IngestionState = 1
+	IngestionState_INGESTION_FETCHED   IngestionState = 2
+	IngestionState_INGESTION_PARSED    IngestionState = 3
+	IngestionState_INGESTION_EMBEDDED  IngestionState = 4
+	IngestionState_INGESTION_SKIPPED   IngestionState = 5
+	IngestionState_INGESTION_FAILED    IngestionState = 6
+)
+
+// Enum value maps for IngestionState.
+var (
+	IngestionState_name = map[int32]string{
+		0: "INGESTION_UNDEFINED",
+		1: "INGESTION_QUEUED",
+		2: "INGESTION_FETCHED",
+		3: "INGESTION_PARSED",
+		4: "INGESTION_EMBEDDED",
+		5: "INGESTION_SKIPPED",
+		6: "INGESTION_FAILED",
+	}
+	IngestionState_value = map[string]int32{
+		"INGESTION_UNDEFINED": 0,
+		"INGESTION_QUEUED":    1,
+		"INGESTION_FETCHED":   2,
+		"INGESTION_PARSED":    3,
+		"INGESTION_EMBEDDED":  4,
+		"INGESTION_SKIPPED":   5,
+		"INGESTION_FAILED":    6,
+	}
+)
+
+func (x IngestionState) Enum() *IngestionState {
+	p := new(IngestionState)
+	*p = x
+	return p
+}
+
+func (x IngestionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IngestionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_data_v1_model_proto_enumTypes[2].Descriptor()
+}
+
+func (IngestionState) Type() protoreflect.EnumType {
+	return &file_data_v1_model_proto_enumTypes[2]
+}
+
+func (x IngestionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IngestionState.Descriptor instead.
+func (IngestionState) EnumDescriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{2}
+}
+
+type IngestionObjectType int32
+
+const (
+	IngestionObjectType_OBJECT_UNDEFINED IngestionObjectType = 0
+	IngestionObjectType_OBJECT_PAGE      IngestionObjectType = 1
+	IngestionObjectType_OBJECT_FILE      IngestionObjectType = 2
+)
+
+// Enum value maps for IngestionObjectType.
+var (
+	IngestionObjectType_name = map[int32]string{
+		0: "OBJECT_UNDEFINED",
+		1: "OBJECT_PAGE",
+		2: "OBJECT_FILE",
+	}
+	IngestionObjectType_value = map[string]int32{
+		"OBJECT_UNDEFINED": 0,
+		"OBJECT_PAGE":      1,
+		"OBJECT_FILE":      2,
+	}
+)
+
+func (x IngestionObjectType) Enum() *IngestionObjectType {
+	p := new(IngestionObjectType)
+	*p = x
+	return p
+}
+
+func (x IngestionObjectType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IngestionObjectType) Descriptor() protoreflect.EnumDescriptor {
+	return file_data_v1_model_proto_enumTypes[3].Descriptor()
+}
+
+func (IngestionObjectType) Type() protoreflect.EnumType {
+	return &file_data_v1_model_proto_enumTypes[3]
+}
+
+func (x IngestionObjectType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IngestionObjectType.Descriptor instead.
+func (IngestionObjectType) EnumDescriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{3}
+}
+
+// HybridSearch fuses full-text and vector ranks with reciprocal rank fusion:
+// score = vectorWeight / (rrfK + vector rank) + lexicalWeight / (rrfK + lexical rank)
+type HybridSearch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VectorWeight  float32                `protobuf:"fixed32,1,opt,name=vectorWeight,proto3" json:"vectorWeight,omitempty"` // both weights default to 1 if unset
+	LexicalWeight float32                `protobuf:"fixed32,2,opt,name=lexicalWeight,proto3" json:"lexicalWeight,omitempty"`
+	RrfK          uint32                 `protobuf:"varint,3,opt,name=rrfK,proto3" json:"rrfK,omitempty"`             // default 60
+	Candidates    uint32                 `protobuf:"varint,4,opt,name=candidates,proto3" json:"candidates,omitempty"` // chunks taken from each ranking, default max(4 * topK, 20)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HybridSearch) Reset() {
+	*x = HybridSearch{}
+	mi := &file_data_v1_model_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HybridSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HybridSearch) ProtoMessage() {}
+
+func (x *HybridSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HybridSearch.ProtoReflect.Descriptor instead.
+func (*HybridSearch) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HybridSearch) GetVectorWeight() float32 {
+	if x != nil {
+		return x.VectorWeight
+	}
+	return 0
+}
+
+func (x *HybridSearch) GetLexicalWeight() float32 {
+	if x != nil {
+		return x.LexicalWeight
+	}
+	return 0
+}
+
+func (x *HybridSearch) GetRrfK() uint32 {
+	if x != nil {
+		return x.RrfK
+	}
+	return 0
+}
+
+func (x *HybridSearch) GetCandidates() uint32 {
+	if x != nil {
+		return x.Candidates
+	}
+	return 0
+}
+
+// NeighborExpansion adds surrounding chunks of the same document to each hit,
+// hits whose neighbourhoods overlap or touch are merged into one passage
+type NeighborExpansion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        uint32                 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"` // chunks taken before and after each hit, at most 5
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NeighborExpansion) Reset() {
+	*x = NeighborExpansion{}
+	mi := &file_data_v1_model_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NeighborExpansion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NeighborExpansion) ProtoMessage() {}
+
+func (x *NeighborExpansion) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NeighborExpansion.ProtoReflect.Descriptor instead.
+func (*NeighborExpansion) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NeighborExpansion) GetWindow() uint32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+// Diversification re-ranks candidates with maximal marginal relevance
+type Diversification struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Lambda         *float32               `protobuf:"fixed32,1,opt,name=lambda,proto3,oneof" json:"lambda,omitempty"`          // relevance weight in [0, 1], 1 keeps the relevance order, default 0.5
+	MaxPerDocument uint32                 `protobuf:"varint,2,opt,name=maxPerDocument,proto3" json:"maxPerDocument,omitempty"` // no cap if unset
+	Candidates     uint32                 `protobuf:"varint,3,opt,name=candidates,proto3" json:"candidates,omitempty"`         // chunks to re-rank, default max(4 * topK, 20)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Diversification) Reset() {
+	*x = Diversification{}
+	mi := &file_data_v1_model_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Diversification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diversification) ProtoMessage() {}
+
+func (x *Diversification) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diversification.ProtoReflect.Descriptor instead.
+func (*Diversification) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Diversification) GetLambda() float32 {
+	if x != nil && x.Lambda != nil {
+		return *x.Lambda
+	}
+	return 0
+}
+
+func (x *Diversification) GetMaxPerDocument() uint32 {
+	if x != nil {
+		return x.MaxPerDocument
+	}
+	return 0
+}
+
+func (x *Diversification) GetCandidates() uint32 {
+	if x != nil {
+		return x.Candidates
+	}
+	return 0
+}
+
+// SearchFilter narrows the chunks before ranking, conditions are combined with AND
+type SearchFilter struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Types              []string               `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`             // web.page, file
+	Extensions         []string               `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`   // .pdf, docx
+	UrlPrefixes        []string               `protobuf:"bytes,3,rep,name=urlPrefixes,proto3" json:"urlPrefixes,omitempty"` // page url or file path inside the source
+	DateFrom           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=dateFrom,proto3,oneof" json:"dateFrom,omitempty"` // page Last-Modified or file upload time
+	DateTo             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dateTo,proto3,oneof" json:"dateTo,omitempty"`
+	Languages          []string               `protobuf:"bytes,6,rep,name=languages,proto3" json:"languages,omitempty"`                   // ru, en
+	DocumentPredicates []string               `protobuf:"bytes,7,rep,name=documentPredicates,proto3" json:"documentPredicates,omitempty"` // jsonpath predicates on document metadata, e.g. $.pages > 10
+	ChunkPredicates    []string               `protobuf:"bytes,8,rep,name=chunkPredicates,proto3" json:"chunkPredicates,omitempty"`       // jsonpath predicates on chunk metadata
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SearchFilter) Reset() {
+	*x = SearchFilter{}
+	mi := &file_data_v1_model_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFilter) ProtoMessage() {}
+
+func (x *SearchFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFilter.ProtoReflect.Descriptor instead.
+func (*SearchFilter) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchFilter) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetExtensions() []string {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetUrlPrefixes() []string {
+	if x != nil {
+		return x.UrlPrefixes
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetDocumentPredicates() []string {
+	if x != nil {
+		return x.DocumentPredicates
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetChunkPredicates() []string {
+	if x != nil {
+		return x.ChunkPredicates
+	}
+	return nil
+}
+
 type VectorSearchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	SourceIds     []string               `protobuf:"bytes,2,rep,name=sourceIds,proto3" json:"sourceIds,omitempty"`
-	TopK          uint64                 `protobuf:"varint,3,opt,name=topK,proto3" json:"topK,omitempty"`
-	Threshold     float32                `protobuf:"fixed32,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	UseQuestions  bool                   `protobuf:"varint,5,opt,name=useQuestions,proto3" json:"useQuestions,omitempty"` // hypothetical questions
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	SourceIds     []string               `protobuf:"bytes,2,rep,name=sourceIds,proto3" json:"sourceIds,omitempty"`
+	TopK          uint64                 `protobuf:"varint,3,opt,name=topK,proto3" json:"topK,omitempty"`
+	Threshold     float32                `protobuf:"fixed32,4,opt,name=threshold,proto3" json:"threshold,omitempty"`      // applies to vector candidates only
+	UseQuestions  bool                   `protobuf:"varint,5,opt,name=useQuestions,proto3" json:"useQuestions,omitempty"` // hypothetical questions
+	Hybrid        *HybridSearch          `protobuf:"bytes,6,opt,name=hybrid,proto3,oneof" json:"hybrid,omitempty"`        // vector-only search if unset
+	Filter        *SearchFilter          `protobuf:"bytes,7,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	Diversify     *Diversification       `protobuf:"bytes,8,opt,name=diversify,proto3,oneof" json:"diversify,omitempty"` // relevance order if unset
+	Expand        *NeighborExpansion     `protobuf:"bytes,9,opt,name=expand,proto3,oneof" json:"expand,omitempty"`       // isolated chunks if unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VectorSearchRequest) Reset() {
+	*x = VectorSearchRequest{}
+	mi := &file_data_v1_model_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VectorSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VectorSearchRequest) ProtoMessage() {}
+
+func (x *VectorSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VectorSearchRequest.ProtoReflect.Descriptor instead.
+func (*VectorSearchRequest) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VectorSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *VectorSearchRequest) GetSourceIds() []string {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+func (x *VectorSearchRequest) GetTopK() uint64 {
+	if x != nil {
+		return x.TopK
+	}
+	return 0
+}
+
+func (x *VectorSearchRequest) GetThreshold() float32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *VectorSearchRequest) GetUseQuestions() bool {
+	if x != nil {
+		return x.UseQuestions
+	}
+	return false
+}
+
+func (x *VectorSearchRequest) GetHybrid() *HybridSearch {
+	if x != nil {
+		return x.Hybrid
+	}
+	return nil
+}
+
+func (x *VectorSearchRequest) GetFilter() *SearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *VectorSearchRequest) GetDiversify() *Diversification {
+	if x != nil {
+		return x.Diversify
+	}
+	return nil
+}
+
+func (x *VectorSearchRequest) GetExpand() *NeighborExpansion {
+	if x != nil {
+		return x.Expand
+	}
+	return nil
+}
+
+type DocumentChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Index         int64                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Metadata      []byte                 `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"` // encoded json<any,any>
+	Similarity    float32                `protobuf:"fixed32,5,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	Score         float32                `protobuf:"fixed32,6,opt,name=score,proto3" json:"score,omitempty"` // fused rank score in hybrid search
+	DocumentId    string                 `protobuf:"bytes,7,opt,name=documentId,proto3" json:"documentId,omitempty"`
+	EndIndex      int64                  `protobuf:"varint,8,opt,name=endIndex,proto3" json:"endIndex,omitempty"`         // index of the last chunk of an expanded passage, equals index otherwise
+	ChunkIds      []string               `protobuf:"bytes,9,rep,name=chunkIds,proto3" json:"chunkIds,omitempty"`          // chunks of an expanded passage in document order
+	DocumentName  string                 `protobuf:"bytes,10,opt,name=documentName,proto3" json:"documentName,omitempty"` // file name or page title, set in search results
+	DocumentUrl   string                 `protobuf:"bytes,11,opt,name=documentUrl,proto3" json:"documentUrl,omitempty"`   // page url or file path inside the source, set in search results
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DocumentChunk) Reset() {
+	*x = DocumentChunk{}
+	mi := &file_data_v1_model_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentChunk) ProtoMessage() {}
+
+func (x *DocumentChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentChunk.ProtoReflect.Descriptor instead.
+func (*DocumentChunk) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DocumentChunk) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DocumentChunk) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *DocumentChunk) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *DocumentChunk) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *DocumentChunk) GetSimilarity() float32 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *DocumentChunk) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *DocumentChunk) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *DocumentChunk) GetEndIndex() int64 {
+	if x != nil {
+		return x.EndIndex
+	}
+	return 0
+}
+
+func (x *DocumentChunk) GetChunkIds() []string {
+	if x != nil {
+		return x.ChunkIds
+	}
+	return nil
+}
+
+func (x *DocumentChunk) GetDocumentName() string {
+	if x != nil {
+		return x.DocumentName
+	}
+	return ""
+}
+
+func (x *DocumentChunk) GetDocumentUrl() string {
+	if x != nil {
+		return x.DocumentUrl
+	}
+	return ""
+}
+
+type VectorSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunks        []*DocumentChunk       `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VectorSearchResponse) Reset() {
+	*x = VectorSearchResponse{}
+	mi := &file_data_v1_model_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VectorSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VectorSearchResponse) ProtoMessage() {}
+
+func (x *VectorSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VectorSearchResponse.ProtoReflect.Descriptor instead.
+func (*VectorSearchResponse) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VectorSearchResponse) GetChunks() []*DocumentChunk {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+// BatchVectorSearchRequest runs the same search for several queries and fuses the rankings with RRF
+type BatchVectorSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queries       []string               `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"` // at most 10
+	SourceIds     []string               `protobuf:"bytes,2,rep,name=sourceIds,proto3" json:"sourceIds,omitempty"`
+	TopK          uint64                 `protobuf:"varint,3,opt,name=topK,proto3" json:"topK,omitempty"`                 // fused results
+	Threshold     float32                `protobuf:"fixed32,4,opt,name=threshold,proto3" json:"threshold,omitempty"`      // applies to vector candidates only
+	UseQuestions  bool                   `protobuf:"varint,5,opt,name=useQuestions,proto3" json:"useQuestions,omitempty"` // hypothetical questions
+	Hybrid        *HybridSearch          `protobuf:"bytes,6,opt,name=hybrid,proto3,oneof" json:"hybrid,omitempty"`        // vector-only search if unset
+	Filter        *SearchFilter          `protobuf:"bytes,7,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	Diversify     *Diversification       `protobuf:"bytes,8,opt,name=diversify,proto3,oneof" json:"diversify,omitempty"` // applied to the fused ranking
+	Expand        *NeighborExpansion     `protobuf:"bytes,9,opt,name=expand,proto3,oneof" json:"expand,omitempty"`       // applied to the fused ranking
+	RrfK          uint32                 `protobuf:"varint,10,opt,name=rrfK,proto3" json:"rrfK,omitempty"`               // smoothing constant of the fusion across queries, default 60
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchVectorSearchRequest) Reset() {
+	*x = BatchVectorSearchRequest{}
+	mi := &file_data_v1_model_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchVectorSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchVectorSearchRequest) ProtoMessage() {}
+
+func (x *BatchVectorSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchVectorSearchRequest.ProtoReflect.Descriptor instead.
+func (*BatchVectorSearchRequest) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchVectorSearchRequest) GetQueries() []string {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+func (x *BatchVectorSearchRequest) GetSourceIds() []string {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+func (x *BatchVectorSearchRequest) GetTopK() uint64 {
+	if x != nil {
+		return x.TopK
+	}
+	return 0
+}
+
+func (x *BatchVectorSearchRequest) GetThreshold() float32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *BatchVectorSearchRequest) GetUseQuestions() bool {
+	if x != nil {
+		return x.UseQuestions
+	}
+	return false
+}
+
+func (x *BatchVectorSearchRequest) GetHybrid() *HybridSearch {
+	if x != nil {
+		return x.Hybrid
+	}
+	return nil
+}
+
+func (x *BatchVectorSearchRequest) GetFilter() *SearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *BatchVectorSearchRequest) GetDiversify() *Diversification {
+	if x != nil {
+		return x.Diversify
+	}
+	return nil
+}
+
+func (x *BatchVectorSearchRequest) GetExpand() *NeighborExpansion {
+	if x != nil {
+		return x.Expand
+	}
+	return nil
+}
+
+func (x *BatchVectorSearchRequest) GetRrfK() uint32 {
+	if x != nil {
+		return x.RrfK
+	}
+	return 0
+}
+
+// QueryHit is the position of a chunk in the ranking of one query
+type QueryHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         uint32                 `protobuf:"varint,1,opt,name=query,proto3" json:"query,omitempty"` // index of the query in the request
+	Rank          uint32                 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`   // 1-based
+	Similarity    float32                `protobuf:"fixed32,3,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryHit) Reset() {
+	*x = QueryHit{}
+	mi := &file_data_v1_model_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryHit) ProtoMessage() {}
+
+func (x *QueryHit) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryHit.ProtoReflect.Descriptor instead.
+func (*QueryHit) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryHit) GetQuery() uint32 {
+	if x != nil {
+		return x.Query
+	}
+	return 0
+}
+
+func (x *QueryHit) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *QueryHit) GetSimilarity() float32 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+type BatchSearchChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         *DocumentChunk         `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"` // score is the fused score across queries
+	Hits          []*QueryHit            `protobuf:"bytes,2,rep,name=hits,proto3" json:"hits,omitempty"`   // queries that found the chunk, best rank first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSearchChunk) Reset() {
+	*x = BatchSearchChunk{}
+	mi := &file_data_v1_model_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSearchChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSearchChunk) ProtoMessage() {}
+
+func (x *BatchSearchChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSearchChunk.ProtoReflect.Descriptor instead.
+func (*BatchSearchChunk) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchSearchChunk) GetChunk() *DocumentChunk {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *BatchSearchChunk) GetHits() []*QueryHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+type BatchVectorSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunks        []*BatchSearchChunk    `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchVectorSearchResponse) Reset() {
+	*x = BatchVectorSearchResponse{}
+	mi := &file_data_v1_model_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchVectorSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchVectorSearchResponse) ProtoMessage() {}
+
+func (x *BatchVectorSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchVectorSearchResponse.ProtoReflect.Descriptor instead.
+func (*BatchVectorSearchResponse) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchVectorSearchResponse) GetChunks() []*BatchSearchChunk {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+type GetDocumentsIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
+	Size          uint32                 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Page          uint32                 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDocumentsIn) Reset() {
+	*x = GetDocumentsIn{}
+	mi := &file_data_v1_model_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentsIn) ProtoMessage() {}
+
+func (x *GetDocumentsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentsIn.ProtoReflect.Descriptor instead.
+func (*GetDocumentsIn) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetDocumentsIn) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *GetDocumentsIn) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetDocumentsIn) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type Document struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SourceId      string                 `protobuf:"bytes,2,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Metadata      string                 `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ObjectId      string                 `protobuf:"bytes,6,opt,name=objectId,proto3" json:"objectId,omitempty"`     // page or file the document was parsed from
+	ObjectType    string                 `protobuf:"bytes,7,opt,name=objectType,proto3" json:"objectType,omitempty"` // page or file
+	Extension     string                 `protobuf:"bytes,8,opt,name=extension,proto3" json:"extension,omitempty"`
+	Url           string                 `protobuf:"bytes,9,opt,name=url,proto3" json:"url,omitempty"` // page url or file path
+	Language      string                 `protobuf:"bytes,10,opt,name=language,proto3" json:"language,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=date,proto3" json:"date,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document) Reset() {
+	*x = Document{}
+	mi := &file_data_v1_model_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document) ProtoMessage() {}
+
+func (x *Document) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document.ProtoReflect.Descriptor instead.
+func (*Document) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Document) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Document) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *Document) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Document) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Document) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *Document) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *Document) GetObjectType() string {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ""
+}
+
+func (x *Document) GetExtension() string {
+	if x != nil {
+		return x.Extension
+	}
+	return ""
+}
+
+func (x *Document) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Document) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Document) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Document) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Document) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// source ids scope every document and chunk lookup, items of other sources are not found
+type GetDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SourceIds     []string               `protobuf:"bytes,2,rep,name=sourceIds,proto3" json:"sourceIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDocumentRequest) Reset() {
+	*x = GetDocumentRequest{}
+	mi := &file_data_v1_model_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentRequest) ProtoMessage() {}
+
+func (x *GetDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentRequest.ProtoReflect.Descriptor instead.
+func (*GetDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetDocumentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetDocumentRequest) GetSourceIds() []string {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+type GetChunksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"` // at most 50
+	SourceIds     []string               `protobuf:"bytes,2,rep,name=sourceIds,proto3" json:"sourceIds,omitempty"`
+	Window        uint32                 `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"` // neighbouring chunks returned before and after each requested one, at most 5
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChunksRequest) Reset() {
+	*x = GetChunksRequest{}
+	mi := &file_data_v1_model_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChunksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChunksRequest) ProtoMessage() {}
+
+func (x *GetChunksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChunksRequest.ProtoReflect.Descriptor instead.
+func (*GetChunksRequest) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetChunksRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *GetChunksRequest) GetSourceIds() []string {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+func (x *GetChunksRequest) GetWindow() uint32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+type GetChunksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunks        []*DocumentChunk       `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`         // requested chunks and their neighbours ordered by document and index
+	MissingIds    []string               `protobuf:"bytes,2,rep,name=missingIds,proto3" json:"missingIds,omitempty"` // requested ids that were not found
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChunksResponse) Reset() {
+	*x = GetChunksResponse{}
+	mi := &file_data_v1_model_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChunksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChunksResponse) ProtoMessage() {}
+
+func (x *GetChunksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChunksResponse.ProtoReflect.Descriptor instead.
+func (*GetChunksResponse) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetChunksResponse) GetChunks() []*DocumentChunk {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+func (x *GetChunksResponse) GetMissingIds() []string {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+type DownloadOriginalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    string                 `protobuf:"bytes,1,opt,name=documentId,proto3" json:"documentId,omitempty"`
+	SourceIds     []string               `protobuf:"bytes,2,rep,name=sourceIds,proto3" json:"sourceIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadOriginalRequest) Reset() {
+	*x = DownloadOriginalRequest{}
+	mi := &file_data_v1_model_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadOriginalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadOriginalRequest) ProtoMessage() {}
+
+func (x *DownloadOriginalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadOriginalRequest.ProtoReflect.Descriptor instead.
+func (*DownloadOriginalRequest) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DownloadOriginalRequest) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *DownloadOriginalRequest) GetSourceIds() []string {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+type OriginalInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	ObjectType    string                 `protobuf:"bytes,4,opt,name=objectType,proto3" json:"objectType,omitempty"` // file or page for a raw html snapshot
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OriginalInfo) Reset() {
+	*x = OriginalInfo{}
+	mi := &file_data_v1_model_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OriginalInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OriginalInfo) ProtoMessage() {}
+
+func (x *OriginalInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OriginalInfo.ProtoReflect.Descriptor instead.
+func (*OriginalInfo) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OriginalInfo) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *OriginalInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *OriginalInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *OriginalInfo) GetObjectType() string {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ""
+}
+
+// the first message of the stream carries info, the following ones carry data
+type DownloadOriginalResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*DownloadOriginalResponse_Info
+	//	*DownloadOriginalResponse_Data
+	Payload       isDownloadOriginalResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadOriginalResponse) Reset() {
+	*x = DownloadOriginalResponse{}
+	mi := &file_data_v1_model_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadOriginalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadOriginalResponse) ProtoMessage() {}
+
+func (x *DownloadOriginalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadOriginalResponse.ProtoReflect.Descriptor instead.
+func (*DownloadOriginalResponse) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DownloadOriginalResponse) GetPayload() isDownloadOriginalResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DownloadOriginalResponse) GetInfo() *OriginalInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadOriginalResponse_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *DownloadOriginalResponse) GetData() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadOriginalResponse_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+type isDownloadOriginalResponse_Payload interface {
+	isDownloadOriginalResponse_Payload()
+}
+
+type DownloadOriginalResponse_Info struct {
+	Info *OriginalInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type DownloadOriginalResponse_Data struct {
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*DownloadOriginalResponse_Info) isDownloadOriginalResponse_Payload() {}
+
+func (*DownloadOriginalResponse_Data) isDownloadOriginalResponse_Payload() {}
+
+type GetDocumentsOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          uint32                 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Page          uint32                 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Total         uint32                 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Documents     []*Document            `protobuf:"bytes,4,rep,name=documents,proto3" json:"documents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDocumentsOut) Reset() {
+	*x = GetDocumentsOut{}
+	mi := &file_data_v1_model_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentsOut) ProtoMessage() {}
+
+func (x *GetDocumentsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentsOut.ProtoReflect.Descriptor instead.
+func (*GetDocumentsOut) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetDocumentsOut) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetDocumentsOut) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetDocumentsOut) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetDocumentsOut) GetDocuments() []*Document {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type TableColumn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // column header from the source file
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // integer, numeric, date, timestamp, boolean or text
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableColumn) Reset() {
+	*x = TableColumn{}
+	mi := &file_data_v1_model_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableColumn) ProtoMessage() {}
+
+func (x *TableColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableColumn.ProtoReflect.Descriptor instead.
+func (*TableColumn) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TableColumn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TableColumn) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type StructuredTable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SourceId      string                 `protobuf:"bytes,2,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
+	DocumentId    string                 `protobuf:"bytes,3,opt,name=documentId,proto3" json:"documentId,omitempty"`
+	Sheet         string                 `protobuf:"bytes,4,opt,name=sheet,proto3" json:"sheet,omitempty"`
+	Columns       []*TableColumn         `protobuf:"bytes,5,rep,name=columns,proto3" json:"columns,omitempty"`
+	RowCount      uint64                 `protobuf:"varint,6,opt,name=rowCount,proto3" json:"rowCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StructuredTable) Reset() {
+	*x = StructuredTable{}
+	mi := &file_data_v1_model_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StructuredTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StructuredTable) ProtoMessage() {}
+
+func (x *StructuredTable) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StructuredTable.ProtoReflect.Descriptor instead.
+func (*StructuredTable) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StructuredTable) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StructuredTable) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *StructuredTable) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *StructuredTable) GetSheet() string {
+	if x != nil {
+		return x.Sheet
+	}
+	return ""
+}
+
+func (x *StructuredTable) GetColumns() []*TableColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *StructuredTable) GetRowCount() uint64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+type ListTablesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceIds     []string               `protobuf:"bytes,1,rep,name=sourceIds,proto3" json:"sourceIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTablesRequest) Reset() {
+	*x = ListTablesRequest{}
+	mi := &file_data_v1_model_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTablesRequest) ProtoMessage() {}
+
+func (x *ListTablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTablesRequest.ProtoReflect.Descriptor instead.
+func (*ListTablesRequest) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListTablesRequest) GetSourceIds() []string {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+type ListTablesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tables        []*StructuredTable     `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTablesResponse) Reset() {
+	*x = ListTablesResponse{}
+	mi := &file_data_v1_model_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTablesResponse) ProtoMessage() {}
+
+func (x *ListTablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTablesResponse.ProtoReflect.Descriptor instead.
+func (*ListTablesResponse) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListTablesResponse) GetTables() []*StructuredTable {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type Aggregation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Function      AggregateFunction      `protobuf:"varint,1,opt,name=function,proto3,enum=data.v1.AggregateFunction" json:"function,omitempty"`
+	Column        string                 `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"` // may be empty for count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Aggregation) Reset() {
+	*x = Aggregation{}
+	mi := &file_data_v1_model_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Aggregation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aggregation) ProtoMessage() {}
+
+func (x *Aggregation) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aggregation.ProtoReflect.Descriptor instead.
+func (*Aggregation) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Aggregation) GetFunction() AggregateFunction {
+	if x != nil {
+		return x.Function
+	}
+	return AggregateFunction_AGGREGATE_UNDEFINED
+}
+
+func (x *Aggregation) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+type TableFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Column        string                 `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	Operator      FilterOperator         `protobuf:"varint,2,opt,name=operator,proto3,enum=data.v1.FilterOperator" json:"operator,omitempty"`
+	Values        []string               `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"` // exactly one value for all operators except in
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableFilter) Reset() {
+	*x = TableFilter{}
+	mi := &file_data_v1_model_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableFilter) ProtoMessage() {}
+
+func (x *TableFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableFilter.ProtoReflect.Descriptor instead.
+func (*TableFilter) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TableFilter) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *TableFilter) GetOperator() FilterOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return FilterOperator_FILTER_UNDEFINED
+}
+
+func (x *TableFilter) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// only tables of the active generation of sourceIds are found, as in document and chunk lookups
+type AggregateTableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=tableId,proto3" json:"tableId,omitempty"`
+	Aggregations  []*Aggregation         `protobuf:"bytes,2,rep,name=aggregations,proto3" json:"aggregations,omitempty"` // count(*) if empty
+	GroupBy       []string               `protobuf:"bytes,3,rep,name=groupBy,proto3" json:"groupBy,omitempty"`
+	Filters       []*TableFilter         `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters,omitempty"`
+	Limit         uint32                 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	SourceIds     []string               `protobuf:"bytes,6,rep,name=sourceIds,proto3" json:"sourceIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateTableRequest) Reset() {
+	*x = AggregateTableRequest{}
+	mi := &file_data_v1_model_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateTableRequest) ProtoMessage() {}
+
+func (x *AggregateTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateTableRequest.ProtoReflect.Descriptor instead.
+func (*AggregateTableRequest) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AggregateTableRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *AggregateTableRequest) GetAggregations() []*Aggregation {
+	if x != nil {
+		return x.Aggregations
+	}
+	return nil
+}
+
+func (x *AggregateTableRequest) GetGroupBy() []string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *AggregateTableRequest) GetFilters() []*TableFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *AggregateTableRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AggregateTableRequest) GetSourceIds() []string {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+type AggregateRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateRow) Reset() {
+	*x = AggregateRow{}
+	mi := &file_data_v1_model_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRow) ProtoMessage() {}
+
+func (x *AggregateRow) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRow.ProtoReflect.Descriptor instead.
+func (*AggregateRow) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AggregateRow) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type AggregateTableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       []string               `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	Rows          []*AggregateRow        `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	Truncated     bool                   `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateTableResponse) Reset() {
+	*x = AggregateTableResponse{}
+	mi := &file_data_v1_model_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateTableResponse) ProtoMessage() {}
+
+func (x *AggregateTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateTableResponse.ProtoReflect.Descriptor instead.
+func (*AggregateTableResponse) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AggregateTableResponse) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *AggregateTableResponse) GetRows() []*AggregateRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *AggregateTableResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type IngestionObject struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          IngestionObjectType    `protobuf:"varint,2,opt,name=type,proto3,enum=data.v1.IngestionObjectType" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // page url or file path
+	State         IngestionState         `protobuf:"varint,4,opt,name=state,proto3,enum=data.v1.IngestionState" json:"state,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"` // failure or skip reason
+	ChunkCount    uint32                 `protobuf:"varint,6,opt,name=chunkCount,proto3" json:"chunkCount,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestionObject) Reset() {
+	*x = IngestionObject{}
+	mi := &file_data_v1_model_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestionObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestionObject) ProtoMessage() {}
+
+func (x *IngestionObject) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestionObject.ProtoReflect.Descriptor instead.
+func (*IngestionObject) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *IngestionObject) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IngestionObject) GetType() IngestionObjectType {
+	if x != nil {
+		return x.Type
+	}
+	return IngestionObjectType_OBJECT_UNDEFINED
+}
+
+func (x *IngestionObject) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IngestionObject) GetState() IngestionState {
+	if x != nil {
+		return x.State
+	}
+	return IngestionState_INGESTION_UNDEFINED
+}
+
+func (x *IngestionObject) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *IngestionObject) GetChunkCount() uint32 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+func (x *IngestionObject) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *IngestionObject) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type IngestionStateCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         IngestionState         `protobuf:"varint,1,opt,name=state,proto3,enum=data.v1.IngestionState" json:"state,omitempty"`
+	Count         uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestionStateCount) Reset() {
+	*x = IngestionStateCount{}
+	mi := &file_data_v1_model_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestionStateCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestionStateCount) ProtoMessage() {}
+
+func (x *IngestionStateCount) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestionStateCount.ProtoReflect.Descriptor instead.
+func (*IngestionStateCount) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *IngestionStateCount) GetState() IngestionState {
+	if x != nil {
+		return x.State
+	}
+	return IngestionState_INGESTION_UNDEFINED
+}
+
+func (x *IngestionStateCount) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetIngestionReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
+	States        []IngestionState       `protobuf:"varint,2,rep,packed,name=states,proto3,enum=data.v1.IngestionState" json:"states,omitempty"` // all states if empty
+	Type          IngestionObjectType    `protobuf:"varint,3,opt,name=type,proto3,enum=data.v1.IngestionObjectType" json:"type,omitempty"`       // all types if undefined
+	Size          uint32                 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Page          uint32                 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIngestionReportRequest) Reset() {
+	*x = GetIngestionReportRequest{}
+	mi := &file_data_v1_model_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIngestionReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIngestionReportRequest) ProtoMessage() {}
+
+func (x *GetIngestionReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIngestionReportRequest.ProtoReflect.Descriptor instead.
+func (*GetIngestionReportRequest) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetIngestionReportRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *GetIngestionReportRequest) GetStates() []IngestionState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *GetIngestionReportRequest) GetType() IngestionObjectType {
+	if x != nil {
+		return x.Type
+	}
+	return IngestionObjectType_OBJECT_UNDEFINED
+}
+
+func (x *GetIngestionReportRequest) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetIngestionReportRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type GetIngestionReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
+	Size          uint32                 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Page          uint32                 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Total         uint32                 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`  // objects matching the filter
+	Counts        []*IngestionStateCount `protobuf:"bytes,5,rep,name=counts,proto3" json:"counts,omitempty"` // per state, ignoring the state filter
+	Objects       []*IngestionObject     `protobuf:"bytes,6,rep,name=objects,proto3" json:"objects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIngestionReportResponse) Reset() {
+	*x = GetIngestionReportResponse{}
+	mi := &file_data_v1_model_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIngestionReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIngestionReportResponse) ProtoMessage() {}
+
+func (x *GetIngestionReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIngestionReportResponse.ProtoReflect.Descriptor instead.
+func (*GetIngestionReportResponse) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetIngestionReportResponse) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *GetIngestionReportResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetIngestionReportResponse) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetIngestionReportResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetIngestionReportResponse) GetCounts() []*IngestionStateCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *GetIngestionReportResponse) GetObjects() []*IngestionObject {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+type DeadLetter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	Payload       string                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"` // job payload as json
+	Metadata      map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"` // last processing error
+	Attempts      uint32                 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ReplayedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=replayedAt,proto3" json:"replayedAt,omitempty"` // unset if the job has not been replayed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	mi := &file_data_v1_model_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeadLetter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetReplayedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReplayedAt
+	}
+	return nil
+}
+
+type ListDeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Size          uint32                 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Page          uint32                 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
+	mi := &file_data_v1_model_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListDeadLettersRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ListDeadLettersRequest) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type ListDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          uint32                 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Page          uint32                 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Total         uint32                 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	DeadLetters   []*DeadLetter          `protobuf:"bytes,4,rep,name=deadLetters,proto3" json:"deadLetters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersResponse) Reset() {
+	*x = ListDeadLettersResponse{}
+	mi := &file_data_v1_model_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListDeadLettersResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListDeadLettersResponse) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeadLettersResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListDeadLettersResponse) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+type GetDeadLetterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeadLetterRequest) Reset() {
+	*x = GetDeadLetterRequest{}
+	mi := &file_data_v1_model_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeadLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLetterRequest) ProtoMessage() {}
+
+func (x *GetDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*GetDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetDeadLetterRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *GetDeadLetterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReplayDeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Ids           []string               `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLettersRequest) Reset() {
+	*x = ReplayDeadLettersRequest{}
+	mi := &file_data_v1_model_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersRequest) ProtoMessage() {}
+
+func (x *ReplayDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReplayDeadLettersRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ReplayDeadLettersRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ReplayDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Replayed      uint32                 `protobuf:"varint,1,opt,name=replayed,proto3" json:"replayed,omitempty"` // already replayed and unknown ids are skipped
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VectorSearchRequest) Reset() {
-	*x = VectorSearchRequest{}
-	mi := &file_data_v1_model_proto_msgTypes[0]
+func (x *ReplayDeadLettersResponse) Reset() {
+	*x = ReplayDeadLettersResponse{}
+	mi := &file_data_v1_model_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReplayDeadLettersResponse) GetReplayed() uint32 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+// EmbeddingModel registered embedding model with its vector coverage of the corpus
+type EmbeddingModel struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version           string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Dimensions        uint32                 `protobuf:"varint,4,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	IndexType         string                 `protobuf:"bytes,5,opt,name=indexType,proto3" json:"indexType,omitempty"`    // ivfflat or hnsw
+	Active            bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`         // VectorSearch uses this model
+	Configured        bool                   `protobuf:"varint,7,opt,name=configured,proto3" json:"configured,omitempty"` // the search service has an embedder for this model
+	Chunks            uint64                 `protobuf:"varint,8,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	EmbeddedChunks    uint64                 `protobuf:"varint,9,opt,name=embeddedChunks,proto3" json:"embeddedChunks,omitempty"`
+	Questions         uint64                 `protobuf:"varint,10,opt,name=questions,proto3" json:"questions,omitempty"`
+	EmbeddedQuestions uint64                 `protobuf:"varint,11,opt,name=embeddedQuestions,proto3" json:"embeddedQuestions,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ActivatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=activatedAt,proto3" json:"activatedAt,omitempty"` // unset if the model has never been active
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EmbeddingModel) Reset() {
+	*x = EmbeddingModel{}
+	mi := &file_data_v1_model_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VectorSearchRequest) String() string {
+func (x *EmbeddingModel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VectorSearchRequest) ProtoMessage() {}
+func (*EmbeddingModel) ProtoMessage() {}
 
-func (x *VectorSearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_data_v1_model_proto_msgTypes[0]
+func (x *EmbeddingModel) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,144 +2976,123 @@ func (x *VectorSearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VectorSearchRequest.ProtoReflect.Descriptor instead.
-func (*VectorSearchRequest) Descriptor() ([]byte, []int) {
-	return file_data_v1_model_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use EmbeddingModel.ProtoReflect.Descriptor instead.
+func (*EmbeddingModel) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *VectorSearchRequest) GetQuery() string {
+func (x *EmbeddingModel) GetId() int64 {
 	if x != nil {
-		return x.Query
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *VectorSearchRequest) GetSourceIds() []string {
+func (x *EmbeddingModel) GetName() string {
 	if x != nil {
-		return x.SourceIds
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *VectorSearchRequest) GetTopK() uint64 {
+func (x *EmbeddingModel) GetVersion() string {
 	if x != nil {
-		return x.TopK
+		return x.Version
 	}
-	return 0
+	return ""
 }
 
-func (x *VectorSearchRequest) GetThreshold() float32 {
+func (x *EmbeddingModel) GetDimensions() uint32 {
 	if x != nil {
-		return x.Threshold
+		return x.Dimensions
 	}
 	return 0
 }
 
-func (x *VectorSearchRequest) GetUseQuestions() bool {
+func (x *EmbeddingModel) GetIndexType() string {
 	if x != nil {
-		return x.UseQuestions
+		return x.IndexType
 	}
-	return false
-}
-
-type DocumentChunk struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Index         int64                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	Metadata      []byte                 `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"` // encoded json<any,any>
-	Similarity    float32                `protobuf:"fixed32,5,opt,name=similarity,proto3" json:"similarity,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DocumentChunk) Reset() {
-	*x = DocumentChunk{}
-	mi := &file_data_v1_model_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *DocumentChunk) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EmbeddingModel) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
 }
 
-func (*DocumentChunk) ProtoMessage() {}
-
-func (x *DocumentChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_data_v1_model_proto_msgTypes[1]
+func (x *EmbeddingModel) GetConfigured() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Configured
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use DocumentChunk.ProtoReflect.Descriptor instead.
-func (*DocumentChunk) Descriptor() ([]byte, []int) {
-	return file_data_v1_model_proto_rawDescGZIP(), []int{1}
+func (x *EmbeddingModel) GetChunks() uint64 {
+	if x != nil {
+		return x.Chunks
+	}
+	return 0
 }
 
-func (x *DocumentChunk) GetId() string {
+func (x *EmbeddingModel) GetEmbeddedChunks() uint64 {
 	if x != nil {
-		return x.Id
+		return x.EmbeddedChunks
 	}
-	return ""
+	return 0
 }
 
-func (x *DocumentChunk) GetIndex() int64 {
+func (x *EmbeddingModel) GetQuestions() uint64 {
 	if x != nil {
-		return x.Index
+		return x.Questions
 	}
 	return 0
 }
 
-func (x *DocumentChunk) GetContent() string {
+func (x *EmbeddingModel) GetEmbeddedQuestions() uint64 {
 	if x != nil {
-		return x.Content
+		return x.EmbeddedQuestions
 	}
-	return ""
+	return 0
 }
 
-func (x *DocumentChunk) GetMetadata() []byte {
+func (x *EmbeddingModel) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Metadata
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *DocumentChunk) GetSimilarity() float32 {
+func (x *EmbeddingModel) GetActivatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Similarity
+		return x.ActivatedAt
 	}
-	return 0
+	return nil
 }
 
-type VectorSearchResponse struct {
+type ListEmbeddingModelsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Chunks        []*DocumentChunk       `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VectorSearchResponse) Reset() {
-	*x = VectorSearchResponse{}
-	mi := &file_data_v1_model_proto_msgTypes[2]
+func (x *ListEmbeddingModelsRequest) Reset() {
+	*x = ListEmbeddingModelsRequest{}
+	mi := &file_data_v1_model_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VectorSearchResponse) String() string {
+func (x *ListEmbeddingModelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VectorSearchResponse) ProtoMessage() {}
+func (*ListEmbeddingModelsRequest) ProtoMessage() {}
 
-func (x *VectorSearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_data_v1_model_proto_msgTypes[2]
+func (x *ListEmbeddingModelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -205,42 +3103,33 @@ func (x *VectorSearchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VectorSearchResponse.ProtoReflect.Descriptor instead.
-func (*VectorSearchResponse) Descriptor() ([]byte, []int) {
-	return file_data_v1_model_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *VectorSearchResponse) GetChunks() []*DocumentChunk {
-	if x != nil {
-		return x.Chunks
-	}
-	return nil
+// Deprecated: Use ListEmbeddingModelsRequest.ProtoReflect.Descriptor instead.
+func (*ListEmbeddingModelsRequest) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{40}
 }
 
-type GetDocumentsIn struct {
+type ListEmbeddingModelsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SourceId      string                 `protobuf:"bytes,1,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
-	Size          uint32                 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Page          uint32                 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Models        []*EmbeddingModel      `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDocumentsIn) Reset() {
-	*x = GetDocumentsIn{}
-	mi := &file_data_v1_model_proto_msgTypes[3]
+func (x *ListEmbeddingModelsResponse) Reset() {
+	*x = ListEmbeddingModelsResponse{}
+	mi := &file_data_v1_model_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDocumentsIn) String() string {
+func (x *ListEmbeddingModelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDocumentsIn) ProtoMessage() {}
+func (*ListEmbeddingModelsResponse) ProtoMessage() {}
 
-func (x *GetDocumentsIn) ProtoReflect() protoreflect.Message {
-	mi := &file_data_v1_model_proto_msgTypes[3]
+func (x *ListEmbeddingModelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -251,58 +3140,42 @@ func (x *GetDocumentsIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDocumentsIn.ProtoReflect.Descriptor instead.
-func (*GetDocumentsIn) Descriptor() ([]byte, []int) {
-	return file_data_v1_model_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *GetDocumentsIn) GetSourceId() string {
-	if x != nil {
-		return x.SourceId
-	}
-	return ""
-}
-
-func (x *GetDocumentsIn) GetSize() uint32 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
+// Deprecated: Use ListEmbeddingModelsResponse.ProtoReflect.Descriptor instead.
+func (*ListEmbeddingModelsResponse) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetDocumentsIn) GetPage() uint32 {
+func (x *ListEmbeddingModelsResponse) GetModels() []*EmbeddingModel {
 	if x != nil {
-		return x.Page
+		return x.Models
 	}
-	return 0
+	return nil
 }
 
-type Document struct {
+type StartReembeddingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SourceId      string                 `protobuf:"bytes,2,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	Metadata      string                 `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ModelId       int64                  `protobuf:"varint,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	SourceId      *string                `protobuf:"bytes,2,opt,name=sourceId,proto3,oneof" json:"sourceId,omitempty"` // whole corpus if unset
+	CutOver       bool                   `protobuf:"varint,3,opt,name=cutOver,proto3" json:"cutOver,omitempty"`        // activate the model once every chunk and question has its vector
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Document) Reset() {
-	*x = Document{}
-	mi := &file_data_v1_model_proto_msgTypes[4]
+func (x *StartReembeddingRequest) Reset() {
+	*x = StartReembeddingRequest{}
+	mi := &file_data_v1_model_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Document) String() string {
+func (x *StartReembeddingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Document) ProtoMessage() {}
+func (*StartReembeddingRequest) ProtoMessage() {}
 
-func (x *Document) ProtoReflect() protoreflect.Message {
-	mi := &file_data_v1_model_proto_msgTypes[4]
+func (x *StartReembeddingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,71 +3186,99 @@ func (x *Document) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Document.ProtoReflect.Descriptor instead.
-func (*Document) Descriptor() ([]byte, []int) {
-	return file_data_v1_model_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use StartReembeddingRequest.ProtoReflect.Descriptor instead.
+func (*StartReembeddingRequest) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *Document) GetId() string {
+func (x *StartReembeddingRequest) GetModelId() int64 {
 	if x != nil {
-		return x.Id
+		return x.ModelId
 	}
-	return ""
+	return 0
 }
 
-func (x *Document) GetSourceId() string {
-	if x != nil {
-		return x.SourceId
+func (x *StartReembeddingRequest) GetSourceId() string {
+	if x != nil && x.SourceId != nil {
+		return *x.SourceId
 	}
 	return ""
 }
 
-func (x *Document) GetName() string {
+func (x *StartReembeddingRequest) GetCutOver() bool {
 	if x != nil {
-		return x.Name
+		return x.CutOver
 	}
-	return ""
+	return false
 }
 
-func (x *Document) GetContent() string {
+type StartReembeddingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartReembeddingResponse) Reset() {
+	*x = StartReembeddingResponse{}
+	mi := &file_data_v1_model_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartReembeddingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartReembeddingResponse) ProtoMessage() {}
+
+func (x *StartReembeddingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[43]
 	if x != nil {
-		return x.Content
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Document) GetMetadata() string {
+// Deprecated: Use StartReembeddingResponse.ProtoReflect.Descriptor instead.
+func (*StartReembeddingResponse) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StartReembeddingResponse) GetJobId() string {
 	if x != nil {
-		return x.Metadata
+		return x.JobId
 	}
 	return ""
 }
 
-type GetDocumentsOut struct {
+type ActivateEmbeddingModelRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Size          uint32                 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
-	Page          uint32                 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Total         uint32                 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
-	Documents     []*Document            `protobuf:"bytes,4,rep,name=documents,proto3" json:"documents,omitempty"`
+	ModelId       int64                  `protobuf:"varint,1,opt,name=modelId,proto3" json:"modelId,omitempty"`
+	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"` // activate even if some chunks or questions have no vectors of the model
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDocumentsOut) Reset() {
-	*x = GetDocumentsOut{}
-	mi := &file_data_v1_model_proto_msgTypes[5]
+func (x *ActivateEmbeddingModelRequest) Reset() {
+	*x = ActivateEmbeddingModelRequest{}
+	mi := &file_data_v1_model_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDocumentsOut) String() string {
+func (x *ActivateEmbeddingModelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDocumentsOut) ProtoMessage() {}
+func (*ActivateEmbeddingModelRequest) ProtoMessage() {}
 
-func (x *GetDocumentsOut) ProtoReflect() protoreflect.Message {
-	mi := &file_data_v1_model_proto_msgTypes[5]
+func (x *ActivateEmbeddingModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_v1_model_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -388,50 +3289,74 @@ func (x *GetDocumentsOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDocumentsOut.ProtoReflect.Descriptor instead.
-func (*GetDocumentsOut) Descriptor() ([]byte, []int) {
-	return file_data_v1_model_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *GetDocumentsOut) GetSize() uint32 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
-}
-
-func (x *GetDocumentsOut) GetPage() uint32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use ActivateEmbeddingModelRequest.ProtoReflect.Descriptor instead.
+func (*ActivateEmbeddingModelRequest) Descriptor() ([]byte, []int) {
+	return file_data_v1_model_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetDocumentsOut) GetTotal() uint32 {
+func (x *ActivateEmbeddingModelRequest) GetModelId() int64 {
 	if x != nil {
-		return x.Total
+		return x.ModelId
 	}
 	return 0
 }
 
-func (x *GetDocumentsOut) GetDocuments() []*Document {
+func (x *ActivateEmbeddingModelRequest) GetForce() bool {
 	if x != nil {
-		return x.Documents
+		return x.Force
 	}
-	return nil
+	return false
 }
 
 var File_data_v1_model_proto protoreflect.FileDescriptor
 
 const file_data_v1_model_proto_rawDesc = "" +
 	"\n" +
-	"\x13data/v1/model.proto\x12\adata.v1\"\x9f\x01\n" +
+	"\x13data/v1/model.proto\x12\adata.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8c\x01\n" +
+	"\fHybridSearch\x12\"\n" +
+	"\fvectorWeight\x18\x01 \x01(\x02R\fvectorWeight\x12$\n" +
+	"\rlexicalWeight\x18\x02 \x01(\x02R\rlexicalWeight\x12\x12\n" +
+	"\x04rrfK\x18\x03 \x01(\rR\x04rrfK\x12\x1e\n" +
+	"\n" +
+	"candidates\x18\x04 \x01(\rR\n" +
+	"candidates\"+\n" +
+	"\x11NeighborExpansion\x12\x16\n" +
+	"\x06window\x18\x01 \x01(\rR\x06window\"\x81\x01\n" +
+	"\x0fDiversification\x12\x1b\n" +
+	"\x06lambda\x18\x01 \x01(\x02H\x00R\x06lambda\x88\x01\x01\x12&\n" +
+	"\x0emaxPerDocument\x18\x02 \x01(\rR\x0emaxPerDocument\x12\x1e\n" +
+	"\n" +
+	"candidates\x18\x03 \x01(\rR\n" +
+	"candidatesB\t\n" +
+	"\a_lambda\"\xec\x02\n" +
+	"\fSearchFilter\x12\x14\n" +
+	"\x05types\x18\x01 \x03(\tR\x05types\x12\x1e\n" +
+	"\n" +
+	"extensions\x18\x02 \x03(\tR\n" +
+	"extensions\x12 \n" +
+	"\vurlPrefixes\x18\x03 \x03(\tR\vurlPrefixes\x12;\n" +
+	"\bdateFrom\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\bdateFrom\x88\x01\x01\x127\n" +
+	"\x06dateTo\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x06dateTo\x88\x01\x01\x12\x1c\n" +
+	"\tlanguages\x18\x06 \x03(\tR\tlanguages\x12.\n" +
+	"\x12documentPredicates\x18\a \x03(\tR\x12documentPredicates\x12(\n" +
+	"\x0fchunkPredicates\x18\b \x03(\tR\x0fchunkPredicatesB\v\n" +
+	"\t_dateFromB\t\n" +
+	"\a_dateTo\"\xac\x03\n" +
 	"\x13VectorSearchRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1c\n" +
 	"\tsourceIds\x18\x02 \x03(\tR\tsourceIds\x12\x12\n" +
 	"\x04topK\x18\x03 \x01(\x04R\x04topK\x12\x1c\n" +
 	"\tthreshold\x18\x04 \x01(\x02R\tthreshold\x12\"\n" +
-	"\fuseQuestions\x18\x05 \x01(\bR\fuseQuestions\"\x8b\x01\n" +
+	"\fuseQuestions\x18\x05 \x01(\bR\fuseQuestions\x122\n" +
+	"\x06hybrid\x18\x06 \x01(\v2\x15.data.v1.HybridSearchH\x00R\x06hybrid\x88\x01\x01\x122\n" +
+	"\x06filter\x18\a \x01(\v2\x15.data.v1.SearchFilterH\x01R\x06filter\x88\x01\x01\x12;\n" +
+	"\tdiversify\x18\b \x01(\v2\x18.data.v1.DiversificationH\x02R\tdiversify\x88\x01\x01\x127\n" +
+	"\x06expand\x18\t \x01(\v2\x1a.data.v1.NeighborExpansionH\x03R\x06expand\x88\x01\x01B\t\n" +
+	"\a_hybridB\t\n" +
+	"\a_filterB\f\n" +
+	"\n" +
+	"_diversifyB\t\n" +
+	"\a_expand\"\xbf\x02\n" +
 	"\rDocumentChunk\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05index\x18\x02 \x01(\x03R\x05index\x12\x18\n" +
@@ -439,24 +3364,257 @@ const file_data_v1_model_proto_rawDesc = "" +
 	"\bmetadata\x18\x04 \x01(\fR\bmetadata\x12\x1e\n" +
 	"\n" +
 	"similarity\x18\x05 \x01(\x02R\n" +
-	"similarity\"F\n" +
+	"similarity\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x02R\x05score\x12\x1e\n" +
+	"\n" +
+	"documentId\x18\a \x01(\tR\n" +
+	"documentId\x12\x1a\n" +
+	"\bendIndex\x18\b \x01(\x03R\bendIndex\x12\x1a\n" +
+	"\bchunkIds\x18\t \x03(\tR\bchunkIds\x12\"\n" +
+	"\fdocumentName\x18\n" +
+	" \x01(\tR\fdocumentName\x12 \n" +
+	"\vdocumentUrl\x18\v \x01(\tR\vdocumentUrl\"F\n" +
 	"\x14VectorSearchResponse\x12.\n" +
-	"\x06chunks\x18\x01 \x03(\v2\x16.data.v1.DocumentChunkR\x06chunks\"T\n" +
+	"\x06chunks\x18\x01 \x03(\v2\x16.data.v1.DocumentChunkR\x06chunks\"\xc9\x03\n" +
+	"\x18BatchVectorSearchRequest\x12\x18\n" +
+	"\aqueries\x18\x01 \x03(\tR\aqueries\x12\x1c\n" +
+	"\tsourceIds\x18\x02 \x03(\tR\tsourceIds\x12\x12\n" +
+	"\x04topK\x18\x03 \x01(\x04R\x04topK\x12\x1c\n" +
+	"\tthreshold\x18\x04 \x01(\x02R\tthreshold\x12\"\n" +
+	"\fuseQuestions\x18\x05 \x01(\bR\fuseQuestions\x122\n" +
+	"\x06hybrid\x18\x06 \x01(\v2\x15.data.v1.HybridSearchH\x00R\x06hybrid\x88\x01\x01\x122\n" +
+	"\x06filter\x18\a \x01(\v2\x15.data.v1.SearchFilterH\x01R\x06filter\x88\x01\x01\x12;\n" +
+	"\tdiversify\x18\b \x01(\v2\x18.data.v1.DiversificationH\x02R\tdiversify\x88\x01\x01\x127\n" +
+	"\x06expand\x18\t \x01(\v2\x1a.data.v1.NeighborExpansionH\x03R\x06expand\x88\x01\x01\x12\x12\n" +
+	"\x04rrfK\x18\n" +
+	" \x01(\rR\x04rrfKB\t\n" +
+	"\a_hybridB\t\n" +
+	"\a_filterB\f\n" +
+	"\n" +
+	"_diversifyB\t\n" +
+	"\a_expand\"T\n" +
+	"\bQueryHit\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\rR\x05query\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\rR\x04rank\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x03 \x01(\x02R\n" +
+	"similarity\"g\n" +
+	"\x10BatchSearchChunk\x12,\n" +
+	"\x05chunk\x18\x01 \x01(\v2\x16.data.v1.DocumentChunkR\x05chunk\x12%\n" +
+	"\x04hits\x18\x02 \x03(\v2\x11.data.v1.QueryHitR\x04hits\"N\n" +
+	"\x19BatchVectorSearchResponse\x121\n" +
+	"\x06chunks\x18\x01 \x03(\v2\x19.data.v1.BatchSearchChunkR\x06chunks\"T\n" +
 	"\x0eGetDocumentsIn\x12\x1a\n" +
 	"\bsourceId\x18\x01 \x01(\tR\bsourceId\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\rR\x04size\x12\x12\n" +
-	"\x04page\x18\x03 \x01(\rR\x04page\"\x80\x01\n" +
+	"\x04page\x18\x03 \x01(\rR\x04page\"\xac\x03\n" +
 	"\bDocument\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\bsourceId\x18\x02 \x01(\tR\bsourceId\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
 	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1a\n" +
-	"\bmetadata\x18\x05 \x01(\tR\bmetadata\"\x80\x01\n" +
+	"\bmetadata\x18\x05 \x01(\tR\bmetadata\x12\x1a\n" +
+	"\bobjectId\x18\x06 \x01(\tR\bobjectId\x12\x1e\n" +
+	"\n" +
+	"objectType\x18\a \x01(\tR\n" +
+	"objectType\x12\x1c\n" +
+	"\textension\x18\b \x01(\tR\textension\x12\x10\n" +
+	"\x03url\x18\t \x01(\tR\x03url\x12\x1a\n" +
+	"\blanguage\x18\n" +
+	" \x01(\tR\blanguage\x12.\n" +
+	"\x04date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x128\n" +
+	"\tcreatedAt\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"B\n" +
+	"\x12GetDocumentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tsourceIds\x18\x02 \x03(\tR\tsourceIds\"Z\n" +
+	"\x10GetChunksRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x1c\n" +
+	"\tsourceIds\x18\x02 \x03(\tR\tsourceIds\x12\x16\n" +
+	"\x06window\x18\x03 \x01(\rR\x06window\"c\n" +
+	"\x11GetChunksResponse\x12.\n" +
+	"\x06chunks\x18\x01 \x03(\v2\x16.data.v1.DocumentChunkR\x06chunks\x12\x1e\n" +
+	"\n" +
+	"missingIds\x18\x02 \x03(\tR\n" +
+	"missingIds\"W\n" +
+	"\x17DownloadOriginalRequest\x12\x1e\n" +
+	"\n" +
+	"documentId\x18\x01 \x01(\tR\n" +
+	"documentId\x12\x1c\n" +
+	"\tsourceIds\x18\x02 \x03(\tR\tsourceIds\"\x80\x01\n" +
+	"\fOriginalInfo\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12 \n" +
+	"\vcontentType\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x1e\n" +
+	"\n" +
+	"objectType\x18\x04 \x01(\tR\n" +
+	"objectType\"h\n" +
+	"\x18DownloadOriginalResponse\x12+\n" +
+	"\x04info\x18\x01 \x01(\v2\x15.data.v1.OriginalInfoH\x00R\x04info\x12\x14\n" +
+	"\x04data\x18\x02 \x01(\fH\x00R\x04dataB\t\n" +
+	"\apayload\"\x80\x01\n" +
 	"\x0fGetDocumentsOut\x12\x12\n" +
 	"\x04size\x18\x01 \x01(\rR\x04size\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\rR\x04page\x12\x14\n" +
 	"\x05total\x18\x03 \x01(\rR\x05total\x12/\n" +
-	"\tdocuments\x18\x04 \x03(\v2\x11.data.v1.DocumentR\tdocumentsB\x12Z\x10internal/data/pbb\x06proto3"
+	"\tdocuments\x18\x04 \x03(\v2\x11.data.v1.DocumentR\tdocuments\"5\n" +
+	"\vTableColumn\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\"\xbf\x01\n" +
+	"\x0fStructuredTable\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bsourceId\x18\x02 \x01(\tR\bsourceId\x12\x1e\n" +
+	"\n" +
+	"documentId\x18\x03 \x01(\tR\n" +
+	"documentId\x12\x14\n" +
+	"\x05sheet\x18\x04 \x01(\tR\x05sheet\x12.\n" +
+	"\acolumns\x18\x05 \x03(\v2\x14.data.v1.TableColumnR\acolumns\x12\x1a\n" +
+	"\browCount\x18\x06 \x01(\x04R\browCount\"1\n" +
+	"\x11ListTablesRequest\x12\x1c\n" +
+	"\tsourceIds\x18\x01 \x03(\tR\tsourceIds\"F\n" +
+	"\x12ListTablesResponse\x120\n" +
+	"\x06tables\x18\x01 \x03(\v2\x18.data.v1.StructuredTableR\x06tables\"]\n" +
+	"\vAggregation\x126\n" +
+	"\bfunction\x18\x01 \x01(\x0e2\x1a.data.v1.AggregateFunctionR\bfunction\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\tR\x06column\"r\n" +
+	"\vTableFilter\x12\x16\n" +
+	"\x06column\x18\x01 \x01(\tR\x06column\x123\n" +
+	"\boperator\x18\x02 \x01(\x0e2\x17.data.v1.FilterOperatorR\boperator\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\"\xe9\x01\n" +
+	"\x15AggregateTableRequest\x12\x18\n" +
+	"\atableId\x18\x01 \x01(\tR\atableId\x128\n" +
+	"\faggregations\x18\x02 \x03(\v2\x14.data.v1.AggregationR\faggregations\x12\x18\n" +
+	"\agroupBy\x18\x03 \x03(\tR\agroupBy\x12.\n" +
+	"\afilters\x18\x04 \x03(\v2\x14.data.v1.TableFilterR\afilters\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\rR\x05limit\x12\x1c\n" +
+	"\tsourceIds\x18\x06 \x03(\tR\tsourceIds\"&\n" +
+	"\fAggregateRow\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"{\n" +
+	"\x16AggregateTableResponse\x12\x18\n" +
+	"\acolumns\x18\x01 \x03(\tR\acolumns\x12)\n" +
+	"\x04rows\x18\x02 \x03(\v2\x15.data.v1.AggregateRowR\x04rows\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated\"\xc0\x02\n" +
+	"\x0fIngestionObject\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1c.data.v1.IngestionObjectTypeR\x04type\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12-\n" +
+	"\x05state\x18\x04 \x01(\x0e2\x17.data.v1.IngestionStateR\x05state\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1e\n" +
+	"\n" +
+	"chunkCount\x18\x06 \x01(\rR\n" +
+	"chunkCount\x128\n" +
+	"\tcreatedAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"Z\n" +
+	"\x13IngestionStateCount\x12-\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x17.data.v1.IngestionStateR\x05state\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\"\xc2\x01\n" +
+	"\x19GetIngestionReportRequest\x12\x1a\n" +
+	"\bsourceId\x18\x01 \x01(\tR\bsourceId\x12/\n" +
+	"\x06states\x18\x02 \x03(\x0e2\x17.data.v1.IngestionStateR\x06states\x120\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1c.data.v1.IngestionObjectTypeR\x04type\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\rR\x04size\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\rR\x04page\"\xe0\x01\n" +
+	"\x1aGetIngestionReportResponse\x12\x1a\n" +
+	"\bsourceId\x18\x01 \x01(\tR\bsourceId\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\rR\x04size\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\rR\x04page\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\rR\x05total\x124\n" +
+	"\x06counts\x18\x05 \x03(\v2\x1c.data.v1.IngestionStateCountR\x06counts\x122\n" +
+	"\aobjects\x18\x06 \x03(\v2\x18.data.v1.IngestionObjectR\aobjects\"\xf0\x02\n" +
+	"\n" +
+	"DeadLetter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x18\n" +
+	"\apayload\x18\x03 \x01(\tR\apayload\x12=\n" +
+	"\bmetadata\x18\x04 \x03(\v2!.data.v1.DeadLetter.MetadataEntryR\bmetadata\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\rR\battempts\x128\n" +
+	"\tcreatedAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12:\n" +
+	"\n" +
+	"replayedAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"replayedAt\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"V\n" +
+	"\x16ListDeadLettersRequest\x12\x14\n" +
+	"\x05queue\x18\x01 \x01(\tR\x05queue\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\rR\x04size\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\rR\x04page\"\x8e\x01\n" +
+	"\x17ListDeadLettersResponse\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\rR\x04size\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\rR\x04page\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\rR\x05total\x125\n" +
+	"\vdeadLetters\x18\x04 \x03(\v2\x13.data.v1.DeadLetterR\vdeadLetters\"<\n" +
+	"\x14GetDeadLetterRequest\x12\x14\n" +
+	"\x05queue\x18\x01 \x01(\tR\x05queue\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"B\n" +
+	"\x18ReplayDeadLettersRequest\x12\x14\n" +
+	"\x05queue\x18\x01 \x01(\tR\x05queue\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\tR\x03ids\"7\n" +
+	"\x19ReplayDeadLettersResponse\x12\x1a\n" +
+	"\breplayed\x18\x01 \x01(\rR\breplayed\"\xc8\x03\n" +
+	"\x0eEmbeddingModel\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x1e\n" +
+	"\n" +
+	"dimensions\x18\x04 \x01(\rR\n" +
+	"dimensions\x12\x1c\n" +
+	"\tindexType\x18\x05 \x01(\tR\tindexType\x12\x16\n" +
+	"\x06active\x18\x06 \x01(\bR\x06active\x12\x1e\n" +
+	"\n" +
+	"configured\x18\a \x01(\bR\n" +
+	"configured\x12\x16\n" +
+	"\x06chunks\x18\b \x01(\x04R\x06chunks\x12&\n" +
+	"\x0eembeddedChunks\x18\t \x01(\x04R\x0eembeddedChunks\x12\x1c\n" +
+	"\tquestions\x18\n" +
+	" \x01(\x04R\tquestions\x12,\n" +
+	"\x11embeddedQuestions\x18\v \x01(\x04R\x11embeddedQuestions\x128\n" +
+	"\tcreatedAt\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\vactivatedAt\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vactivatedAt\"\x1c\n" +
+	"\x1aListEmbeddingModelsRequest\"N\n" +
+	"\x1bListEmbeddingModelsResponse\x12/\n" +
+	"\x06models\x18\x01 \x03(\v2\x17.data.v1.EmbeddingModelR\x06models\"{\n" +
+	"\x17StartReembeddingRequest\x12\x18\n" +
+	"\amodelId\x18\x01 \x01(\x03R\amodelId\x12\x1f\n" +
+	"\bsourceId\x18\x02 \x01(\tH\x00R\bsourceId\x88\x01\x01\x12\x18\n" +
+	"\acutOver\x18\x03 \x01(\bR\acutOverB\v\n" +
+	"\t_sourceId\"0\n" +
+	"\x18StartReembeddingResponse\x12\x14\n" +
+	"\x05jobId\x18\x01 \x01(\tR\x05jobId\"O\n" +
+	"\x1dActivateEmbeddingModelRequest\x12\x18\n" +
+	"\amodelId\x18\x01 \x01(\x03R\amodelId\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force*\x8d\x01\n" +
+	"\x11AggregateFunction\x12\x17\n" +
+	"\x13AGGREGATE_UNDEFINED\x10\x00\x12\x13\n" +
+	"\x0fAGGREGATE_COUNT\x10\x01\x12\x11\n" +
+	"\rAGGREGATE_SUM\x10\x02\x12\x11\n" +
+	"\rAGGREGATE_AVG\x10\x03\x12\x11\n" +
+	"\rAGGREGATE_MIN\x10\x04\x12\x11\n" +
+	"\rAGGREGATE_MAX\x10\x05*\x91\x01\n" +
+	"\x0eFilterOperator\x12\x14\n" +
+	"\x10FILTER_UNDEFINED\x10\x00\x12\r\n" +
+	"\tFILTER_EQ\x10\x01\x12\r\n" +
+	"\tFILTER_NE\x10\x02\x12\r\n" +
+	"\tFILTER_GT\x10\x03\x12\x0e\n" +
+	"\n" +
+	"FILTER_GTE\x10\x04\x12\r\n" +
+	"\tFILTER_LT\x10\x05\x12\x0e\n" +
+	"\n" +
+	"FILTER_LTE\x10\x06\x12\r\n" +
+	"\tFILTER_IN\x10\a*\xb1\x01\n" +
+	"\x0eIngestionState\x12\x17\n" +
+	"\x13INGESTION_UNDEFINED\x10\x00\x12\x14\n" +
+	"\x10INGESTION_QUEUED\x10\x01\x12\x15\n" +
+	"\x11INGESTION_FETCHED\x10\x02\x12\x14\n" +
+	"\x10INGESTION_PARSED\x10\x03\x12\x16\n" +
+	"\x12INGESTION_EMBEDDED\x10\x04\x12\x15\n" +
+	"\x11INGESTION_SKIPPED\x10\x05\x12\x14\n" +
+	"\x10INGESTION_FAILED\x10\x06*M\n" +
+	"\x13IngestionObjectType\x12\x14\n" +
+	"\x10OBJECT_UNDEFINED\x10\x00\x12\x0f\n" +
+	"\vOBJECT_PAGE\x10\x01\x12\x0f\n" +
+	"\vOBJECT_FILE\x10\x02B\x12Z\x10internal/data/pbb\x06proto3"
 
 var (
 	file_data_v1_model_proto_rawDescOnce sync.Once
@@ -470,23 +3628,110 @@ func file_data_v1_model_proto_rawDescGZIP() []byte {
 	return file_data_v1_model_proto_rawDescData
 }
 
-var file_data_v1_model_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_data_v1_model_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_data_v1_model_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_data_v1_model_proto_goTypes = []any{
-	(*VectorSearchRequest)(nil),  // 0: data.v1.VectorSearchRequest
-	(*DocumentChunk)(nil),        // 1: data.v1.DocumentChunk
-	(*VectorSearchResponse)(nil), // 2: data.v1.VectorSearchResponse
-	(*GetDocumentsIn)(nil),       // 3: data.v1.GetDocumentsIn
-	(*Document)(nil),             // 4: data.v1.Document
-	(*GetDocumentsOut)(nil),      // 5: data.v1.GetDocumentsOut
+	(AggregateFunction)(0),                // 0: data.v1.AggregateFunction
+	(FilterOperator)(0),                   // 1: data.v1.FilterOperator
+	(IngestionState)(0),                   // 2: data.v1.IngestionState
+	(IngestionObjectType)(0),              // 3: data.v1.IngestionObjectType
+	(*HybridSearch)(nil),                  // 4: data.v1.HybridSearch
+	(*NeighborExpansion)(nil),             // 5: data.v1.NeighborExpansion
+	(*Diversification)(nil),               // 6: data.v1.Diversification
+	(*SearchFilter)(nil),                  // 7: data.v1.SearchFilter
+	(*VectorSearchRequest)(nil),           // 8: data.v1.VectorSearchRequest
+	(*DocumentChunk)(nil),                 // 9: data.v1.DocumentChunk
+	(*VectorSearchResponse)(nil),          // 10: data.v1.VectorSearchResponse
+	(*BatchVectorSearchRequest)(nil),      // 11: data.v1.BatchVectorSearchRequest
+	(*QueryHit)(nil),                      // 12: data.v1.QueryHit
+	(*BatchSearchChunk)(nil),              // 13: data.v1.BatchSearchChunk
+	(*BatchVectorSearchResponse)(nil),     // 14: data.v1.BatchVectorSearchResponse
+	(*GetDocumentsIn)(nil),                // 15: data.v1.GetDocumentsIn
+	(*Document)(nil),                      // 16: data.v1.Document
+	(*GetDocumentRequest)(nil),            // 17: data.v1.GetDocumentRequest
+	(*GetChunksRequest)(nil),              // 18: data.v1.GetChunksRequest
+	(*GetChunksResponse)(nil),             // 19: data.v1.GetChunksResponse
+	(*DownloadOriginalRequest)(nil),       // 20: data.v1.DownloadOriginalRequest
+	(*OriginalInfo)(nil),                  // 21: data.v1.OriginalInfo
+	(*DownloadOriginalResponse)(nil),      // 22: data.v1.DownloadOriginalResponse
+	(*GetDocumentsOut)(nil),               // 23: data.v1.GetDocumentsOut
+	(*TableColumn)(nil),                   // 24: data.v1.TableColumn
+	(*StructuredTable)(nil),               // 25: data.v1.StructuredTable
+	(*ListTablesRequest)(nil),             // 26: data.v1.ListTablesRequest
+	(*ListTablesResponse)(nil),            // 27: data.v1.ListTablesResponse
+	(*Aggregation)(nil),                   // 28: data.v1.Aggregation
+	(*TableFilter)(nil),                   // 29: data.v1.TableFilter
+	(*AggregateTableRequest)(nil),         // 30: data.v1.AggregateTableRequest
+	(*AggregateRow)(nil),                  // 31: data.v1.AggregateRow
+	(*AggregateTableResponse)(nil),        // 32: data.v1.AggregateTableResponse
+	(*IngestionObject)(nil),               // 33: data.v1.IngestionObject
+	(*IngestionStateCount)(nil),           // 34: data.v1.IngestionStateCount
+	(*GetIngestionReportRequest)(nil),     // 35: data.v1.GetIngestionReportRequest
+	(*GetIngestionReportResponse)(nil),    // 36: data.v1.GetIngestionReportResponse
+	(*DeadLetter)(nil),                    // 37: data.v1.DeadLetter
+	(*ListDeadLettersRequest)(nil),        // 38: data.v1.ListDeadLettersRequest
+	(*ListDeadLettersResponse)(nil),       // 39: data.v1.ListDeadLettersResponse
+	(*GetDeadLetterRequest)(nil),          // 40: data.v1.GetDeadLetterRequest
+	(*ReplayDeadLettersRequest)(nil),      // 41: data.v1.ReplayDeadLettersRequest
+	(*ReplayDeadLettersResponse)(nil),     // 42: data.v1.ReplayDeadLettersResponse
+	(*EmbeddingModel)(nil),                // 43: data.v1.EmbeddingModel
+	(*ListEmbeddingModelsRequest)(nil),    // 44: data.v1.ListEmbeddingModelsRequest
+	(*ListEmbeddingModelsResponse)(nil),   // 45: data.v1.ListEmbeddingModelsResponse
+	(*StartReembeddingRequest)(nil),       // 46: data.v1.StartReembeddingRequest
+	(*StartReembeddingResponse)(nil),      // 47: data.v1.StartReembeddingResponse
+	(*ActivateEmbeddingModelRequest)(nil), // 48: data.v1.ActivateEmbeddingModelRequest
+	nil,                                   // 49: data.v1.DeadLetter.MetadataEntry
+	(*timestamppb.Timestamp)(nil),         // 50: google.protobuf.Timestamp
 }
 var file_data_v1_model_proto_depIdxs = []int32{
-	1, // 0: data.v1.VectorSearchResponse.chunks:type_name -> data.v1.DocumentChunk
-	4, // 1: data.v1.GetDocumentsOut.documents:type_name -> data.v1.Document
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	50, // 0: data.v1.SearchFilter.dateFrom:type_name -> google.protobuf.Timestamp
+	50, // 1: data.v1.SearchFilter.dateTo:type_name -> google.protobuf.Timestamp
+	4,  // 2: data.v1.VectorSearchRequest.hybrid:type_name -> data.v1.HybridSearch
+	7,  // 3: data.v1.VectorSearchRequest.filter:type_name -> data.v1.SearchFilter
+	6,  // 4: data.v1.VectorSearchRequest.diversify:type_name -> data.v1.Diversification
+	5,  // 5: data.v1.VectorSearchRequest.expand:type_name -> data.v1.NeighborExpansion
+	9,  // 6: data.v1.VectorSearchResponse.chunks:type_name -> data.v1.DocumentChunk
+	4,  // 7: data.v1.BatchVectorSearchRequest.hybrid:type_name -> data.v1.HybridSearch
+	7,  // 8: data.v1.BatchVectorSearchRequest.filter:type_name -> data.v1.SearchFilter
+	6,  // 9: data.v1.BatchVectorSearchRequest.diversify:type_name -> data.v1.Diversification
+	5,  // 10: data.v1.BatchVectorSearchRequest.expand:type_name -> data.v1.NeighborExpansion
+	9,  // 11: data.v1.BatchSearchChunk.chunk:type_name -> data.v1.DocumentChunk
+	12, // 12: data.v1.BatchSearchChunk.hits:type_name -> data.v1.QueryHit
+	13, // 13: data.v1.BatchVectorSearchResponse.chunks:type_name -> data.v1.BatchSearchChunk
+	50, // 14: data.v1.Document.date:type_name -> google.protobuf.Timestamp
+	50, // 15: data.v1.Document.createdAt:type_name -> google.protobuf.Timestamp
+	50, // 16: data.v1.Document.updatedAt:type_name -> google.protobuf.Timestamp
+	9,  // 17: data.v1.GetChunksResponse.chunks:type_name -> data.v1.DocumentChunk
+	21, // 18: data.v1.DownloadOriginalResponse.info:type_name -> data.v1.OriginalInfo
+	16, // 19: data.v1.GetDocumentsOut.documents:type_name -> data.v1.Document
+	24, // 20: data.v1.StructuredTable.columns:type_name -> data.v1.TableColumn
+	25, // 21: data.v1.ListTablesResponse.tables:type_name -> data.v1.StructuredTable
+	0,  // 22: data.v1.Aggregation.function:type_name -> data.v1.AggregateFunction
+	1,  // 23: data.v1.TableFilter.operator:type_name -> data.v1.FilterOperator
+	28, // 24: data.v1.AggregateTableRequest.aggregations:type_name -> data.v1.Aggregation
+	29, // 25: data.v1.AggregateTableRequest.filters:type_name -> data.v1.TableFilter
+	31, // 26: data.v1.AggregateTableResponse.rows:type_name -> data.v1.AggregateRow
+	3,  // 27: data.v1.IngestionObject.type:type_name -> data.v1.IngestionObjectType
+	2,  // 28: data.v1.IngestionObject.state:type_name -> data.v1.IngestionState
+	50, // 29: data.v1.IngestionObject.createdAt:type_name -> google.protobuf.Timestamp
+	50, // 30: data.v1.IngestionObject.updatedAt:type_name -> google.protobuf.Timestamp
+	2,  // 31: data.v1.IngestionStateCount.state:type_name -> data.v1.IngestionState
+	2,  // 32: data.v1.GetIngestionReportRequest.states:type_name -> data.v1.IngestionState
+	3,  // 33: data.v1.GetIngestionReportRequest.type:type_name -> data.v1.IngestionObjectType
+	34, // 34: data.v1.GetIngestionReportResponse.counts:type_name -> data.v1.IngestionStateCount
+	33, // 35: data.v1.GetIngestionReportResponse.objects:type_name -> data.v1.IngestionObject
+	49, // 36: data.v1.DeadLetter.metadata:type_name -> data.v1.DeadLetter.MetadataEntry
+	50, // 37: data.v1.DeadLetter.createdAt:type_name -> google.protobuf.Timestamp
+	50, // 38: data.v1.DeadLetter.replayedAt:type_name -> google.protobuf.Timestamp
+	37, // 39: data.v1.ListDeadLettersResponse.deadLetters:type_name -> data.v1.DeadLetter
+	50, // 40: data.v1.EmbeddingModel.createdAt:type_name -> google.protobuf.Timestamp
+	50, // 41: data.v1.EmbeddingModel.activatedAt:type_name -> google.protobuf.Timestamp
+	43, // 42: data.v1.ListEmbeddingModelsResponse.models:type_name -> data.v1.EmbeddingModel
+	43, // [43:43] is the sub-list for method output_type
+	43, // [43:43] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_data_v1_model_proto_init() }
@@ -494,18 +3739,28 @@ func file_data_v1_model_proto_init() {
 	if File_data_v1_model_proto != nil {
 		return
 	}
+	file_data_v1_model_proto_msgTypes[2].OneofWrappers = []any{}
+	file_data_v1_model_proto_msgTypes[3].OneofWrappers = []any{}
+	file_data_v1_model_proto_msgTypes[4].OneofWrappers = []any{}
+	file_data_v1_model_proto_msgTypes[7].OneofWrappers = []any{}
+	file_data_v1_model_proto_msgTypes[18].OneofWrappers = []any{
+		(*DownloadOriginalResponse_Info)(nil),
+		(*DownloadOriginalResponse_Data)(nil),
+	}
+	file_data_v1_model_proto_msgTypes[42].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_data_v1_model_proto_rawDesc), len(file_data_v1_model_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      4,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_data_v1_model_proto_goTypes,
 		DependencyIndexes: file_data_v1_model_proto_depIdxs,
+		EnumInfos:         file_data_v1_model_proto_enumTypes,
 		MessageInfos:      file_data_v1_model_proto_msgTypes,
 	}.Build()
 	File_data_v1_model_proto = out.File
@@ -24,27 +24,93 @@ var File_data_v1_service_proto protoreflect.FileDescriptor
 
 const file_data_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x15data/v1/service.proto\x12\adata.v1\x1a\x13data/v1/model.proto2\xa1\x01\n" +
+	"\x15data/v1/service.proto\x12\adata.v1\x1a\x13data/v1/model.proto2\xfb\t\n" +
 	"\vDataService\x12M\n" +
-	"\fVectorSearch\x12\x1c.data.v1.VectorSearchRequest\x1a\x1d.data.v1.VectorSearchResponse\"\x00\x12C\n" +
-	"\fGetDocuments\x12\x17.data.v1.GetDocumentsIn\x1a\x18.data.v1.GetDocumentsOut\"\x00B\x12Z\x10internal/data/pbb\x06proto3"
+	"\fVectorSearch\x12\x1c.data.v1.VectorSearchRequest\x1a\x1d.data.v1.VectorSearchResponse\"\x00\x12\\\n" +
+	"\x11BatchVectorSearch\x12!.data.v1.BatchVectorSearchRequest\x1a\".data.v1.BatchVectorSearchResponse\"\x00\x12C\n" +
+	"\fGetDocuments\x12\x17.data.v1.GetDocumentsIn\x1a\x18.data.v1.GetDocumentsOut\"\x00\x12?\n" +
+	"\vGetDocument\x12\x1b.data.v1.GetDocumentRequest\x1a\x11.data.v1.Document\"\x00\x12D\n" +
+	"\tGetChunks\x12\x19.data.v1.GetChunksRequest\x1a\x1a.data.v1.GetChunksResponse\"\x00\x12[\n" +
+	"\x10DownloadOriginal\x12 .data.v1.DownloadOriginalRequest\x1a!.data.v1.DownloadOriginalResponse\"\x000\x01\x12G\n" +
+	"\n" +
+	"ListTables\x12\x1a.data.v1.ListTablesRequest\x1a\x1b.data.v1.ListTablesResponse\"\x00\x12S\n" +
+	"\x0eAggregateTable\x12\x1e.data.v1.AggregateTableRequest\x1a\x1f.data.v1.AggregateTableResponse\"\x00\x12_\n" +
+	"\x12GetIngestionReport\x12\".data.v1.GetIngestionReportRequest\x1a#.data.v1.GetIngestionReportResponse\"\x00\x12V\n" +
+	"\x0fListDeadLetters\x12\x1f.data.v1.ListDeadLettersRequest\x1a .data.v1.ListDeadLettersResponse\"\x00\x12E\n" +
+	"\rGetDeadLetter\x12\x1d.data.v1.GetDeadLetterRequest\x1a\x13.data.v1.DeadLetter\"\x00\x12\\\n" +
+	"\x11ReplayDeadLetters\x12!.data.v1.ReplayDeadLettersRequest\x1a\".data.v1.ReplayDeadLettersResponse\"\x00\x12b\n" +
+	"\x13ListEmbeddingModels\x12#.data.v1.ListEmbeddingModelsRequest\x1a$.data.v1.ListEmbeddingModelsResponse\"\x00\x12Y\n" +
+	"\x10StartReembedding\x12 .data.v1.StartReembeddingRequest\x1a!.data.v1.StartReembeddingResponse\"\x00\x12[\n" +
+	"\x16ActivateEmbeddingModel\x12&.data.v1.ActivateEmbeddingModelRequest\x1a\x17.data.v1.EmbeddingModel\"\x00B\x12Z\x10internal/data/pbb\x06proto3"
 
 var file_data_v1_service_proto_goTypes = []any{
-	(*VectorSearchRequest)(nil),  // 0: data.v1.VectorSearchRequest
-	(*GetDocumentsIn)(nil),       // 1: data.v1.GetDocumentsIn
-	(*VectorSearchResponse)(nil), // 2: data.v1.VectorSearchResponse
-	(*GetDocumentsOut)(nil),      // 3: data.v1.GetDocumentsOut
+	(*VectorSearchRequest)(nil),           // 0: data.v1.VectorSearchRequest
+	(*BatchVectorSearchRequest)(nil),      // 1: data.v1.BatchVectorSearchRequest
+	(*GetDocumentsIn)(nil),                // 2: data.v1.GetDocumentsIn
+	(*GetDocumentRequest)(nil),            // 3: data.v1.GetDocumentRequest
+	(*GetChunksRequest)(nil),              // 4: data.v1.GetChunksRequest
+	(*DownloadOriginalRequest)(nil),       // 5: data.v1.DownloadOriginalRequest
+	(*ListTablesRequest)(nil),             // 6: data.v1.ListTablesRequest
+	(*AggregateTableRequest)(nil),         // 7: data.v1.AggregateTableRequest
+	(*GetIngestionReportRequest)(nil),     // 8: data.v1.GetIngestionReportRequest
+	(*ListDeadLettersRequest)(nil),        // 9: data.v1.ListDeadLettersRequest
+	(*GetDeadLetterRequest)(nil),          // 10: data.v1.GetDeadLetterRequest
+	(*ReplayDeadLettersRequest)(nil),      // 11: data.v1.ReplayDeadLettersRequest
+	(*ListEmbeddingModelsRequest)(nil),    // 12: data.v1.ListEmbeddingModelsRequest
+	(*StartReembeddingRequest)(nil),       // 13: data.v1.StartReembeddingRequest
+	(*ActivateEmbeddingModelRequest)(nil), // 14: data.v1.ActivateEmbeddingModelRequest
+	(*VectorSearchResponse)(nil),          // 15: data.v1.VectorSearchResponse
+	(*BatchVectorSearchResponse)(nil),     // 16: data.v1.BatchVectorSearchResponse
+	(*GetDocumentsOut)(nil),               // 17: data.v1.GetDocumentsOut
+	(*Document)(nil),                      // 18: data.v1.Document
+	(*GetChunksResponse)(nil),             // 19: data.v1.GetChunksResponse
+	(*DownloadOriginalResponse)(nil),      // 20: data.v1.DownloadOriginalResponse
+	(*ListTablesResponse)(nil),            // 21: data.v1.ListTablesResponse
+	(*AggregateTableResponse)(nil),        // 22: data.v1.AggregateTableResponse
+	(*GetIngestionReportResponse)(nil),    // 23: data.v1.GetIngestionReportResponse
+	(*ListDeadLettersResponse)(nil),       // 24: data.v1.ListDeadLettersResponse
+	(*DeadLetter)(nil),                    // 25: data.v1.DeadLetter
+	(*ReplayDeadLettersResponse)(nil),     // 26: data.v1.ReplayDeadLettersResponse
+	(*ListEmbeddingModelsResponse)(nil),   // 27: data.v1.ListEmbeddingModelsResponse
+	(*StartReembeddingResponse)(nil),      // 28: data.v1.StartReembeddingResponse
+	(*EmbeddingModel)(nil),                // 29: data.v1.EmbeddingModel
 }
 var file_data_v1_service_proto_depIdxs = []int32{
-	0, // 0: data.v1.DataService.VectorSearch:input_type -> data.v1.VectorSearchRequest
-	1, // 1: data.v1.DataService.GetDocuments:input_type -> data.v1.GetDocumentsIn
-	2, // 2: data.v1.DataService.VectorSearch:output_type -> data.v1.VectorSearchResponse
-	3, // 3: data.v1.DataService.GetDocuments:output_type -> data.v1.GetDocumentsOut
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: data.v1.DataService.VectorSearch:input_type -> data.v1.VectorSearchRequest
+	1,  // 1: data.v1.DataService.BatchVectorSearch:input_type -> data.v1.BatchVectorSearchRequest
+	2,  // 2: data.v1.DataService.GetDocuments:input_type -> data.v1.GetDocumentsIn
+	3,  // 3: data.v1.DataService.GetDocument:input_type -> data.v1.GetDocumentRequest
+	4,  // 4: data.v1.DataService.GetChunks:input_type -> data.v1.GetChunksRequest
+	5,  // 5: data.v1.DataService.DownloadOriginal:input_type -> data.v1.DownloadOriginalRequest
+	6,  // 6: data.v1.DataService.ListTables:input_type -> data.v1.ListTablesRequest
+	7,  // 7: data.v1.DataService.AggregateTable:input_type -> data.v1.AggregateTableRequest
+	8,  // 8: data.v1.DataService.GetIngestionReport:input_type -> data.v1.GetIngestionReportRequest
+	9,  // 9: data.v1.DataService.ListDeadLetters:input_type -> data.v1.ListDeadLettersRequest
+	10, // 10: data.v1.DataService.GetDeadLetter:input_type -> data.v1.GetDeadLetterRequest
+	11, // 11: data.v1.DataService.ReplayDeadLetters:input_type -> data.v1.ReplayDeadLettersRequest
+	12, // 12: data.v1.DataService.ListEmbeddingModels:input_type -> data.v1.ListEmbeddingModelsRequest
+	13, // 13: data.v1.DataService.StartReembedding:input_type -> data.v1.StartReembeddingRequest
+	14, // 14: data.v1.DataService.ActivateEmbeddingModel:input_type -> data.v1.ActivateEmbeddingModelRequest
+	15, // 15: data.v1.DataService.VectorSearch:output_type -> data.v1.VectorSearchResponse
+	16, // 16: data.v1.DataService.BatchVectorSearch:output_type -> data.v1.BatchVectorSearchResponse
+	17, // 17: data.v1.DataService.GetDocuments:output_type -> data.v1.GetDocumentsOut
+	18, // 18: data.v1.DataService.GetDocument:output_type -> data.v1.Document
+	19, // 19: data.v1.DataService.GetChunks:output_type -> data.v1.GetChunksResponse
+	20, // 20: data.v1.DataService.DownloadOriginal:output_type -> data.v1.DownloadOriginalResponse
+	21, // 21: data.v1.DataService.ListTables:output_type -> data.v1.ListTablesResponse
+	22, // 22: data.v1.DataService.AggregateTable:output_type -> data.v1.AggregateTableResponse
+	23, // 23: data.v1.DataService.GetIngestionReport:output_type -> data.v1.GetIngestionReportResponse
+	24, // 24: data.v1.DataService.ListDeadLetters:output_type -> data.v1.ListDeadLettersResponse
+	25, // 25: data.v1.DataService.GetDeadLetter:output_type -> data.v1.DeadLetter
+	26, // 26: data.v1.DataService.ReplayDeadLetters:output_type -> data.v1.ReplayDeadLettersResponse
+	27, // 27: data.v1.DataService.ListEmbeddingModels:output_type -> data.v1.ListEmbeddingModelsResponse
+	28, // 28: data.v1.DataService.StartReembedding:output_type -> data.v1.StartReembeddingResponse
+	29, // 29: data.v1.DataService.ActivateEmbeddingModel:output_type -> data.v1.EmbeddingModel
+	15, // [15:30] is the sub-list for method output_type
+	0,  // [0:15] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_data_v1_service_proto_init() }
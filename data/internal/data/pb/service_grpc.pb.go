@@ -19,8 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DataService_VectorSearch_FullMethodName = "/data.v1.DataService/VectorSearch"
-	DataService_GetDocuments_FullMethodName = "/data.v1.DataService/GetDocuments"
+	DataService_VectorSearch_FullMethodName           = "/data.v1.DataService/VectorSearch"
+	DataService_BatchVectorSearch_FullMethodName      = "/data.v1.DataService/BatchVectorSearch"
+	DataService_GetDocuments_FullMethodName           = "/data.v1.DataService/GetDocuments"
+	DataService_GetDocument_FullMethodName            = "/data.v1.DataService/GetDocument"
+	DataService_GetChunks_FullMethodName              = "/data.v1.DataService/GetChunks"
+	DataService_DownloadOriginal_FullMethodName       = "/data.v1.DataService/DownloadOriginal"
+	DataService_ListTables_FullMethodName             = "/data.v1.DataService/ListTables"
+	DataService_AggregateTable_FullMethodName         = "/data.v1.DataService/AggregateTable"
+	DataService_GetIngestionReport_FullMethodName     = "/data.v1.DataService/GetIngestionReport"
+	DataService_ListDeadLetters_FullMethodName        = "/data.v1.DataService/ListDeadLetters"
+	DataService_GetDeadLetter_FullMethodName          = "/data.v1.DataService/GetDeadLetter"
+	DataService_ReplayDeadLetters_FullMethodName      = "/data.v1.DataService/ReplayDeadLetters"
+	DataService_ListEmbeddingModels_FullMethodName    = "/data.v1.DataService/ListEmbeddingModels"
+	DataService_StartReembedding_FullMethodName       = "/data.v1.DataService/StartReembedding"
+	DataService_ActivateEmbeddingModel_FullMethodName = "/data.v1.DataService/ActivateEmbeddingModel"
 )
 
 // DataServiceClient is the client API for DataService service.
@@ -28,7 +41,20 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DataServiceClient interface {
 	VectorSearch(ctx context.Context, in *VectorSearchRequest, opts ...grpc.CallOption) (*VectorSearchResponse, error)
+	BatchVectorSearch(ctx context.Context, in *BatchVectorSearchRequest, opts ...grpc.CallOption) (*BatchVectorSearchResponse, error)
 	GetDocuments(ctx context.Context, in *GetDocumentsIn, opts ...grpc.CallOption) (*GetDocumentsOut, error)
+	GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*Document, error)
+	GetChunks(ctx context.Context, in *GetChunksRequest, opts ...grpc.CallOption) (*GetChunksResponse, error)
+	DownloadOriginal(ctx context.Context, in *DownloadOriginalRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadOriginalResponse], error)
+	ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ListTablesResponse, error)
+	AggregateTable(ctx context.Context, in *AggregateTableRequest, opts ...grpc.CallOption) (*AggregateTableResponse, error)
+	GetIngestionReport(ctx context.Context, in *GetIngestionReportRequest, opts ...grpc.CallOption) (*GetIngestionReportResponse, error)
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error)
+	GetDeadLetter(ctx context.Context, in *GetDeadLetterRequest, opts ...grpc.CallOption) (*DeadLetter, error)
+	ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error)
+	ListEmbeddingModels(ctx context.Context, in *ListEmbeddingModelsRequest, opts ...grpc.CallOption) (*ListEmbeddingModelsResponse, error)
+	StartReembedding(ctx context.Context, in *StartReembeddingRequest, opts ...grpc.CallOption) (*StartReembeddingResponse, error)
+	ActivateEmbeddingModel(ctx context.Context, in *ActivateEmbeddingModelRequest, opts ...grpc.CallOption) (*EmbeddingModel, error)
 }
 
 type dataServiceClient struct {
@@ -49,6 +75,16 @@ func (c *dataServiceClient) VectorSearch(ctx context.Context, in *VectorSearchRe
 	return out, nil
 }
 
+func (c *dataServiceClient) BatchVectorSearch(ctx context.Context, in *BatchVectorSearchRequest, opts ...grpc.CallOption) (*BatchVectorSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchVectorSearchResponse)
+	err := c.cc.Invoke(ctx, DataService_BatchVectorSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataServiceClient) GetDocuments(ctx context.Context, in *GetDocumentsIn, opts ...grpc.CallOption) (*GetDocumentsOut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetDocumentsOut)
@@ -59,12 +95,154 @@ func (c *dataServiceClient) GetDocuments(ctx context.Context, in *GetDocumentsIn
 	return out, nil
 }
 
+func (c *dataServiceClient) GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*Document, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Document)
+	err := c.cc.Invoke(ctx, DataService_GetDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) GetChunks(ctx context.Context, in *GetChunksRequest, opts ...grpc.CallOption) (*GetChunksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChunksResponse)
+	err := c.cc.Invoke(ctx, DataService_GetChunks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) DownloadOriginal(ctx context.Context, in *DownloadOriginalRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadOriginalResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DataService_ServiceDesc.Streams[0], DataService_DownloadOriginal_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadOriginalRequest, DownloadOriginalResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataService_DownloadOriginalClient = grpc.ServerStreamingClient[DownloadOriginalResponse]
+
+func (c *dataServiceClient) ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ListTablesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTablesResponse)
+	err := c.cc.Invoke(ctx, DataService_ListTables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) AggregateTable(ctx context.Context, in *AggregateTableRequest, opts ...grpc.CallOption) (*AggregateTableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AggregateTableResponse)
+	err := c.cc.Invoke(ctx, DataService_AggregateTable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) GetIngestionReport(ctx context.Context, in *GetIngestionReportRequest, opts ...grpc.CallOption) (*GetIngestionReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIngestionReportResponse)
+	err := c.cc.Invoke(ctx, DataService_GetIngestionReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeadLettersResponse)
+	err := c.cc.Invoke(ctx, DataService_ListDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) GetDeadLetter(ctx context.Context, in *GetDeadLetterRequest, opts ...grpc.CallOption) (*DeadLetter, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeadLetter)
+	err := c.cc.Invoke(ctx, DataService_GetDeadLetter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayDeadLettersResponse)
+	err := c.cc.Invoke(ctx, DataService_ReplayDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) ListEmbeddingModels(ctx context.Context, in *ListEmbeddingModelsRequest, opts ...grpc.CallOption) (*ListEmbeddingModelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmbeddingModelsResponse)
+	err := c.cc.Invoke(ctx, DataService_ListEmbeddingModels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) StartReembedding(ctx context.Context, in *StartReembeddingRequest, opts ...grpc.CallOption) (*StartReembeddingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartReembeddingResponse)
+	err := c.cc.Invoke(ctx, DataService_StartReembedding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) ActivateEmbeddingModel(ctx context.Context, in *ActivateEmbeddingModelRequest, opts ...grpc.CallOption) (*EmbeddingModel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmbeddingModel)
+	err := c.cc.Invoke(ctx, DataService_ActivateEmbeddingModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataServiceServer is the server API for DataService service.
 // All implementations must embed UnimplementedDataServiceServer
 // for forward compatibility.
 type DataServiceServer interface {
 	VectorSearch(context.Context, *VectorSearchRequest) (*VectorSearchResponse, error)
+	BatchVectorSearch(context.Context, *BatchVectorSearchRequest) (*BatchVectorSearchResponse, error)
 	GetDocuments(context.Context, *GetDocumentsIn) (*GetDocumentsOut, error)
+	GetDocument(context.Context, *GetDocumentRequest) (*Document, error)
+	GetChunks(context.Context, *GetChunksRequest) (*GetChunksResponse, error)
+	DownloadOriginal(*DownloadOriginalRequest, grpc.ServerStreamingServer[DownloadOriginalResponse]) error
+	ListTables(context.Context, *ListTablesRequest) (*ListTablesResponse, error)
+	AggregateTable(context.Context, *AggregateTableRequest) (*AggregateTableResponse, error)
+	GetIngestionReport(context.Context, *GetIngestionReportRequest) (*GetIngestionReportResponse, error)
+	ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error)
+	GetDeadLetter(context.Context, *GetDeadLetterRequest) (*DeadLetter, error)
+	ReplayDeadLetters(context.Context, *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error)
+	ListEmbeddingModels(context.Context, *ListEmbeddingModelsRequest) (*ListEmbeddingModelsResponse, error)
+	StartReembedding(context.Context, *StartReembeddingRequest) (*StartReembeddingResponse, error)
+	ActivateEmbeddingModel(context.Context, *ActivateEmbeddingModelRequest) (*EmbeddingModel, error)
 	mustEmbedUnimplementedDataServiceServer()
 }
 
@@ -78,9 +256,48 @@ type UnimplementedDataServiceServer struct{}
 func (UnimplementedDataServiceServer) VectorSearch(context.Context, *VectorSearchRequest) (*VectorSearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VectorSearch not implemented")
 }
+func (UnimplementedDataServiceServer) BatchVectorSearch(context.Context, *BatchVectorSearchRequest) (*BatchVectorSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchVectorSearch not implemented")
+}
 func (UnimplementedDataServiceServer) GetDocuments(context.Context, *GetDocumentsIn) (*GetDocumentsOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDocuments not implemented")
 }
+func (UnimplementedDataServiceServer) GetDocument(context.Context, *GetDocumentRequest) (*Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocument not implemented")
+}
+func (UnimplementedDataServiceServer) GetChunks(context.Context, *GetChunksRequest) (*GetChunksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChunks not implemented")
+}
+func (UnimplementedDataServiceServer) DownloadOriginal(*DownloadOriginalRequest, grpc.ServerStreamingServer[DownloadOriginalResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadOriginal not implemented")
+}
+func (UnimplementedDataServiceServer) ListTables(context.Context, *ListTablesRequest) (*ListTablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTables not implemented")
+}
+func (UnimplementedDataServiceServer) AggregateTable(context.Context, *AggregateTableRequest) (*AggregateTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateTable not implemented")
+}
+func (UnimplementedDataServiceServer) GetIngestionReport(context.Context, *GetIngestionReportRequest) (*GetIngestionReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIngestionReport not implemented")
+}
+func (UnimplementedDataServiceServer) ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+func (UnimplementedDataServiceServer) GetDeadLetter(context.Context, *GetDeadLetterRequest) (*DeadLetter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetter not implemented")
+}
+func (UnimplementedDataServiceServer) ReplayDeadLetters(context.Context, *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetters not implemented")
+}
+func (UnimplementedDataServiceServer) ListEmbeddingModels(context.Context, *ListEmbeddingModelsRequest) (*ListEmbeddingModelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmbeddingModels not implemented")
+}
+func (UnimplementedDataServiceServer) StartReembedding(context.Context, *StartReembeddingRequest) (*StartReembeddingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartReembedding not implemented")
+}
+func (UnimplementedDataServiceServer) ActivateEmbeddingModel(context.Context, *ActivateEmbeddingModelRequest) (*EmbeddingModel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateEmbeddingModel not implemented")
+}
 func (UnimplementedDataServiceServer) mustEmbedUnimplementedDataServiceServer() {}
 func (UnimplementedDataServiceServer) testEmbeddedByValue()                     {}
 
@@ -120,6 +337,24 @@ func _DataService_VectorSearch_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataService_BatchVectorSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchVectorSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).BatchVectorSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_BatchVectorSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).BatchVectorSearch(ctx, req.(*BatchVectorSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataService_GetDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDocumentsIn)
 	if err := dec(in); err != nil {
@@ -138,6 +373,215 @@ func _DataService_GetDocuments_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataService_GetDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).GetDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_GetDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).GetDocument(ctx, req.(*GetDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_GetChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChunksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).GetChunks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_GetChunks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).GetChunks(ctx, req.(*GetChunksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_DownloadOriginal_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadOriginalRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataServiceServer).DownloadOriginal(m, &grpc.GenericServerStream[DownloadOriginalRequest, DownloadOriginalResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataService_DownloadOriginalServer = grpc.ServerStreamingServer[DownloadOriginalResponse]
+
+func _DataService_ListTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).ListTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_ListTables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).ListTables(ctx, req.(*ListTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_AggregateTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).AggregateTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_AggregateTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).AggregateTable(ctx, req.(*AggregateTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_GetIngestionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIngestionReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).GetIngestionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_GetIngestionReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).GetIngestionReport(ctx, req.(*GetIngestionReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_ListDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).ListDeadLetters(ctx, req.(*ListDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_GetDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).GetDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_GetDeadLetter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).GetDeadLetter(ctx, req.(*GetDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_ReplayDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).ReplayDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_ReplayDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).ReplayDeadLetters(ctx, req.(*ReplayDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_ListEmbeddingModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmbeddingModelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).ListEmbeddingModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_ListEmbeddingModels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).ListEmbeddingModels(ctx, req.(*ListEmbeddingModelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_StartReembedding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartReembeddingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).StartReembedding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_StartReembedding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).StartReembedding(ctx, req.(*StartReembeddingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_ActivateEmbeddingModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateEmbeddingModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).ActivateEmbeddingModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_ActivateEmbeddingModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).ActivateEmbeddingModel(ctx, req.(*ActivateEmbeddingModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DataService_ServiceDesc is the grpc.ServiceDesc for DataService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -149,11 +593,65 @@ var DataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VectorSearch",
 			Handler:    _DataService_VectorSearch_Handler,
 		},
+		{
+			MethodName: "BatchVectorSearch",
+			Handler:    _DataService_BatchVectorSearch_Handler,
+		},
 		{
 			MethodName: "GetDocuments",
 			Handler:    _DataService_GetDocuments_Handler,
 		},
+		{
+			MethodName: "GetDocument",
+			Handler:    _DataService_GetDocument_Handler,
+		},
+		{
+			MethodName: "GetChunks",
+			Handler:    _DataService_GetChunks_Handler,
+		},
+		{
+			MethodName: "ListTables",
+			Handler:    _DataService_ListTables_Handler,
+		},
+		{
+			MethodName: "AggregateTable",
+			Handler:    _DataService_AggregateTable_Handler,
+		},
+		{
+			MethodName: "GetIngestionReport",
+			Handler:    _DataService_GetIngestionReport_Handler,
+		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _DataService_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "GetDeadLetter",
+			Handler:    _DataService_GetDeadLetter_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetters",
+			Handler:    _DataService_ReplayDeadLetters_Handler,
+		},
+		{
+			MethodName: "ListEmbeddingModels",
+			Handler:    _DataService_ListEmbeddingModels_Handler,
+		},
+		{
+			MethodName: "StartReembedding",
+			Handler:    _DataService_StartReembedding_Handler,
+		},
+		{
+			MethodName: "ActivateEmbeddingModel",
+			Handler:    _DataService_ActivateEmbeddingModel_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadOriginal",
+			Handler:       _DataService_DownloadOriginal_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "data/v1/service.proto",
 }
@@ -0,0 +1,79 @@
+package document
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidChunkingConfig = errors.New("invalid chunking config") // ошибка некорректных параметров разбиения
+
+type ChunkingStrategy string
+
+const (
+	ChunkingRecursive ChunkingStrategy = "recursive" // разбиение по абзацам, строкам, предложениям и словам
+	ChunkingMarkdown  ChunkingStrategy = "markdown"  // разбиение по разделам заголовков, затем рекурсивно
+)
+
+type ChunkSizeUnit string
+
+const (
+	UnitChars  ChunkSizeUnit = "chars"  // размер в символах (рунах)
+	UnitTokens ChunkSizeUnit = "tokens" // размер в приблизительно оцененных токенах, см. splitter.EstimateTokens
+)
+
+const (
+	DefaultChunkSize    = 4096 // размер чанка по умолчанию
+	DefaultChunkOverlap = 100  // перекрытие соседних чанков по умолчанию
+	MaxChunkSize        = 32768
+)
+
+// ChunkingConfig параметры разбиения документов источника на чанки
+type ChunkingConfig struct {
+	Strategy ChunkingStrategy `json:"strategy"` // стратегия разбиения
+	Unit     ChunkSizeUnit    `json:"unit"`     // единица измерения размера чанка
+	Size     int              `json:"size"`     // максимальный размер чанка
+	Overlap  int              `json:"overlap"`  // перекрытие соседних чанков
+}
+
+func DefaultChunkingConfig() ChunkingConfig {
+	return ChunkingConfig{
+		Strategy: ChunkingMarkdown,
+		Unit:     UnitChars,
+		Size:     DefaultChunkSize,
+		Overlap:  DefaultChunkOverlap,
+	}
+}
+
+// Normalize заполняет незаданные параметры значениями по умолчанию и проверяет их корректность.
+func (c *ChunkingConfig) Normalize() error {
+	defaults := DefaultChunkingConfig()
+	if c.Strategy == "" {
+		c.Strategy = defaults.Strategy
+	}
+	if c.Unit == "" {
+		c.Unit = defaults.Unit
+	}
+	if c.Size == 0 {
+		c.Size = defaults.Size
+		if c.Overlap == 0 {
+			c.Overlap = defaults.Overlap
+		}
+	}
+	switch c.Strategy {
+	case ChunkingRecursive, ChunkingMarkdown:
+	default:
+		return fmt.Errorf("%w: unknown strategy %q", ErrInvalidChunkingConfig, c.Strategy)
+	}
+	switch c.Unit {
+	case UnitChars, UnitTokens:
+	default:
+		return fmt.Errorf("%w: unknown unit %q", ErrInvalidChunkingConfig, c.Unit)
+	}
+	if c.Size < 0 || c.Size > MaxChunkSize {
+		return fmt.Errorf("%w: size must be in range 1..%d", ErrInvalidChunkingConfig, MaxChunkSize)
+	}
+	if c.Overlap < 0 || c.Overlap >= c.Size {
+		return fmt.Errorf("%w: overlap must be non-negative and less than size", ErrInvalidChunkingConfig)
+	}
+	return nil
+}
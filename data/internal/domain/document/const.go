@@ -10,6 +10,12 @@ const (
 	MD   FileExtension = ".md"
 	CSV  FileExtension = ".csv"
 	HTML FileExtension = ".html"
+	DOCX FileExtension = ".docx"
+	PPTX FileExtension = ".pptx"
+	ODT  FileExtension = ".odt"
+	RTF  FileExtension = ".rtf"
+	XLSX FileExtension = ".xlsx"
+	ODS  FileExtension = ".ods"
 )
 
 var FileExtensionMap = map[string]FileExtension{
@@ -21,4 +27,10 @@ var FileExtensionMap = map[string]FileExtension{
 	".md":   MD,
 	".csv":  CSV,
 	".html": HTML,
+	".docx": DOCX,
+	".pptx": PPTX,
+	".odt":  ODT,
+	".rtf":  RTF,
+	".xlsx": XLSX,
+	".ods":  ODS,
 }
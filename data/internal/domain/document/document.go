@@ -2,12 +2,15 @@ package document
 
 import (
 	"errors"
+	"io"
 	"time"
 	"unicode/utf8"
 )
 
 var (
 	ErrDocumentNotFound = errors.New("document not found") // ошибка, когда документ не найден
+	ErrOriginalNotFound = errors.New("original not found") // исходный объект документа удален из хранилища
+	ErrStaleGeneration  = errors.New("stale generation")   // построение поколения источника заменено более новым
 )
 
 type Type string
@@ -18,32 +21,52 @@ const (
 )
 
 type Document struct {
-	ID         string         `db:"id"`          // идентификатор документа в векторном хранилище
-	SourceID   string         `db:"source_id"`   // идентификатор источника к которому относится документ
-	ObjectID   string         `db:"object_id"`   // идентификатор объекта к которому относится документ
-	ObjectType Type           `db:"object_type"` // тип объекта к которому относится документ
-	Name       string         `db:"name"`        // название документа для файлов или title для html страниц
-	Content    string         `db:"content"`     // содержание документа
-	Metadata   map[string]any `db:"metadata"`    // метаданные документа (например, заголовок, автор, дата создания и т.д.)
-	Chunks     []string       `db:"chunks"`      // IDS чанков данного документа
-	CreatedAt  time.Time      `db:"created_at"`  // дата создания документа
-	UpdatedAt  time.Time      `db:"updated_at"`  // дата последнего обновления документа
+	ID         string         `db:"id"`            // идентификатор документа в векторном хранилище
+	SourceID   string         `db:"source_id"`     // идентификатор источника к которому относится документ
+	ObjectID   string         `db:"object_id"`     // идентификатор объекта к которому относится документ
+	ObjectType Type           `db:"object_type"`   // тип объекта к которому относится документ
+	Name       string         `db:"name"`          // название документа для файлов или title для html страниц
+	Content    string         `db:"content"`       // содержание документа
+	Metadata   map[string]any `db:"metadata"`      // метаданные документа (например, заголовок, автор, дата создания и т.д.)
+	Extension  string         `db:"extension"`     // расширение исходного файла, для страниц .html
+	URL        string         `db:"url"`           // url страницы или путь файла в источнике
+	Language   string         `db:"language"`      // язык документа, определенный по тексту
+	Date       time.Time      `db:"document_date"` // дата документа: Last-Modified страницы или время загрузки файла
+	Chunks     []string       `db:"chunks"`        // IDS чанков данного документа
+	Pages      []string       `db:"-"`             // текст страниц для постраничных форматов (pdf), не сохраняется
+	Sheets     []Sheet        `db:"-"`             // листы табличных форматов (csv, xlsx, ods), не сохраняются
+	CreatedAt  time.Time      `db:"created_at"`    // дата создания документа
+	UpdatedAt  time.Time      `db:"updated_at"`    // дата последнего обновления документа
 }
 
 type Chunk struct {
-	ID         string    `db:"id"`          // идентификатор чанка в векторном хранилище
-	Index      int       `db:"index"`       // индекс чанка в документе
-	SourceID   string    `db:"source_id"`   // идентификатор источника к которому относиться данный чанк
-	DocumentID string    `db:"document_id"` // идентификатор документа к которому относиться данный чанк
-	Content    string    `db:"content"`     // текстовый контент чанка
-	Metadata   []byte    `db:"metadata"`    // метаданные чанка (например, заголовок, автор, дата создания и т.д.)
-	Embeddings []float32 `db:"embeddings"`  // векторное представление чанка
+	ID             string    `db:"id"`              // идентификатор чанка в векторном хранилище
+	Index          int       `db:"index"`           // индекс чанка в документе
+	SourceID       string    `db:"source_id"`       // идентификатор источника к которому относиться данный чанк
+	DocumentID     string    `db:"document_id"`     // идентификатор документа к которому относиться данный чанк
+	Content        string    `db:"content"`         // текстовый контент чанка
+	Metadata       []byte    `db:"metadata"`        // метаданные чанка (например, заголовок, автор, дата создания и т.д.)
+	Embeddings     []float32 `db:"-"`               // векторное представление чанка основной моделью, хранится в таблице векторов модели
+	EmbeddingModel string    `db:"embedding_model"` // модель с версией, которой получено векторное представление
 }
 
 type SearchResult struct {
 	Chunk
-	DocumentName     string  `db:"document_name"`
-	CosineSimilarity float32 `db:"cosine_similarity"` // оценка релевантности чанка к запросу
+	DocumentName     string     `db:"document_name"`
+	DocumentURL      string     `db:"document_url"`      // url страницы или путь файла документа
+	CosineSimilarity float32    `db:"cosine_similarity"` // оценка релевантности чанка к запросу
+	Score            float32    `db:"-"`                 // оценка RRF в гибридном поиске
+	EndIndex         int        `db:"-"`                 // индекс последнего чанка отрывка, заполняется при расширении соседями
+	ChunkIDs         []string   `db:"-"`                 // чанки отрывка по порядку в документе, заполняются при расширении соседями
+	Hits             []QueryHit `db:"-"`                 // позиции чанка в выдачах запросов пакетного поиска
+}
+
+// Original содержимое исходного файла или снимок html страницы, из которых получен документ
+type Original struct {
+	Filename    string
+	ContentType string
+	Size        int64
+	Body        io.ReadCloser // вызывающий закрывает после чтения
 }
 
 func CleanUTF8(input string) string {
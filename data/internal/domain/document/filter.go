@@ -0,0 +1,104 @@
+package document
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	LanguageRu = "ru" // документ преимущественно на кириллице
+	LanguageEn = "en" // документ преимущественно на латинице
+)
+
+const (
+	MaxPredicates      = 16   // максимальное количество jsonpath предикатов в фильтре
+	MaxPredicateLength = 1024 // максимальная длина одного jsonpath предиката
+)
+
+var (
+	ErrInvalidFilter = errors.New("invalid search filter")        // фильтр не может быть применен
+	ErrSearchTimeout = errors.New("search with filter timed out") // поиск с фильтром превысил ограничение времени
+)
+
+// SearchFilter ограничения поиска, применяются в запросе до сортировки и лимита, условия объединяются через AND
+type SearchFilter struct {
+	Types              []Type     // типы документов
+	Extensions         []string   // расширения файлов с точкой в нижнем регистре
+	URLPrefixes        []string   // префиксы url страницы или пути файла
+	DateFrom           *time.Time // нижняя граница даты документа включительно
+	DateTo             *time.Time // верхняя граница даты документа включительно
+	Languages          []string   // языки документа
+	DocumentPredicates []string   // jsonpath предикаты по метаданным документа
+	ChunkPredicates    []string   // jsonpath предикаты по метаданным чанка
+}
+
+// IsEmpty возвращает true, если фильтр не ограничивает поиск
+func (f *SearchFilter) IsEmpty() bool {
+	return f == nil || len(f.Types) == 0 && len(f.Extensions) == 0 && len(f.URLPrefixes) == 0 &&
+		f.DateFrom == nil && f.DateTo == nil && len(f.Languages) == 0 &&
+		len(f.DocumentPredicates) == 0 && len(f.ChunkPredicates) == 0
+}
+
+// Validate проверяет ограничения на количество и длину jsonpath предикатов,
+// синтаксис предикатов проверяется хранилищем до выполнения поиска
+func (f *SearchFilter) Validate() error {
+	if f == nil {
+		return nil
+	}
+	if len(f.DocumentPredicates)+len(f.ChunkPredicates) > MaxPredicates {
+		return fmt.Errorf("%w: too many predicates, must not be greater than %d", ErrInvalidFilter, MaxPredicates)
+	}
+	for _, predicate := range f.Predicates() {
+		if strings.TrimSpace(predicate) == "" {
+			return fmt.Errorf("%w: empty predicate", ErrInvalidFilter)
+		}
+		if len(predicate) > MaxPredicateLength {
+			return fmt.Errorf("%w: predicate is longer than %d bytes", ErrInvalidFilter, MaxPredicateLength)
+		}
+	}
+	return nil
+}
+
+// Predicates возвращает все jsonpath предикаты фильтра
+func (f *SearchFilter) Predicates() []string {
+	if f == nil {
+		return nil
+	}
+	predicates := make([]string, 0, len(f.DocumentPredicates)+len(f.ChunkPredicates))
+	predicates = append(predicates, f.DocumentPredicates...)
+	return append(predicates, f.ChunkPredicates...)
+}
+
+// NormalizeExtension приводит расширение к виду, в котором оно хранится у документа: ".pdf"
+func NormalizeExtension(ext string) string {
+	ext = strings.ToLower(strings.TrimSpace(ext))
+	if ext == "" || strings.HasPrefix(ext, ".") {
+		return ext
+	}
+	return "." + ext
+}
+
+// DetectLanguage определяет язык текста по преобладающему алфавиту,
+// возвращает пустую строку, если букв кириллицы и латиницы в тексте нет
+func DetectLanguage(text string) string {
+	var cyrillic, latin int
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Cyrillic, r):
+			cyrillic++
+		case unicode.Is(unicode.Latin, r):
+			latin++
+		}
+	}
+	switch {
+	case cyrillic == 0 && latin == 0:
+		return ""
+	case cyrillic >= latin:
+		return LanguageRu
+	default:
+		return LanguageEn
+	}
+}
@@ -0,0 +1,35 @@
+package document
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	assert.Equal(t, LanguageRu, DetectLanguage("Правила приема в магистратуру, раздел FAQ"))
+	assert.Equal(t, LanguageEn, DetectLanguage("Admission rules, раздел"))
+	assert.Empty(t, DetectLanguage("2025-06-01 12:00"))
+}
+
+func TestSearchFilterIsEmpty(t *testing.T) {
+	assert.True(t, (*SearchFilter)(nil).IsEmpty())
+	assert.True(t, (&SearchFilter{}).IsEmpty())
+	assert.False(t, (&SearchFilter{Languages: []string{LanguageEn}}).IsEmpty())
+	assert.Equal(t, ".pdf", NormalizeExtension(" PDF"))
+}
+
+func TestSearchFilterValidate(t *testing.T) {
+	assert.NoError(t, (*SearchFilter)(nil).Validate())
+	assert.NoError(t, (&SearchFilter{ChunkPredicates: []string{`$.page > 2`}}).Validate())
+
+	tooMany := make([]string, MaxPredicates+1)
+	for i := range tooMany {
+		tooMany[i] = `$.page > 2`
+	}
+	assert.ErrorIs(t, (&SearchFilter{DocumentPredicates: tooMany}).Validate(), ErrInvalidFilter)
+	assert.ErrorIs(t, (&SearchFilter{ChunkPredicates: []string{" "}}).Validate(), ErrInvalidFilter)
+	long := `$.title == "` + strings.Repeat("a", MaxPredicateLength) + `"`
+	assert.ErrorIs(t, (&SearchFilter{ChunkPredicates: []string{long}}).Validate(), ErrInvalidFilter)
+}
@@ -0,0 +1,132 @@
+package document
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	// MaxNeighborWindow максимальное количество соседних чанков с каждой стороны результата
+	MaxNeighborWindow = 5
+	// minOverlap минимальная длина совпадения конца чанка с началом следующего, которое считается перекрытием
+	minOverlap = 8
+)
+
+// ChunkRange диапазон индексов чанков документа включительно
+type ChunkRange struct {
+	DocumentID string
+	From       int
+	To         int
+}
+
+// NeighborRanges возвращает диапазоны чанков, покрывающие результаты и их window соседей с каждой стороны
+func NeighborRanges(results []*SearchResult, window int) []ChunkRange {
+	chunks := make([]*Chunk, 0, len(results))
+	for _, r := range results {
+		chunks = append(chunks, &r.Chunk)
+	}
+	return ChunkRanges(chunks, window)
+}
+
+// ChunkRanges возвращает диапазоны, покрывающие чанки и их window соседей с каждой стороны,
+// пересекающиеся и смежные диапазоны одного документа объединяются
+func ChunkRanges(chunks []*Chunk, window int) []ChunkRange {
+	ranges := make([]ChunkRange, 0, len(chunks))
+	for _, c := range chunks {
+		ranges = append(ranges, ChunkRange{DocumentID: c.DocumentID, From: max(c.Index-window, 0), To: c.Index + window})
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		if ranges[i].DocumentID != ranges[j].DocumentID {
+			return ranges[i].DocumentID < ranges[j].DocumentID
+		}
+		return ranges[i].From < ranges[j].From
+	})
+	merged := make([]ChunkRange, 0, len(ranges))
+	for _, r := range ranges {
+		last := len(merged) - 1
+		if last >= 0 && merged[last].DocumentID == r.DocumentID && r.From <= merged[last].To+1 {
+			merged[last].To = max(merged[last].To, r.To)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// ExpandPassages заменяет результаты отрывками из соседних чанков neighbors в пределах window.
+// Результаты, попавшие в один непрерывный отрывок, объединяются, отрывок занимает позицию
+// лучшего из них и наследует его идентификатор и оценки. Перекрытие соседних чанков в тексте не повторяется.
+func ExpandPassages(results []*SearchResult, neighbors []*Chunk, window int) []*SearchResult {
+	chunks := make(map[string]map[int]*Chunk)
+	add := func(c *Chunk) {
+		if chunks[c.DocumentID] == nil {
+			chunks[c.DocumentID] = make(map[int]*Chunk)
+		}
+		chunks[c.DocumentID][c.Index] = c
+	}
+	for _, c := range neighbors {
+		add(c)
+	}
+	for _, r := range results {
+		add(&r.Chunk)
+	}
+
+	ranges := NeighborRanges(results, window)
+	emitted := make([]bool, len(ranges))
+	passages := make([]*SearchResult, 0, len(ranges))
+	for _, r := range results {
+		i := sort.Search(len(ranges), func(i int) bool {
+			return ranges[i].DocumentID > r.DocumentID ||
+				ranges[i].DocumentID == r.DocumentID && ranges[i].To >= r.Index
+		})
+		if i == len(ranges) || emitted[i] {
+			continue
+		}
+		emitted[i] = true
+
+		passage := *r
+		passage.ChunkIDs = nil
+		contents := make([]string, 0, ranges[i].To-ranges[i].From+1)
+		for index := ranges[i].From; index <= ranges[i].To; index++ {
+			c, ok := chunks[r.DocumentID][index]
+			if !ok {
+				continue
+			}
+			if len(passage.ChunkIDs) == 0 {
+				passage.Index = index
+			}
+			passage.EndIndex = index
+			passage.ChunkIDs = append(passage.ChunkIDs, c.ID)
+			contents = append(contents, c.Content)
+		}
+		passage.Content = joinChunks(contents)
+		passages = append(passages, &passage)
+	}
+	return passages
+}
+
+// joinChunks склеивает тексты соседних чанков, убирая перекрытие конца чанка с началом следующего
+func joinChunks(contents []string) string {
+	var sb strings.Builder
+	prev := ""
+	for i, content := range contents {
+		if i == 0 {
+			sb.WriteString(content)
+			prev = content
+			continue
+		}
+		overlap := 0
+		for k := min(len(prev), len(content)); k >= minOverlap; k-- {
+			if strings.HasSuffix(prev, content[:k]) {
+				overlap = k
+				break
+			}
+		}
+		if overlap == 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(content[overlap:])
+		prev = content
+	}
+	return sb.String()
+}
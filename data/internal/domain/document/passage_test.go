@@ -0,0 +1,44 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func hit(id, documentID string, index int) *SearchResult {
+	return &SearchResult{Chunk: Chunk{ID: id, DocumentID: documentID, Index: index, Content: id}}
+}
+
+func TestNeighborRanges(t *testing.T) {
+	ranges := NeighborRanges([]*SearchResult{hit("c5", "d1", 5), hit("c1", "d1", 1), hit("x0", "d2", 0), hit("c8", "d1", 8)}, 1)
+	assert.Equal(t, []ChunkRange{
+		{DocumentID: "d1", From: 0, To: 2},
+		{DocumentID: "d1", From: 4, To: 9},
+		{DocumentID: "d2", From: 0, To: 1},
+	}, ranges)
+}
+
+func TestExpandPassages(t *testing.T) {
+	results := []*SearchResult{hit("c5", "d1", 5), hit("x0", "d2", 0), hit("c6", "d1", 6)}
+	neighbors := []*Chunk{
+		{ID: "c4", DocumentID: "d1", Index: 4, Content: "c4"},
+		{ID: "c7", DocumentID: "d1", Index: 7, Content: "c7"},
+		{ID: "x1", DocumentID: "d2", Index: 1, Content: "x1"},
+	}
+
+	res := ExpandPassages(results, neighbors, 1)
+	assert.Equal(t, []string{"c5", "x0"}, ids(res))
+	assert.Equal(t, []string{"c4", "c5", "c6", "c7"}, res[0].ChunkIDs)
+	assert.Equal(t, 4, res[0].Index)
+	assert.Equal(t, 7, res[0].EndIndex)
+	assert.Equal(t, "c4\nc5\nc6\nc7", res[0].Content)
+	assert.Equal(t, []string{"x0", "x1"}, res[1].ChunkIDs)
+}
+
+func TestJoinChunksOverlap(t *testing.T) {
+	assert.Equal(t,
+		"первый чанк заканчивается перекрытием и продолжается",
+		joinChunks([]string{"первый чанк заканчивается перекрытием", "перекрытием и продолжается"}),
+	)
+}
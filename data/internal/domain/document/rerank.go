@@ -0,0 +1,103 @@
+package document
+
+import "math"
+
+// DefaultMMRLambda вес релевантности по умолчанию, релевантность и новизна равнозначны
+const DefaultMMRLambda = 0.5
+
+// DiversityParams параметры переранжирования методом maximal marginal relevance
+type DiversityParams struct {
+	Lambda         float32 // вес релевантности против сходства с уже выбранными, 1 - порядок по релевантности
+	MaxPerDocument int     // максимум результатов из одного документа, 0 - без ограничения
+	Candidates     int     // количество кандидатов для переранжирования
+}
+
+// Normalize ограничивает параметры допустимыми значениями для выдачи limit результатов
+func (p *DiversityParams) Normalize(limit int) {
+	p.Lambda = min(max(p.Lambda, 0), 1)
+	p.MaxPerDocument = max(p.MaxPerDocument, 0)
+	if p.Candidates < limit {
+		p.Candidates = max(limit*candidatesFactor, minCandidates)
+	}
+}
+
+// MMR выбирает до limit результатов, на каждом шаге беря кандидата с наибольшим
+// Lambda * relevance - (1 - Lambda) * max similarity к уже выбранным.
+// Релевантность - оценка RRF в гибридном поиске или косинусная близость, нормированная на максимум по кандидатам,
+// сходство - косинусная близость векторов чанков, для чанков без вектора считается нулевым.
+// Повторы чанка учитываются по первой позиции.
+func MMR(candidates []*SearchResult, vectors map[string][]float32, params DiversityParams, limit int) []*SearchResult {
+	pool := make([]*SearchResult, 0, len(candidates))
+	seen := make(map[string]struct{}, len(candidates))
+	for _, c := range candidates {
+		if _, ok := seen[c.ID]; ok {
+			continue
+		}
+		seen[c.ID] = struct{}{}
+		pool = append(pool, c)
+	}
+	if limit <= 0 || limit > len(pool) {
+		limit = len(pool)
+	}
+
+	relevance := make([]float32, len(pool))
+	var maxRelevance float32
+	for i, c := range pool {
+		relevance[i] = c.CosineSimilarity
+		if c.Score > 0 {
+			relevance[i] = c.Score
+		}
+		maxRelevance = max(maxRelevance, relevance[i])
+	}
+	if maxRelevance > 0 {
+		for i := range relevance {
+			relevance[i] /= maxRelevance
+		}
+	}
+
+	selected := make([]*SearchResult, 0, limit)
+	used := make([]bool, len(pool))
+	redundancy := make([]float32, len(pool)) // максимальное сходство кандидата с выбранными
+	perDocument := make(map[string]int)
+	for len(selected) < limit {
+		best := -1
+		var bestScore float32
+		for i, c := range pool {
+			if used[i] || params.MaxPerDocument > 0 && perDocument[c.DocumentID] >= params.MaxPerDocument {
+				continue
+			}
+			score := params.Lambda*relevance[i] - (1-params.Lambda)*redundancy[i]
+			if best < 0 || score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+		if best < 0 {
+			break
+		}
+		used[best] = true
+		selected = append(selected, pool[best])
+		perDocument[pool[best].DocumentID]++
+		for i, c := range pool {
+			if !used[i] {
+				redundancy[i] = max(redundancy[i], cosine(vectors[c.ID], vectors[pool[best].ID]))
+			}
+		}
+	}
+	return selected
+}
+
+func cosine(a, b []float32) float32 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return float32(dot / math.Sqrt(normA*normB))
+}
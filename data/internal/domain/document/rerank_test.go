@@ -0,0 +1,50 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func candidate(id, documentID string, similarity float32) *SearchResult {
+	return &SearchResult{Chunk: Chunk{ID: id, DocumentID: documentID}, CosineSimilarity: similarity}
+}
+
+func TestMMR(t *testing.T) {
+	candidates := []*SearchResult{
+		candidate("a", "d1", 0.9),
+		candidate("a2", "d1", 0.89),
+		candidate("b", "d2", 0.7),
+		candidate("a", "d1", 0.9),
+	}
+	vectors := map[string][]float32{
+		"a":  {1, 0},
+		"a2": {1, 0.01},
+		"b":  {0, 1},
+	}
+
+	// почти совпадающий a2 уступает менее релевантному, но отличающемуся b
+	res := MMR(candidates, vectors, DiversityParams{Lambda: 0.5}, 2)
+	assert.Equal(t, []string{"a", "b"}, ids(res))
+
+	// при lambda = 1 сохраняется порядок по релевантности, повторы отбрасываются
+	res = MMR(candidates, vectors, DiversityParams{Lambda: 1}, 0)
+	assert.Equal(t, []string{"a", "a2", "b"}, ids(res))
+}
+
+func TestMMRMaxPerDocument(t *testing.T) {
+	candidates := []*SearchResult{
+		candidate("a", "d1", 0.9),
+		candidate("a2", "d1", 0.8),
+		candidate("a3", "d1", 0.7),
+	}
+
+	res := MMR(candidates, nil, DiversityParams{Lambda: 1, MaxPerDocument: 2}, 3)
+	assert.Equal(t, []string{"a", "a2"}, ids(res))
+}
+
+func TestDiversityParamsNormalize(t *testing.T) {
+	p := DiversityParams{Lambda: 2, MaxPerDocument: -1}
+	p.Normalize(10)
+	assert.Equal(t, DiversityParams{Lambda: 1, Candidates: 40}, p)
+}
@@ -0,0 +1,123 @@
+package document
+
+import "sort"
+
+const (
+	// DefaultRRFK сглаживающая константа reciprocal rank fusion, ослабляет влияние первых позиций
+	DefaultRRFK = 60
+	// candidatesFactor во сколько раз кандидатов каждого вида поиска больше, чем итоговых результатов
+	candidatesFactor = 4
+	minCandidates    = 20
+)
+
+// HybridParams параметры объединения лексического и векторного поиска
+type HybridParams struct {
+	VectorWeight  float32 // вес позиции чанка в векторном поиске
+	LexicalWeight float32 // вес позиции чанка в полнотекстовом поиске
+	K             int     // сглаживающая константа RRF
+	Candidates    int     // количество кандидатов каждого вида поиска
+}
+
+// Normalize заполняет незаданные параметры значениями по умолчанию для выдачи limit результатов
+func (p *HybridParams) Normalize(limit int) {
+	if p.VectorWeight <= 0 && p.LexicalWeight <= 0 {
+		p.VectorWeight, p.LexicalWeight = 1, 1
+	}
+	p.VectorWeight = max(p.VectorWeight, 0)
+	p.LexicalWeight = max(p.LexicalWeight, 0)
+	if p.K <= 0 {
+		p.K = DefaultRRFK
+	}
+	if p.Candidates < limit {
+		p.Candidates = max(limit*candidatesFactor, minCandidates)
+	}
+}
+
+// FuseRRF объединяет результаты векторного и полнотекстового поиска методом reciprocal rank fusion:
+// оценка чанка - сумма weight / (K + rank) по спискам, в которых он найден.
+// Списки должны быть упорядочены по убыванию релевантности, повторы чанка учитываются по лучшей позиции.
+func FuseRRF(vector, lexical []*SearchResult, params HybridParams, limit int) []*SearchResult {
+	fused := make(map[string]*SearchResult, len(vector)+len(lexical))
+	order := make([]*SearchResult, 0, len(vector)+len(lexical))
+	add := func(results []*SearchResult, weight float32) {
+		if weight == 0 {
+			return
+		}
+		seen := make(map[string]struct{}, len(results))
+		rank := 0
+		for _, r := range results {
+			if _, ok := seen[r.ID]; ok {
+				continue
+			}
+			seen[r.ID] = struct{}{}
+			rank++
+			res, ok := fused[r.ID]
+			if !ok {
+				res = r
+				res.Score = 0
+				fused[r.ID] = res
+				order = append(order, res)
+			}
+			res.Score += weight / float32(params.K+rank)
+		}
+	}
+	add(vector, params.VectorWeight)
+	add(lexical, params.LexicalWeight)
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return order[i].Score > order[j].Score
+	})
+	if limit > 0 && len(order) > limit {
+		order = order[:limit]
+	}
+	return order
+}
+
+// QueryHit позиция чанка в выдаче одного из запросов пакетного поиска
+type QueryHit struct {
+	Query            int     // номер запроса в пакете
+	Rank             int     // позиция в выдаче запроса, начиная с 1
+	CosineSimilarity float32 // близость чанка к запросу
+}
+
+// FuseQueries объединяет выдачи нескольких запросов методом RRF с равными весами: оценка чанка -
+// сумма 1 / (k + rank) по выдачам, в которых он найден. Позиции чанка по запросам записываются в Hits
+// от лучшей к худшей, косинусная близость результата - наибольшая по запросам.
+func FuseQueries(rankings [][]*SearchResult, k, limit int) []*SearchResult {
+	fused := make(map[string]*SearchResult)
+	order := make([]*SearchResult, 0)
+	for query, results := range rankings {
+		seen := make(map[string]struct{}, len(results))
+		rank := 0
+		for _, r := range results {
+			if _, ok := seen[r.ID]; ok {
+				continue
+			}
+			seen[r.ID] = struct{}{}
+			rank++
+			res, ok := fused[r.ID]
+			if !ok {
+				res = r
+				res.Score = 0
+				res.Hits = nil
+				fused[r.ID] = res
+				order = append(order, res)
+			}
+			res.Score += 1 / float32(k+rank)
+			res.CosineSimilarity = max(res.CosineSimilarity, r.CosineSimilarity)
+			res.Hits = append(res.Hits, QueryHit{Query: query, Rank: rank, CosineSimilarity: r.CosineSimilarity})
+		}
+	}
+	for _, res := range order {
+		sort.SliceStable(res.Hits, func(i, j int) bool {
+			return res.Hits[i].Rank < res.Hits[j].Rank
+		})
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return order[i].Score > order[j].Score
+	})
+	if limit > 0 && len(order) > limit {
+		order = order[:limit]
+	}
+	return order
+}
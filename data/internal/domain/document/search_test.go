@@ -0,0 +1,68 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func results(ids ...string) []*SearchResult {
+	res := make([]*SearchResult, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, &SearchResult{Chunk: Chunk{ID: id}})
+	}
+	return res
+}
+
+func ids(results []*SearchResult) []string {
+	res := make([]string, 0, len(results))
+	for _, r := range results {
+		res = append(res, r.ID)
+	}
+	return res
+}
+
+func TestFuseRRF(t *testing.T) {
+	params := HybridParams{VectorWeight: 1, LexicalWeight: 1, K: DefaultRRFK}
+
+	// b найден обоими поисками и поднимается выше лидеров каждого из списков
+	fused := FuseRRF(results("a", "b", "c"), results("d", "b", "b"), params, 3)
+	assert.Equal(t, []string{"b", "a", "d"}, ids(fused))
+	assert.InDelta(t, 2.0/62, fused[0].Score, 1e-6)
+	assert.InDelta(t, 1.0/61, fused[1].Score, 1e-6)
+}
+
+func TestFuseRRFZeroWeight(t *testing.T) {
+	params := HybridParams{VectorWeight: 0, LexicalWeight: 1, K: DefaultRRFK}
+
+	fused := FuseRRF(results("a", "b"), results("c", "a"), params, 0)
+	assert.Equal(t, []string{"c", "a"}, ids(fused))
+}
+
+func TestHybridParamsNormalize(t *testing.T) {
+	p := HybridParams{}
+	p.Normalize(10)
+	assert.Equal(t, HybridParams{VectorWeight: 1, LexicalWeight: 1, K: DefaultRRFK, Candidates: 40}, p)
+
+	p = HybridParams{LexicalWeight: 2, K: 10, Candidates: 100}
+	p.Normalize(3)
+	assert.Equal(t, HybridParams{LexicalWeight: 2, K: 10, Candidates: 100}, p)
+
+	p = HybridParams{VectorWeight: 1, Candidates: 2}
+	p.Normalize(3)
+	assert.Equal(t, minCandidates, p.Candidates)
+}
+
+func TestFuseQueries(t *testing.T) {
+	first := results("a", "b")
+	first[0].CosineSimilarity = 0.5
+	second := results("b", "c", "a")
+	second[2].CosineSimilarity = 0.7
+
+	fused := FuseQueries([][]*SearchResult{first, second}, DefaultRRFK, 2)
+	assert.Equal(t, []string{"b", "a"}, ids(fused))
+	assert.InDelta(t, 1.0/62+1.0/61, fused[0].Score, 1e-6)
+	assert.Equal(t, []QueryHit{{Query: 1, Rank: 1}, {Query: 0, Rank: 2}}, fused[0].Hits)
+	assert.Equal(t, float32(0.7), fused[1].CosineSimilarity)
+	assert.Equal(t, []QueryHit{{Query: 0, Rank: 1, CosineSimilarity: 0.5}, {Query: 1, Rank: 3, CosineSimilarity: 0.7}}, fused[1].Hits)
+}
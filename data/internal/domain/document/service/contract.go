@@ -6,11 +6,18 @@ import (
 
 	"github.com/larek-tech/diploma/data/internal/domain/document"
 	"github.com/larek-tech/diploma/data/internal/domain/question"
+	"github.com/larek-tech/diploma/data/internal/domain/source"
+	"github.com/larek-tech/diploma/data/internal/domain/structured"
 )
 
 type (
 	documentStorage interface {
 		Save(ctx context.Context, doc *document.Document) error
+		DeleteByObjectID(ctx context.Context, objectID string) error
+	}
+	sourceStorage interface {
+		GetByID(ctx context.Context, id string) (*source.Source, error)
+		LockGeneration(ctx context.Context, id string) (int, error)
 	}
 	chunkStorage interface {
 		Update(ctx context.Context, documentID string, chunks []*document.Chunk) error
@@ -18,6 +25,14 @@ type (
 	}
 	embedder interface {
 		CreateEmbedding(ctx context.Context, inputTexts []string) ([][]float32, error)
+		EmbeddingsModel() string
+	}
+	// vectorStorage сохраняет векторы чанков и вопросов в таблицы моделей эмбеддингов
+	vectorStorage interface {
+		SaveVectors(ctx context.Context, chunks []*document.Chunk, questions []*question.Questions) error
+	}
+	structuredStorage interface {
+		Save(ctx context.Context, table *structured.Table, rows [][]any) error
 	}
 	questionStorage interface {
 		Save(ctx context.Context, questions []*question.Questions) error
@@ -28,6 +43,14 @@ type (
 	parser interface {
 		Parse(io.ReadSeeker) (string, error)
 	}
+	// pageParser парсер постраничных форматов, номера страниц сохраняются в метаданных чанков
+	pageParser interface {
+		ParsePages(io.ReadSeeker) ([]string, error)
+	}
+	// sheetParser парсер табличных форматов, чанки собираются из групп строк листа
+	sheetParser interface {
+		ParseSheets(io.ReadSeeker) ([]document.Sheet, error)
+	}
 	ocr interface {
 		Process(string) (string, error)
 	}
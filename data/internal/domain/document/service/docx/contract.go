@@ -0,0 +1,7 @@
+package docx
+
+type (
+	ocr interface {
+		Process(filePath string) (string, error)
+	}
+)
@@ -0,0 +1,175 @@
+package docx
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document/service/office"
+)
+
+const documentPart = "word/document.xml"
+
+var headingStyle = regexp.MustCompile(`^(?i)(?:heading|заголовок)\s*(\d)$`)
+
+type Service struct {
+	ocr ocr
+}
+
+func New(ocr ocr) *Service {
+	return &Service{
+		ocr: ocr,
+	}
+}
+
+// Parse извлекает текст docx документа с заголовками, списками и таблицами в виде markdown,
+// текст встроенных изображений распознается через ocr
+func (s Service) Parse(reader io.ReadSeeker) (string, error) {
+	archive, err := office.Open(reader)
+	if err != nil {
+		return "", err
+	}
+	root, err := archive.ReadXML(documentPart)
+	if err != nil {
+		return "", fmt.Errorf("failed to read docx: %w", err)
+	}
+	body := root.Find("body")
+	if body == nil {
+		return "", nil
+	}
+
+	p := parser{
+		archive:  archive,
+		ocr:      s.ocr,
+		rels:     archive.Relationships(documentPart),
+		headings: headingStyles(archive),
+	}
+	p.blocks(body)
+	return p.out.String(), nil
+}
+
+type parser struct {
+	archive  *office.Archive
+	ocr      ocr
+	rels     map[string]string
+	headings map[string]int
+	out      office.Builder
+}
+
+func (p *parser) blocks(n *office.Node) {
+	for _, c := range n.Children {
+		switch c.Name {
+		case "p":
+			p.paragraph(c)
+		case "tbl":
+			p.out.Table(p.table(c))
+		case "sdt", "sdtContent", "customXml":
+			p.blocks(c)
+		}
+	}
+}
+
+func (p *parser) paragraph(n *office.Node) {
+	text, images := p.runs(n)
+	props := n.Child("pPr")
+	switch {
+	case props == nil:
+		p.out.Paragraph(text)
+	case p.headingLevel(props) > 0:
+		p.out.Heading(p.headingLevel(props), text)
+	case props.Child("numPr") != nil:
+		depth, _ := strconv.Atoi(props.Child("numPr").Child("ilvl").Attr("val"))
+		p.out.ListItem(depth, text)
+	default:
+		p.out.Paragraph(text)
+	}
+	for _, image := range images {
+		p.out.Paragraph(image)
+	}
+}
+
+func (p *parser) headingLevel(props *office.Node) int {
+	if level, ok := p.headings[props.Child("pStyle").Attr("val")]; ok {
+		return level
+	}
+	if outline := props.Child("outlineLvl"); outline != nil {
+		if level, err := strconv.Atoi(outline.Attr("val")); err == nil && level < 9 {
+			return level + 1
+		}
+	}
+	return 0
+}
+
+// runs собирает текст абзаца и распознанный текст изображений внутри него
+func (p *parser) runs(n *office.Node) (string, []string) {
+	var sb strings.Builder
+	var images []string
+	var visit func(*office.Node)
+	visit = func(n *office.Node) {
+		switch n.Name {
+		case "t":
+			sb.WriteString(n.InnerText())
+			return
+		case "tab", "br", "cr":
+			sb.WriteString(" ")
+			return
+		case "blip":
+			if text := p.archive.RecognizeImage(p.ocr, p.rels[n.Attr("embed")]); text != "" {
+				images = append(images, text)
+			}
+			return
+		case "pPr", "rPr", "instrText", "delText":
+			return
+		}
+		for _, c := range n.Children {
+			visit(c)
+		}
+	}
+	visit(n)
+	return sb.String(), images
+}
+
+func (p *parser) table(n *office.Node) [][]string {
+	var rows [][]string
+	for _, tr := range n.FindAll("tr") {
+		var cells []string
+		for _, tc := range tr.FindAll("tc") {
+			var parts []string
+			for _, para := range tc.FindAll("p") {
+				text, images := p.runs(para)
+				parts = append(parts, text)
+				parts = append(parts, images...)
+			}
+			cells = append(cells, strings.Join(parts, " "))
+		}
+		rows = append(rows, cells)
+	}
+	return rows
+}
+
+// headingStyles сопоставляет идентификаторы стилей заголовков с их уровнем
+func headingStyles(archive *office.Archive) map[string]int {
+	res := map[string]int{"Title": 1}
+	for i := 1; i <= 9; i++ {
+		res["Heading"+strconv.Itoa(i)] = i
+	}
+	styles, err := archive.ReadXML("word/styles.xml")
+	if err != nil {
+		return res
+	}
+	for _, style := range styles.FindAll("style") {
+		id := style.Attr("styleId")
+		name := style.Child("name").Attr("val")
+		if strings.EqualFold(name, "title") {
+			res[id] = 1
+			continue
+		}
+		if m := headingStyle.FindStringSubmatch(name); m != nil {
+			level, _ := strconv.Atoi(m[1])
+			res[id] = level
+		}
+	}
+	return res
+}
@@ -0,0 +1,75 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ocrMock struct{}
+
+func (ocrMock) Process(string) (string, error) {
+	return "текст на картинке", nil
+}
+
+func buildArchive(t *testing.T, files map[string]string) *bytes.Reader {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		assert.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+const document = `<?xml version="1.0" encoding="UTF-8"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+ xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main"
+ xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<w:body>
+<w:p><w:pPr><w:pStyle w:val="1"/></w:pPr><w:r><w:t>Введение</w:t></w:r></w:p>
+<w:p><w:r><w:t xml:space="preserve">Первый </w:t></w:r><w:r><w:t>абзац</w:t></w:r></w:p>
+<w:p><w:pPr><w:numPr><w:ilvl w:val="0"/><w:numId w:val="1"/></w:numPr></w:pPr><w:r><w:t>пункт</w:t></w:r></w:p>
+<w:p><w:pPr><w:numPr><w:ilvl w:val="1"/><w:numId w:val="1"/></w:numPr></w:pPr><w:r><w:t>подпункт</w:t></w:r></w:p>
+<w:tbl>
+<w:tr><w:tc><w:p><w:r><w:t>a</w:t></w:r></w:p></w:tc><w:tc><w:p><w:r><w:t>b</w:t></w:r></w:p></w:tc></w:tr>
+<w:tr><w:tc><w:p><w:r><w:t>1</w:t></w:r></w:p></w:tc><w:tc><w:p><w:r><w:t>2</w:t></w:r></w:p></w:tc></w:tr>
+</w:tbl>
+<w:p><w:r><w:drawing><a:graphic><a:graphicData><a:blip r:embed="rId5"/></a:graphicData></a:graphic></w:drawing></w:r></w:p>
+</w:body>
+</w:document>`
+
+const styles = `<w:styles xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">
+<w:style w:type="paragraph" w:styleId="1"><w:name w:val="heading 1"/></w:style>
+</w:styles>`
+
+const rels = `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId5" Type="image" Target="media/image1.png"/>
+</Relationships>`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	reader := buildArchive(t, map[string]string{
+		"word/document.xml":            document,
+		"word/styles.xml":              styles,
+		"word/_rels/document.xml.rels": rels,
+		"word/media/image1.png":        "png",
+	})
+	text, err := New(ocrMock{}).Parse(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, "# Введение\n\nПервый абзац\n\n- пункт\n\n  - подпункт\n\n| a | b |\n| 1 | 2 |\n\nтекст на картинке", text)
+}
+
+func TestParseNotArchive(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(ocrMock{}).Parse(bytes.NewReader([]byte("plain text")))
+	assert.Error(t, err)
+}
@@ -7,20 +7,41 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/larek-tech/diploma/data/internal/domain/document"
+	"github.com/larek-tech/diploma/data/internal/domain/document/service/splitter"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
 const (
-	// ChunkSize is the size of each chunk in characters.
-	ChunkSize = 4096
-	// ChunkOverlap is the number of overlapping characters between chunks.
-	ChunkOverlap  = 100
 	EmbeddingSize = 1024
 )
 
+// chunkMetadata метаданные чанка: данные документа и путь заголовков раздела
+type chunkMetadata struct {
+	DocumentName string         `json:"document_name,omitempty"`
+	ObjectID     string         `json:"object_id,omitempty"`
+	ObjectType   document.Type  `json:"object_type,omitempty"`
+	Metadata     map[string]any `json:"metadata,omitempty"`
+	Headings     []string       `json:"headings,omitempty"`
+	Page         int            `json:"page,omitempty"`
+	Sheet        string         `json:"sheet,omitempty"`
+	RowStart     int            `json:"row_start,omitempty"`
+	RowEnd       int            `json:"row_end,omitempty"`
+	Columns      []string       `json:"columns,omitempty"`
+}
+
+// pageChunk фрагмент документа с его положением: номером страницы или листом и диапазоном строк
+type pageChunk struct {
+	splitter.Chunk
+	page     int
+	sheet    string
+	rowStart int
+	rowEnd   int
+	columns  []string
+}
+
 // embed embeds the document content into chunks and returns them.
-func (s Service) embed(ctx context.Context, doc *document.Document) ([]*document.Chunk, error) {
+func (s Service) embed(ctx context.Context, doc *document.Document, textSplitter splitter.Splitter) ([]*document.Chunk, error) {
 	ctx, span := s.tracer.Start(ctx, "embeddingService.embed", trace.WithAttributes(
 		attribute.String("documentID", doc.ID),
 		attribute.String("sourceID", doc.SourceID),
@@ -32,36 +53,83 @@ func (s Service) embed(ctx context.Context, doc *document.Document) ([]*document
 	if err != nil {
 		return nil, fmt.Errorf("failed to validate document: %w", err)
 	}
-	content := document.CleanUTF8(doc.Content)
-	rawChunks := characterTextSplitter(content, ChunkSize, ChunkOverlap)
+	rawChunks := split(doc, textSplitter)
 	if len(rawChunks) == 0 {
 		return nil, nil
 	}
-	embeddings, err := s.embedder.CreateEmbedding(ctx, rawChunks)
+	texts := make([]string, 0, len(rawChunks))
+	for _, rawChunk := range rawChunks {
+		texts = append(texts, rawChunk.Content)
+	}
+	embeddings, err := s.embedder.CreateEmbedding(ctx, texts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create embeddings: %w", err)
 	}
 	// TODO: add generation of questions using llm model
-	metadata, err := json.Marshal(doc)
-	if err != nil {
-		metadata = []byte{}
-	}
 	chunks := make([]*document.Chunk, 0, len(rawChunks))
 	for i, rawChunk := range rawChunks {
+		metadata, err := json.Marshal(chunkMetadata{
+			DocumentName: doc.Name,
+			ObjectID:     doc.ObjectID,
+			ObjectType:   doc.ObjectType,
+			Metadata:     doc.Metadata,
+			Headings:     rawChunk.Headings,
+			Page:         rawChunk.page,
+			Sheet:        rawChunk.sheet,
+			RowStart:     rawChunk.rowStart,
+			RowEnd:       rawChunk.rowEnd,
+			Columns:      rawChunk.columns,
+		})
+		if err != nil {
+			metadata = nil
+		}
 		chunk := &document.Chunk{
-			ID:         uuid.NewString(),
-			DocumentID: doc.ID,
-			SourceID:   doc.SourceID,
-			Content:    rawChunk,
-			Index:      i,
-			Embeddings: embeddings[i],
-			Metadata:   metadata,
+			ID:             uuid.NewString(),
+			DocumentID:     doc.ID,
+			SourceID:       doc.SourceID,
+			Content:        rawChunk.Content,
+			Index:          i,
+			Embeddings:     embeddings[i],
+			Metadata:       metadata,
+			EmbeddingModel: s.embedder.EmbeddingsModel(),
 		}
 		chunks = append(chunks, chunk)
 	}
 	return chunks, nil
 }
 
+// split разбивает документ на фрагменты, постраничные документы разбиваются по страницам,
+// чтобы каждый фрагмент относился к одной странице, табличные - по группам строк листа
+func split(doc *document.Document, textSplitter splitter.Splitter) []pageChunk {
+	var res []pageChunk
+	if len(doc.Sheets) > 0 {
+		for _, sheet := range doc.Sheets {
+			for _, chunk := range textSplitter.SplitTable(sheet) {
+				res = append(res, pageChunk{
+					Chunk:    splitter.Chunk{Content: document.CleanUTF8(chunk.Content)},
+					sheet:    sheet.Name,
+					rowStart: chunk.FirstRow,
+					rowEnd:   chunk.LastRow,
+					columns:  sheet.Columns,
+				})
+			}
+		}
+		return res
+	}
+	if len(doc.Pages) == 0 {
+		for _, chunk := range textSplitter.Split(document.CleanUTF8(doc.Content)) {
+			res = append(res, pageChunk{Chunk: chunk})
+		}
+		return res
+	}
+	for i, page := range doc.Pages {
+		for _, chunk := range textSplitter.Split(document.CleanUTF8(page)) {
+			res = append(res, pageChunk{Chunk: chunk, page: i + 1})
+		}
+	}
+	return res
+}
+
 func validateDocument(doc *document.Document) error {
 	if doc == nil {
 		return fmt.Errorf("document is nil")
@@ -74,29 +142,3 @@ func validateDocument(doc *document.Document) error {
 	}
 	return nil
 }
-
-func characterTextSplitter(text string, chunkSize, overlap int) []string {
-	if chunkSize <= 0 || len(text) == 0 {
-		return []string{}
-	}
-
-	var chunks []string
-	start := 0
-
-	for start < len(text) {
-		end := start + chunkSize
-		if end > len(text) {
-			end = len(text)
-		}
-
-		chunk := text[start:end]
-		chunks = append(chunks, chunk)
-
-		start += chunkSize - overlap
-		if start < 0 {
-			start = 0
-		}
-	}
-
-	return chunks
-}
@@ -0,0 +1,124 @@
+package html
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// blockElements элементы, начинающие новый блок текста
+var blockElements = map[atom.Atom]bool{
+	atom.P: true, atom.Div: true, atom.Section: true, atom.Article: true, atom.Main: true,
+	atom.Header: true, atom.Footer: true, atom.Aside: true, atom.Blockquote: true, atom.Pre: true,
+	atom.Ul: true, atom.Ol: true, atom.Dl: true, atom.Dt: true, atom.Dd: true,
+	atom.Figure: true, atom.Figcaption: true, atom.Form: true, atom.Hr: true, atom.Br: true,
+	atom.Details: true, atom.Summary: true, atom.Address: true, atom.Fieldset: true,
+}
+
+var headingLevels = map[atom.Atom]int{
+	atom.H1: 1, atom.H2: 2, atom.H3: 3, atom.H4: 4, atom.H5: 5, atom.H6: 6,
+}
+
+// renderer собирает текст документа в блоки markdown
+type renderer struct {
+	blocks []string
+	inline strings.Builder
+	prefix string
+}
+
+func (r *renderer) flush() {
+	text := strings.Join(strings.Fields(r.inline.String()), " ")
+	r.inline.Reset()
+	if text != "" {
+		r.blocks = append(r.blocks, r.prefix+text)
+	}
+	r.prefix = ""
+}
+
+func (r *renderer) walk(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		r.inline.WriteString(n.Data)
+		return
+	case html.ElementNode:
+	case html.DocumentNode:
+		r.walkChildren(n)
+		return
+	default:
+		return
+	}
+
+	switch {
+	case n.DataAtom == atom.Style || n.DataAtom == atom.Noscript || n.DataAtom == atom.Template:
+		return
+	case headingLevels[n.DataAtom] > 0:
+		r.flush()
+		r.prefix = strings.Repeat("#", headingLevels[n.DataAtom]) + " "
+		r.walkChildren(n)
+		r.flush()
+	case n.DataAtom == atom.Li:
+		r.flush()
+		r.prefix = "- "
+		r.walkChildren(n)
+		r.flush()
+	case n.DataAtom == atom.Table:
+		r.flush()
+		r.table(n)
+	case blockElements[n.DataAtom]:
+		r.flush()
+		r.walkChildren(n)
+		r.flush()
+	default:
+		r.walkChildren(n)
+	}
+}
+
+func (r *renderer) walkChildren(n *html.Node) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		r.walk(c)
+	}
+}
+
+// table записывает таблицу одним блоком, каждая строка таблицы - отдельная строка текста
+func (r *renderer) table(n *html.Node) {
+	var rows []string
+	var visit func(*html.Node)
+	visit = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.DataAtom == atom.Tr {
+			var cells []string
+			for c := n.FirstChild; c != nil; c = c.NextSibling {
+				if c.Type == html.ElementNode && (c.DataAtom == atom.Td || c.DataAtom == atom.Th) {
+					cells = append(cells, strings.Join(strings.Fields(nodeText(c)), " "))
+				}
+			}
+			if len(cells) > 0 {
+				rows = append(rows, "| "+strings.Join(cells, " | ")+" |")
+			}
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			visit(c)
+		}
+	}
+	visit(n)
+	if len(rows) > 0 {
+		r.blocks = append(r.blocks, strings.Join(rows, "\n"))
+	}
+}
+
+func nodeText(n *html.Node) string {
+	var sb strings.Builder
+	var visit func(*html.Node)
+	visit = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			sb.WriteString(n.Data)
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			visit(c)
+		}
+	}
+	visit(n)
+	return sb.String()
+}
@@ -26,7 +26,8 @@ func New() *Service {
 //   - <a> (гиперссылки)
 //
 // После удаления ненужных элементов функция возвращает очищенный текст из <body>,
-// либо, если <body> отсутствует, — весь текст документа.
+// либо, если <body> отсутствует, — весь текст документа. Структура сохраняется в виде markdown:
+// заголовки начинаются с "#", блоки разделены пустой строкой, строки таблиц записываются через "|".
 //
 // Возвращает строку с основным текстовым содержимым и ошибку, если что-то пошло не так.
 //
@@ -60,18 +61,32 @@ func (s Service) Parse(content io.ReadSeeker) (string, error) {
 		s.Remove()
 	})
 
-	var sb strings.Builder
-
 	doc.Find("a").Each(func(i int, s *goquery.Selection) {
 		s.Remove()
 	})
 
-	doc.Find("body").Each(func(i int, s *goquery.Selection) {
-		sb.WriteString(strings.Join(strings.Fields(s.Text()), " "))
-	})
-	text := sb.String()
-	if text == "" {
-		text = strings.Join(strings.Fields(doc.Text()), " ")
+	return render(doc), nil
+}
+
+// Text извлекает структурированный текст из HTML без удаления навигации и ссылок,
+// используется для HTML, полученного из других форматов.
+func Text(content io.Reader) (string, error) {
+	doc, err := goquery.NewDocumentFromReader(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse HTML: %w", err)
+	}
+	return render(doc), nil
+}
+
+func render(doc *goquery.Document) string {
+	root := doc.Find("body")
+	if root.Length() == 0 {
+		root = doc.Selection
+	}
+	r := &renderer{}
+	for _, n := range root.Nodes {
+		r.walk(n)
 	}
-	return document.CleanUTF8(text), nil
+	r.flush()
+	return document.CleanUTF8(strings.Join(r.blocks, "\n\n"))
 }
@@ -38,3 +38,23 @@ func TestParsing(t *testing.T) {
 	assert.NoError(t, err)
 	saveResult(processed)
 }
+
+func TestParsingStructure(t *testing.T) {
+	content := `<html><body>
+<h1>Тарифы</h1>
+<p>Стоимость <b>подписки</b>
+зависит от периода.</p>
+<table>
+<tr><th>Период</th><th>Цена</th></tr>
+<tr><td>Месяц</td><td>100</td></tr>
+</table>
+<ul><li>первый</li><li>второй</li></ul>
+</body></html>`
+	s := New()
+	processed, err := s.Parse(strings.NewReader(content))
+	assert.NoError(t, err)
+	assert.Equal(t, "# Тарифы\n\n"+
+		"Стоимость подписки зависит от периода.\n\n"+
+		"| Период | Цена |\n| Месяц | 100 |\n\n"+
+		"- первый\n\n- второй", processed)
+}
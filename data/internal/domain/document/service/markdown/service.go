@@ -1,9 +1,11 @@
 package markdown
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 
+	"github.com/larek-tech/diploma/data/internal/domain/document/service/html"
 	"github.com/russross/blackfriday/v2"
 )
 
@@ -13,6 +15,8 @@ func New() *Service {
 	return &Service{}
 }
 
+// Parse приводит markdown к тексту с заголовками, абзацами и таблицами через html,
+// чтобы убрать разметку ссылок и выделения.
 func (s Service) Parse(content io.ReadSeeker) (string, error) {
 	// Convert Markdown to HTML
 	rawBytes, err := io.ReadAll(content)
@@ -20,6 +24,6 @@ func (s Service) Parse(content io.ReadSeeker) (string, error) {
 		return "", fmt.Errorf("error reading markdown: %w", err)
 	}
 
-	html := blackfriday.Run(rawBytes)
-	return string(html), nil
+	rendered := blackfriday.Run(rawBytes, blackfriday.WithExtensions(blackfriday.CommonExtensions))
+	return html.Text(bytes.NewReader(rendered))
 }
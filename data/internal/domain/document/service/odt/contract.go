@@ -0,0 +1,7 @@
+package odt
+
+type (
+	ocr interface {
+		Process(filePath string) (string, error)
+	}
+)
@@ -0,0 +1,145 @@
+package odt
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document/service/office"
+)
+
+const contentPart = "content.xml"
+
+type Service struct {
+	ocr ocr
+}
+
+func New(ocr ocr) *Service {
+	return &Service{
+		ocr: ocr,
+	}
+}
+
+// Parse извлекает текст odt документа с заголовками, списками и таблицами в виде markdown,
+// текст встроенных изображений распознается через ocr
+func (s Service) Parse(reader io.ReadSeeker) (string, error) {
+	archive, err := office.Open(reader)
+	if err != nil {
+		return "", err
+	}
+	root, err := archive.ReadXML(contentPart)
+	if err != nil {
+		return "", fmt.Errorf("failed to read odt: %w", err)
+	}
+	body := root.Find("body")
+	if body != nil {
+		body = body.Child("text")
+	}
+	if body == nil {
+		return "", nil
+	}
+
+	p := parser{
+		archive: archive,
+		ocr:     s.ocr,
+	}
+	p.blocks(body, -1)
+	return p.out.String(), nil
+}
+
+type parser struct {
+	archive *office.Archive
+	ocr     ocr
+	out     office.Builder
+}
+
+// blocks обходит блочные элементы, depth - глубина текущего списка или -1 вне списка
+func (p *parser) blocks(n *office.Node, depth int) {
+	for _, c := range n.Children {
+		switch c.Name {
+		case "h":
+			text, images := p.inline(c)
+			level, err := strconv.Atoi(c.Attr("outline-level"))
+			if err != nil {
+				level = 1
+			}
+			p.out.Heading(level, text)
+			p.images(images)
+		case "p":
+			text, images := p.inline(c)
+			if depth >= 0 {
+				p.out.ListItem(depth, text)
+			} else {
+				p.out.Paragraph(text)
+			}
+			p.images(images)
+		case "list":
+			p.blocks(c, depth+1)
+		case "list-item", "list-header", "section", "index-body", "table-of-content", "alphabetical-index":
+			p.blocks(c, depth)
+		case "table":
+			p.out.Table(p.table(c))
+		}
+	}
+}
+
+func (p *parser) images(images []string) {
+	for _, image := range images {
+		p.out.Paragraph(image)
+	}
+}
+
+// inline собирает текст элемента и распознанный текст изображений внутри него
+func (p *parser) inline(n *office.Node) (string, []string) {
+	var sb strings.Builder
+	var images []string
+	var visit func(*office.Node)
+	visit = func(n *office.Node) {
+		switch {
+		case n.IsText():
+			sb.WriteString(n.Text)
+			return
+		case n.Name == "s":
+			count, err := strconv.Atoi(n.Attr("c"))
+			if err != nil {
+				count = 1
+			}
+			sb.WriteString(strings.Repeat(" ", count))
+			return
+		case n.Name == "tab" || n.Name == "line-break" || n.Name == "soft-page-break":
+			sb.WriteString(" ")
+			return
+		case n.Name == "note" || n.Name == "annotation" || n.Name == "tracked-changes":
+			return
+		case n.Name == "image":
+			if text := p.archive.RecognizeImage(p.ocr, n.Attr("href")); text != "" {
+				images = append(images, text)
+			}
+			return
+		case n.Name == "p" || n.Name == "h":
+			// вложенные абзацы, например в ячейках таблиц
+			sb.WriteString(" ")
+		}
+		for _, c := range n.Children {
+			visit(c)
+		}
+	}
+	for _, c := range n.Children {
+		visit(c)
+	}
+	return sb.String(), images
+}
+
+func (p *parser) table(n *office.Node) [][]string {
+	var rows [][]string
+	for _, tr := range n.FindAll("table-row") {
+		var cells []string
+		for _, tc := range tr.FindAll("table-cell") {
+			text, images := p.inline(tc)
+			cells = append(cells, strings.Join(append([]string{text}, images...), " "))
+		}
+		rows = append(rows, cells)
+	}
+	return rows
+}
@@ -0,0 +1,35 @@
+package odt
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const content = `<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0"
+ xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0"
+ xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0">
+<office:body><office:text>
+<text:h text:outline-level="2">Раздел</text:h>
+<text:p>Слово<text:s text:c="2"/>и <text:span>ещё</text:span> слово</text:p>
+<text:list><text:list-item><text:p>пункт</text:p><text:list><text:list-item><text:p>подпункт</text:p></text:list-item></text:list></text:list-item></text:list>
+<table:table><table:table-row><table:table-cell><text:p>a</text:p></table:table-cell><table:table-cell><text:p>b</text:p><text:p>c</text:p></table:table-cell></table:table-row></table:table>
+</office:text></office:body></office:document-content>`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	f, err := w.Create(contentPart)
+	assert.NoError(t, err)
+	_, err = f.Write([]byte(content))
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+
+	text, err := New(nil).Parse(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, "## Раздел\n\nСлово и ещё слово\n\n- пункт\n\n  - подпункт\n\n| a | b c |", text)
+}
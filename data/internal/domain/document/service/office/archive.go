@@ -0,0 +1,165 @@
+package office
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+// maxPartSize ограничение на размер распакованной части документа
+const maxPartSize = 64 << 20
+
+var (
+	ErrPartNotFound = errors.New("document part not found")
+	ErrPartTooLarge = errors.New("document part is too large")
+)
+
+// imageExtensions форматы изображений, которые умеет читать ocr
+var imageExtensions = map[string]bool{
+	".png": true, ".jpg": true, ".jpeg": true, ".gif": true, ".bmp": true, ".tif": true, ".tiff": true,
+}
+
+type ocr interface {
+	Process(filePath string) (string, error)
+}
+
+// Archive zip контейнер офисного документа (docx, pptx, odt)
+type Archive struct {
+	zip *zip.Reader
+}
+
+// Open открывает zip контейнер документа
+func Open(reader io.ReadSeeker) (*Archive, error) {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read content: %w", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open document archive: %w", err)
+	}
+	return &Archive{zip: zr}, nil
+}
+
+// ReadFile возвращает содержимое части документа по имени
+func (a *Archive) ReadFile(name string) ([]byte, error) {
+	for _, f := range a.zip.File {
+		if f.Name == name {
+			return readFile(f)
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrPartNotFound, name)
+}
+
+// ReadXML разбирает xml часть документа
+func (a *Archive) ReadXML(name string) (*Node, error) {
+	data, err := a.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	node, err := ParseXML(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	return node, nil
+}
+
+// Files возвращает отсортированные имена частей документа с указанным префиксом
+func (a *Archive) Files(prefix string) []string {
+	var names []string
+	for _, f := range a.zip.File {
+		if strings.HasPrefix(f.Name, prefix) && !f.FileInfo().IsDir() {
+			names = append(names, f.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Relationships возвращает связи части документа: идентификатор связи -> полный путь цели
+func (a *Archive) Relationships(part string) map[string]string {
+	dir, file := path.Split(part)
+	rels, err := a.ReadXML(dir + "_rels/" + file + ".rels")
+	if err != nil {
+		return map[string]string{}
+	}
+	res := make(map[string]string)
+	for _, rel := range rels.FindAll("Relationship") {
+		if rel.Attr("TargetMode") == "External" {
+			continue
+		}
+		target := rel.Attr("Target")
+		if strings.HasPrefix(target, "/") {
+			target = strings.TrimPrefix(target, "/")
+		} else {
+			target = path.Join(dir, target)
+		}
+		res[rel.Attr("Id")] = target
+	}
+	return res
+}
+
+// RecognizeImage распознает текст встроенного изображения.
+// Ошибки распознавания не прерывают разбор документа, изображение просто пропускается.
+func (a *Archive) RecognizeImage(o ocr, name string) string {
+	if o == nil || !imageExtensions[strings.ToLower(path.Ext(name))] {
+		return ""
+	}
+	data, err := a.ReadFile(name)
+	if err != nil {
+		slog.Warn("failed to read embedded image", "name", name, "error", err)
+		return ""
+	}
+	text, err := RecognizeImage(o, data)
+	if err != nil {
+		slog.Warn("failed to recognize embedded image", "name", name, "error", err)
+		return ""
+	}
+	return text
+}
+
+// RecognizeImage сохраняет изображение во временный файл и распознает его текст
+func RecognizeImage(o ocr, data []byte) (string, error) {
+	f, err := os.CreateTemp("", "ocr-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if _, err := f.Write(data); err != nil {
+		return "", fmt.Errorf("failed to write temp file: %w", err)
+	}
+	text, err := o.Process(f.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to get text: %w", err)
+	}
+	return strings.TrimSpace(text), nil
+}
+
+func readFile(f *zip.File) ([]byte, error) {
+	if f.UncompressedSize64 > maxPartSize {
+		return nil, fmt.Errorf("%w: %s", ErrPartTooLarge, f.Name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, maxPartSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", f.Name, err)
+	}
+	if len(data) > maxPartSize {
+		return nil, fmt.Errorf("%w: %s", ErrPartTooLarge, f.Name)
+	}
+	return data, nil
+}
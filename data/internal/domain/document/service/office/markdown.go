@@ -0,0 +1,66 @@
+package office
+
+import (
+	"strings"
+)
+
+// Builder собирает текст документа в блоки markdown в том же виде, что и html парсер:
+// заголовки через #, элементы списков через -, таблицы построчно через |
+type Builder struct {
+	blocks []string
+}
+
+// Heading добавляет заголовок уровня level
+func (b *Builder) Heading(level int, text string) {
+	text = normalize(text)
+	if text == "" {
+		return
+	}
+	level = max(1, min(level, 6))
+	b.blocks = append(b.blocks, strings.Repeat("#", level)+" "+text)
+}
+
+// Paragraph добавляет абзац
+func (b *Builder) Paragraph(text string) {
+	if text = normalize(text); text != "" {
+		b.blocks = append(b.blocks, text)
+	}
+}
+
+// ListItem добавляет элемент списка с вложенностью depth, начиная с нуля
+func (b *Builder) ListItem(depth int, text string) {
+	if text = normalize(text); text != "" {
+		b.blocks = append(b.blocks, strings.Repeat("  ", max(depth, 0))+"- "+text)
+	}
+}
+
+// Table добавляет таблицу одним блоком
+func (b *Builder) Table(rows [][]string) {
+	lines := make([]string, 0, len(rows))
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		empty := true
+		for i, cell := range row {
+			cells[i] = strings.ReplaceAll(normalize(cell), "|", "/")
+			if cells[i] != "" {
+				empty = false
+			}
+		}
+		if empty {
+			continue
+		}
+		lines = append(lines, "| "+strings.Join(cells, " | ")+" |")
+	}
+	if len(lines) > 0 {
+		b.blocks = append(b.blocks, strings.Join(lines, "\n"))
+	}
+}
+
+// String возвращает собранный текст, блоки разделены пустой строкой
+func (b *Builder) String() string {
+	return strings.Join(b.blocks, "\n\n")
+}
+
+func normalize(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
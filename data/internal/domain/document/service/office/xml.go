@@ -0,0 +1,121 @@
+package office
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Node упрощенный элемент xml документа, имена хранятся без пространства имен.
+// Текст хранится в дочерних узлах с пустым именем, чтобы сохранить порядок смешанного содержимого.
+type Node struct {
+	Name     string
+	Attrs    map[string]string
+	Children []*Node
+	Text     string
+}
+
+// IsText сообщает, что узел текстовый
+func (n *Node) IsText() bool {
+	return n.Name == ""
+}
+
+// ParseXML разбирает xml в дерево узлов
+func ParseXML(data []byte) (*Node, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = false
+
+	root := &Node{Name: "#document"}
+	stack := []*Node{root}
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode xml: %w", err)
+		}
+		parent := stack[len(stack)-1]
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &Node{Name: t.Name.Local, Attrs: make(map[string]string, len(t.Attr))}
+			for _, attr := range t.Attr {
+				node.Attrs[attr.Name.Local] = attr.Value
+			}
+			parent.Children = append(parent.Children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		case xml.CharData:
+			parent.Children = append(parent.Children, &Node{Text: string(t)})
+		}
+	}
+	return root, nil
+}
+
+// Child возвращает первый дочерний элемент с указанным именем
+func (n *Node) Child(name string) *Node {
+	for _, c := range n.Children {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// Find возвращает первый элемент с указанным именем в поддереве, включая сам узел
+func (n *Node) Find(name string) *Node {
+	if n.Name == name {
+		return n
+	}
+	for _, c := range n.Children {
+		if found := c.Find(name); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// FindAll возвращает все элементы с указанным именем в поддереве без вложенных совпадений
+func (n *Node) FindAll(name string) []*Node {
+	var res []*Node
+	var visit func(*Node)
+	visit = func(n *Node) {
+		if n.Name == name {
+			res = append(res, n)
+			return
+		}
+		for _, c := range n.Children {
+			visit(c)
+		}
+	}
+	visit(n)
+	return res
+}
+
+// Attr возвращает значение атрибута по локальному имени
+func (n *Node) Attr(name string) string {
+	if n == nil {
+		return ""
+	}
+	return n.Attrs[name]
+}
+
+// InnerText собирает весь текст поддерева
+func (n *Node) InnerText() string {
+	var sb strings.Builder
+	var visit func(*Node)
+	visit = func(n *Node) {
+		sb.WriteString(n.Text)
+		for _, c := range n.Children {
+			visit(c)
+		}
+	}
+	visit(n)
+	return sb.String()
+}
@@ -5,12 +5,16 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/larek-tech/diploma/data/internal/domain/document"
 )
 
+// pageSeparator разделитель страниц в тексте документа
+const pageSeparator = "\n\n"
+
 var ErrFileTypeNotSupported = errors.New("file extension not supported")
 
 // parse parses the document based on its file extension and returns a Document object.
@@ -19,15 +23,38 @@ func (s Service) parse(_ context.Context, obj io.ReadSeeker, fileExt document.Fi
 	if !found {
 		return nil, fmt.Errorf("failed to parse file unsupported filetype %v: %w", fileExt, ErrFileTypeNotSupported)
 	}
+	doc := &document.Document{
+		ID:        uuid.NewString(),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if sp, ok := parser.(sheetParser); ok {
+		sheets, err := sp.ParseSheets(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse file %w", err)
+		}
+		rendered := make([]string, 0, len(sheets))
+		for _, sheet := range sheets {
+			rendered = append(rendered, sheet.Render())
+		}
+		doc.Sheets = sheets
+		doc.Content = strings.Join(rendered, pageSeparator)
+		return doc, nil
+	}
+	if pp, ok := parser.(pageParser); ok {
+		pages, err := pp.ParsePages(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse file %w", err)
+		}
+		doc.Pages = pages
+		doc.Content = strings.Join(pages, pageSeparator)
+		return doc, nil
+	}
 	content, err := parser.Parse(obj)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse file %w", err)
 	}
 	// TODO: add source metadata (link)
-	return &document.Document{
-		ID:        uuid.NewString(),
-		Content:   content,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-	}, nil
+	doc.Content = content
+	return doc, nil
 }
@@ -4,15 +4,25 @@ import (
 	"fmt"
 	"image/jpeg"
 	"io"
-	"log"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"strings"
+	"unicode"
 
 	"github.com/gen2brain/go-fitz"
+	"golang.org/x/sync/errgroup"
 )
 
-const pageSeparator = "\n\n\n\n\n"
+const (
+	pageSeparator = "\n\n\n\n\n"
+	// pageConcurrency количество страниц, обрабатываемых одновременно
+	pageConcurrency = 4
+	// minPageLetters минимальное количество букв и цифр, при котором текстовый слой страницы считается заполненным
+	minPageLetters = 16
+	// maxGarbageShare доля нераспознаваемых символов, начиная с которой текстовый слой считается мусором
+	maxGarbageShare = 0.1
+)
 
 type Service struct {
 	ocr ocr
@@ -25,57 +35,106 @@ func New(ocr ocr) *Service {
 }
 
 func (s Service) Parse(reader io.ReadSeeker) (string, error) {
-	pdf, err := os.CreateTemp("", "ocr-")
+	pages, err := s.ParsePages(reader)
 	if err != nil {
-		return "", fmt.Errorf("failed to create temp file: %w", err)
+		return "", err
 	}
-	defer os.Remove(pdf.Name())
+	return strings.Join(pages, pageSeparator), nil
+}
+
+// ParsePages возвращает текст каждой страницы документа. Текст берется из текстового слоя pdf,
+// через ocr распознаются только страницы без текстового слоя или с нечитаемым текстом.
+func (s Service) ParsePages(reader io.ReadSeeker) ([]string, error) {
 	content, err := io.ReadAll(reader)
 	if err != nil {
-		return "", fmt.Errorf("failed to read content: %w", err)
-	}
-	if _, err := pdf.Write(content); err != nil {
-		log.Fatal(err)
+		return nil, fmt.Errorf("failed to read content: %w", err)
 	}
-
-	doc, err := fitz.New(pdf.Name())
+	doc, err := fitz.NewFromMemory(content)
 	if err != nil {
-		return "", fmt.Errorf("failed to split pdf: %w", err)
+		return nil, fmt.Errorf("failed to open pdf: %w", err)
 	}
+	defer doc.Close()
 
 	tmpDir, err := os.MkdirTemp(os.TempDir(), "fitz")
 	if err != nil {
-		return "", fmt.Errorf("failed to create temp directory: %w", err)
+		return nil, fmt.Errorf("failed to create temp directory: %w", err)
 	}
-	text := make([]string, doc.NumPage())
-
-	for n := 0; n < doc.NumPage(); n++ {
-		img, err := doc.Image(n)
-		if err != nil {
-			return "", fmt.Errorf("failed to get pdf page: %w", err)
-
-		}
+	defer os.RemoveAll(tmpDir)
+
+	pages := make([]string, doc.NumPage())
+	eg := errgroup.Group{}
+	eg.SetLimit(pageConcurrency)
+	for n := range pages {
+		eg.Go(func() error {
+			text, err := s.page(doc, n, tmpDir)
+			if err != nil {
+				return fmt.Errorf("failed to parse page %d: %w", n+1, err)
+			}
+			pages[n] = text
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
 
-		f, err := os.Create(filepath.Join(tmpDir, fmt.Sprintf("test%03d.jpg", n)))
-		if err != nil {
-			return "", fmt.Errorf("failed to save temp file for pdf page: %w", err)
+func (s Service) page(doc *fitz.Document, n int, tmpDir string) (string, error) {
+	text, err := doc.Text(n)
+	if err != nil {
+		slog.Warn("failed to get pdf page text layer", "page", n+1, "error", err)
+	}
+	if err == nil && hasTextLayer(text) {
+		return strings.TrimSpace(text), nil
+	}
+	if s.ocr == nil {
+		return strings.TrimSpace(text), nil
+	}
+	return s.recognize(doc, n, tmpDir)
+}
 
-		}
-		defer f.Close()
+// recognize растеризует страницу и распознает ее текст через ocr
+func (s Service) recognize(doc *fitz.Document, n int, tmpDir string) (string, error) {
+	img, err := doc.Image(n)
+	if err != nil {
+		return "", fmt.Errorf("failed to get pdf page: %w", err)
+	}
 
-		err = jpeg.Encode(f, img, &jpeg.Options{Quality: jpeg.DefaultQuality})
-		if err != nil {
-			return "", fmt.Errorf("failed to save pdf page jpeg: %w", err)
+	f, err := os.Create(filepath.Join(tmpDir, fmt.Sprintf("page%05d.jpg", n)))
+	if err != nil {
+		return "", fmt.Errorf("failed to save temp file for pdf page: %w", err)
+	}
+	defer f.Close()
 
-		}
-		pageText, err := s.ocr.Process(f.Name())
-		if err != nil {
-			return "", fmt.Errorf("failed to get text: %w", err)
+	err = jpeg.Encode(f, img, &jpeg.Options{Quality: jpeg.DefaultQuality})
+	if err != nil {
+		return "", fmt.Errorf("failed to save pdf page jpeg: %w", err)
+	}
+	text, err := s.ocr.Process(f.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to get text: %w", err)
+	}
+	return strings.TrimSpace(text), nil
+}
 
+// hasTextLayer проверяет, что текст страницы пригоден для использования без ocr:
+// в нем достаточно букв и мало символов, которые не удалось сопоставить юникоду
+func hasTextLayer(text string) bool {
+	var letters, garbage, total int
+	for _, r := range text {
+		switch {
+		case unicode.IsSpace(r):
+			continue
+		case r == unicode.ReplacementChar || unicode.Is(unicode.Co, r) || unicode.IsControl(r):
+			garbage++
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			letters++
 		}
-		text[n] = pageText
-
+		total++
 	}
-
-	return strings.Join(text, pageSeparator), nil
+	if letters < minPageLetters {
+		return false
+	}
+	return float64(garbage) < maxGarbageShare*float64(total)
 }
@@ -0,0 +1,91 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ocrMock struct {
+	calls int
+}
+
+func (o *ocrMock) Process(string) (string, error) {
+	o.calls++
+	return "распознанный текст", nil
+}
+
+// buildPDF собирает pdf, в котором каждая непустая строка pages выводится текстом на своей странице
+func buildPDF(pages []string) []byte {
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+	}
+	kids := make([]string, 0, len(pages))
+	for _, text := range pages {
+		stream := ""
+		if text != "" {
+			stream = fmt.Sprintf("BT /F1 12 Tf 72 720 Td (%s) Tj ET", text)
+		}
+		objects = append(objects, fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(stream), stream))
+		objects = append(objects, fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			len(objects),
+		))
+		kids = append(kids, fmt.Sprintf("%d 0 R", len(objects)))
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages))
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+func TestParsePages(t *testing.T) {
+	t.Parallel()
+
+	ocr := &ocrMock{}
+	pages, err := New(ocr).ParsePages(bytes.NewReader(buildPDF([]string{
+		"Born digital page with a proper text layer",
+		"",
+	})))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Born digital page with a proper text layer", "распознанный текст"}, pages)
+	assert.Equal(t, 1, ocr.calls)
+}
+
+func TestHasTextLayer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "text", text: "Обычный текст страницы с цифрами 2024", want: true},
+		{name: "empty", text: " \n\n ", want: false},
+		{name: "too short", text: "стр. 1", want: false},
+		{name: "garbage", text: "текст страницы ���  текст", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, hasTextLayer(tt.text))
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package pptx
+
+type (
+	ocr interface {
+		Process(filePath string) (string, error)
+	}
+)
@@ -0,0 +1,185 @@
+package pptx
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document/service/office"
+)
+
+var slidePart = regexp.MustCompile(`^ppt/slides/slide(\d+)\.xml$`)
+
+type Service struct {
+	ocr ocr
+}
+
+func New(ocr ocr) *Service {
+	return &Service{
+		ocr: ocr,
+	}
+}
+
+// Parse извлекает текст слайдов pptx презентации: заголовок слайда становится заголовком,
+// текстовые блоки - абзацами и списками, таблицы - markdown таблицами
+func (s Service) Parse(reader io.ReadSeeker) (string, error) {
+	archive, err := office.Open(reader)
+	if err != nil {
+		return "", err
+	}
+
+	var out office.Builder
+	for i, part := range slides(archive) {
+		root, err := archive.ReadXML(part)
+		if err != nil {
+			return "", fmt.Errorf("failed to read pptx slide: %w", err)
+		}
+		p := parser{
+			archive: archive,
+			ocr:     s.ocr,
+			rels:    archive.Relationships(part),
+			out:     &out,
+		}
+		p.slide(i+1, root)
+	}
+	return out.String(), nil
+}
+
+// slides возвращает части слайдов в порядке их номеров
+func slides(archive *office.Archive) []string {
+	type slide struct {
+		part   string
+		number int
+	}
+	var res []slide
+	for _, name := range archive.Files("ppt/slides/") {
+		if m := slidePart.FindStringSubmatch(name); m != nil {
+			number, _ := strconv.Atoi(m[1])
+			res = append(res, slide{part: name, number: number})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].number < res[j].number
+	})
+	parts := make([]string, len(res))
+	for i, s := range res {
+		parts[i] = s.part
+	}
+	return parts
+}
+
+type parser struct {
+	archive *office.Archive
+	ocr     ocr
+	rels    map[string]string
+	out     *office.Builder
+}
+
+func (p *parser) slide(number int, root *office.Node) {
+	tree := root.Find("spTree")
+	if tree == nil {
+		return
+	}
+	title := fmt.Sprintf("Slide %d", number)
+	for _, sp := range tree.FindAll("sp") {
+		if isTitle(sp) {
+			if text := shapeText(sp); strings.TrimSpace(text) != "" {
+				title = text
+			}
+			break
+		}
+	}
+	p.out.Heading(1, title)
+	p.shapes(tree)
+}
+
+func (p *parser) shapes(n *office.Node) {
+	for _, c := range n.Children {
+		switch c.Name {
+		case "sp":
+			if !isTitle(c) {
+				p.textBody(c.Child("txBody"))
+			}
+		case "graphicFrame":
+			if tbl := c.Find("tbl"); tbl != nil {
+				p.out.Table(table(tbl))
+			}
+		case "pic":
+			if blip := c.Find("blip"); blip != nil {
+				p.out.Paragraph(p.archive.RecognizeImage(p.ocr, p.rels[blip.Attr("embed")]))
+			}
+		case "grpSp":
+			p.shapes(c)
+		}
+	}
+}
+
+func (p *parser) textBody(body *office.Node) {
+	if body == nil {
+		return
+	}
+	for _, para := range body.FindAll("p") {
+		text := paragraphText(para)
+		props := para.Child("pPr")
+		level, _ := strconv.Atoi(props.Attr("lvl"))
+		switch {
+		case props != nil && props.Child("buNone") != nil:
+			p.out.Paragraph(text)
+		case level > 0 || props != nil && (props.Child("buChar") != nil || props.Child("buAutoNum") != nil):
+			p.out.ListItem(level, text)
+		default:
+			p.out.Paragraph(text)
+		}
+	}
+}
+
+func isTitle(sp *office.Node) bool {
+	ph := sp.Find("ph")
+	if ph == nil {
+		return false
+	}
+	kind := ph.Attr("type")
+	return kind == "title" || kind == "ctrTitle"
+}
+
+func shapeText(sp *office.Node) string {
+	var parts []string
+	for _, para := range sp.FindAll("p") {
+		parts = append(parts, paragraphText(para))
+	}
+	return strings.Join(parts, " ")
+}
+
+func paragraphText(para *office.Node) string {
+	var sb strings.Builder
+	for _, c := range para.Children {
+		switch c.Name {
+		case "r", "fld":
+			if t := c.Child("t"); t != nil {
+				sb.WriteString(t.InnerText())
+			}
+		case "br":
+			sb.WriteString(" ")
+		}
+	}
+	return sb.String()
+}
+
+func table(tbl *office.Node) [][]string {
+	var rows [][]string
+	for _, tr := range tbl.FindAll("tr") {
+		var cells []string
+		for _, tc := range tr.FindAll("tc") {
+			var parts []string
+			for _, para := range tc.FindAll("p") {
+				parts = append(parts, paragraphText(para))
+			}
+			cells = append(cells, strings.Join(parts, " "))
+		}
+		rows = append(rows, cells)
+	}
+	return rows
+}
@@ -0,0 +1,41 @@
+package pptx
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func slide(title, body string) string {
+	return fmt.Sprintf(`<p:sld xmlns:p="http://schemas.openxmlformats.org/presentationml/2006/main"
+ xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main"><p:cSld><p:spTree>
+<p:sp><p:nvSpPr><p:nvPr><p:ph type="title"/></p:nvPr></p:nvSpPr><p:txBody><a:p><a:r><a:t>%s</a:t></a:r></a:p></p:txBody></p:sp>
+<p:sp><p:nvSpPr><p:nvPr><p:ph idx="1"/></p:nvPr></p:nvSpPr><p:txBody>%s</p:txBody></p:sp>
+</p:spTree></p:cSld></p:sld>`, title, body)
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	files := map[string]string{
+		"ppt/slides/slide1.xml":  slide("Итоги", `<a:p><a:r><a:t>Текст</a:t></a:r></a:p><a:p><a:pPr lvl="1"/><a:r><a:t>деталь</a:t></a:r></a:p>`),
+		"ppt/slides/slide10.xml": slide("Конец", `<a:p><a:r><a:t>Спасибо</a:t></a:r></a:p>`),
+		"ppt/slides/slide2.xml":  slide("", `<a:p><a:pPr><a:buChar char="•"/></a:pPr><a:r><a:t>пункт</a:t></a:r></a:p>`),
+	}
+	for name, content := range files {
+		f, err := w.Create(name)
+		assert.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Close())
+
+	text, err := New(nil).Parse(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, "# Итоги\n\nТекст\n\n  - деталь\n\n# Slide 2\n\n- пункт\n\n# Конец\n\nСпасибо", text)
+}
@@ -2,22 +2,36 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"net/http"
+	"time"
 
 	"github.com/larek-tech/diploma/data/internal/domain/document"
+	"github.com/larek-tech/diploma/data/internal/domain/document/service/splitter"
 	"github.com/larek-tech/diploma/data/internal/domain/file"
 	"github.com/larek-tech/diploma/data/internal/domain/site"
+	"github.com/larek-tech/diploma/data/internal/domain/source"
+	"github.com/larek-tech/diploma/data/internal/domain/structured"
 	"github.com/larek-tech/diploma/data/pkg/metric"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
+// pagesKey ключ метаданных документа с количеством страниц
+const pagesKey = "pages"
+
+// Process разбирает объект источника и индексирует его документ в поколение generation.
+// Если построение этого поколения уже заменено более новым, документ не сохраняется и возвращается
+// document.ErrStaleGeneration, для generation < 0 документ записывается в текущее поколение источника.
 // TODO: remove fileExt from Process func
-func (s Service) Process(ctx context.Context, obj io.ReadSeeker, fileExt document.FileExtension, sourceObj any, sourceID string, metadata map[string]any) error {
+func (s Service) Process(ctx context.Context, obj io.ReadSeeker, fileExt document.FileExtension, sourceObj any, sourceID string, generation int, metadata map[string]any) error {
 	ctx, span := s.tracer.Start(ctx, "embeddingService.Process", trace.WithAttributes(
 		attribute.String("sourceID", sourceID),
+		attribute.Int("generation", generation),
 		attribute.String("fileExt", string(fileExt)),
 		attribute.String("metadata", fmt.Sprintf("%v", metadata)),
 	))
@@ -32,14 +46,51 @@ func (s Service) Process(ctx context.Context, obj io.ReadSeeker, fileExt documen
 	doc.ObjectID = objectID
 	doc.ObjectType = docType
 	doc.SourceID = sourceID
+	doc.Extension = string(fileExt)
+	doc.URL, doc.Date = getObjectLocation(sourceObj)
+	doc.Language = document.DetectLanguage(doc.Content)
 	doc.Metadata = metadata
+	if len(doc.Pages) > 0 {
+		doc.Metadata = make(map[string]any, len(metadata)+1)
+		for k, v := range metadata {
+			doc.Metadata[k] = v
+		}
+		doc.Metadata[pagesKey] = len(doc.Pages)
+	}
+
+	textSplitter, err := s.getSplitter(ctx, sourceID)
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to get splitter: %w", err)
+	}
 
 	err = s.trManager.Do(ctx, func(ctx context.Context) error {
-		txErr := s.documentStorage.Save(ctx, doc)
-		if err != nil {
+		// поколение не сменится, пока транзакция не завершится
+		target, txErr := s.sourceStorage.LockGeneration(ctx, sourceID)
+		if errors.Is(txErr, source.ErrSourceNotFound) {
+			// источник удален во время обработки
+			return document.ErrStaleGeneration
+		}
+		if txErr != nil {
+			return fmt.Errorf("failed to lock source generation: %w", txErr)
+		}
+		if generation >= 0 && target != generation {
+			return document.ErrStaleGeneration
+		}
+		// объект обрабатывается повторно, старые документы и их чанки заменяются новыми
+		if doc.ObjectID != "" {
+			if txErr = s.documentStorage.DeleteByObjectID(ctx, doc.ObjectID); txErr != nil {
+				return fmt.Errorf("failed to delete previous documents: %w", txErr)
+			}
+		}
+		txErr = s.documentStorage.Save(ctx, doc)
+		if txErr != nil {
 			return fmt.Errorf("failed to save document: %w", txErr)
 		}
-		chunks, txErr := s.embed(ctx, doc)
+		if txErr = s.saveSheets(ctx, doc); txErr != nil {
+			return fmt.Errorf("failed to save structured tables: %w", txErr)
+		}
+		chunks, txErr := s.embed(ctx, doc, textSplitter)
 		if txErr != nil {
 			return fmt.Errorf("failed to embed document: %w", txErr)
 		}
@@ -60,6 +111,9 @@ func (s Service) Process(ctx context.Context, obj io.ReadSeeker, fileExt documen
 				return fmt.Errorf("failed to save questions: %w", txErr)
 			}
 		}
+		if txErr = s.vectorStorage.SaveVectors(ctx, chunks, questions); txErr != nil {
+			return fmt.Errorf("failed to save embeddings: %w", txErr)
+		}
 
 		doc.Chunks = lo.Map(chunks, func(chunk *document.Chunk, _ int) string {
 			return chunk.ID
@@ -78,6 +132,35 @@ func (s Service) Process(ctx context.Context, obj io.ReadSeeker, fileExt documen
 	return nil
 }
 
+// saveSheets загружает листы табличного документа в отдельные таблицы для агрегирующих запросов.
+// Листы со слишком большим количеством столбцов пропускаются, их текст все равно попадает в чанки.
+func (s Service) saveSheets(ctx context.Context, doc *document.Document) error {
+	for _, sheet := range doc.Sheets {
+		table, rows, err := structured.FromSheet(doc.SourceID, doc.ID, sheet)
+		if err != nil {
+			slog.Warn("skipping structured table", "documentID", doc.ID, "sheet", sheet.Name, "error", err)
+			continue
+		}
+		if err = s.structuredStorage.Save(ctx, table, rows); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getSplitter возвращает splitter с параметрами разбиения источника или параметрами по умолчанию.
+func (s Service) getSplitter(ctx context.Context, sourceID string) (splitter.Splitter, error) {
+	cfg := document.DefaultChunkingConfig()
+	src, err := s.sourceStorage.GetByID(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source: %w", err)
+	}
+	if src != nil && src.Chunking != nil {
+		cfg = *src.Chunking
+	}
+	return splitter.New(cfg)
+}
+
 func getObjectData(source any) (string, document.Type) {
 	switch v := source.(type) {
 	case *site.Page:
@@ -88,3 +171,21 @@ func getObjectData(source any) (string, document.Type) {
 		return "", document.TypeFile
 	}
 }
+
+// getObjectLocation возвращает url страницы или путь файла в источнике и дату объекта для фильтров поиска
+func getObjectLocation(source any) (string, time.Time) {
+	switch v := source.(type) {
+	case *site.Page:
+		if modified, err := http.ParseTime(v.LastModified); err == nil {
+			return v.URL, modified
+		}
+		return v.URL, v.UpdatedAt
+	case *file.File:
+		if v.Path != "" {
+			return v.Path, v.UpdatedAt
+		}
+		return v.Filename, v.UpdatedAt
+	default:
+		return "", time.Time{}
+	}
+}
@@ -0,0 +1,43 @@
+package rtf
+
+import (
+	"golang.org/x/text/encoding/charmap"
+)
+
+// codePages однобайтовые кодировки, которые встречаются в \ansicpgN
+var codePages = map[int]*charmap.Charmap{
+	437:   charmap.CodePage437,
+	866:   charmap.CodePage866,
+	1250:  charmap.Windows1250,
+	1251:  charmap.Windows1251,
+	1252:  charmap.Windows1252,
+	1253:  charmap.Windows1253,
+	1254:  charmap.Windows1254,
+	1255:  charmap.Windows1255,
+	1256:  charmap.Windows1256,
+	1257:  charmap.Windows1257,
+	1258:  charmap.Windows1258,
+	10000: charmap.Macintosh,
+	10007: charmap.MacintoshCyrillic,
+}
+
+// charsets сопоставляет \fcharsetN шрифта с кодовой страницей
+var charsets = map[int]int{
+	0:   1252,
+	77:  10000,
+	161: 1253,
+	162: 1254,
+	163: 1258,
+	177: 1255,
+	178: 1256,
+	186: 1257,
+	204: 1251,
+	238: 1250,
+}
+
+func codePage(cp int) *charmap.Charmap {
+	if m, ok := codePages[cp]; ok {
+		return m
+	}
+	return charmap.Windows1252
+}
@@ -0,0 +1,7 @@
+package rtf
+
+type (
+	ocr interface {
+		Process(filePath string) (string, error)
+	}
+)
@@ -0,0 +1,458 @@
+package rtf
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document/service/office"
+)
+
+var ErrNotRTF = errors.New("content is not rtf")
+
+type Service struct {
+	ocr ocr
+}
+
+func New(ocr ocr) *Service {
+	return &Service{
+		ocr: ocr,
+	}
+}
+
+// Parse извлекает текст rtf документа с заголовками, списками и таблицами в виде markdown,
+// текст встроенных png и jpeg изображений распознается через ocr
+func (s Service) Parse(reader io.ReadSeeker) (string, error) {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to read content: %w", err)
+	}
+	if !strings.HasPrefix(string(content[:min(len(content), 5)]), `{\rtf`) {
+		return "", ErrNotRTF
+	}
+
+	p := newParser(content, s.ocr)
+	p.parse()
+	return p.out.String(), nil
+}
+
+// destination определяет, куда попадает текст текущей группы
+type destination int
+
+const (
+	destText destination = iota
+	destSkip
+	destFontTable
+	destStyleSheet
+	destPicture
+	destListText
+)
+
+// skipDestinations группы, содержимое которых не относится к тексту документа
+var skipDestinations = map[string]bool{
+	"colortbl": true, "info": true, "header": true, "headerl": true, "headerr": true, "headerf": true,
+	"footer": true, "footerl": true, "footerr": true, "footerf": true, "footnote": true, "fldinst": true,
+	"nonshppict": true, "themedata": true, "colorschememapping": true, "datastore": true,
+	"latentstyles": true, "listtable": true, "listoverridetable": true, "rsidtbl": true,
+	"generator": true, "xmlnstbl": true, "objdata": true, "annotation": true, "atnid": true,
+	"atnauthor": true, "template": true, "docvar": true, "userprops": true, "background": true,
+	"sp": true, "sn": true, "sv": true, "filetbl": true, "revtbl": true, "pgdsctbl": true,
+}
+
+// specialChars управляющие слова, обозначающие отдельные символы
+var specialChars = map[string]rune{
+	"line": ' ', "tab": ' ', "emdash": '—', "endash": '–', "bullet": '•',
+	"lquote": '‘', "rquote": '’', "ldblquote": '“', "rdblquote": '”', "emspace": ' ', "enspace": ' ',
+}
+
+// state свойства группы, восстанавливаются при выходе из нее
+type state struct {
+	dest       destination
+	uc         int
+	font       int
+	blip       bool
+	styleEntry bool
+}
+
+type parser struct {
+	data  []byte
+	pos   int
+	ocr   ocr
+	st    state
+	stack []state
+	star  bool
+
+	defaultCodePage int
+	fonts           map[int]int
+	styles          map[int]int
+	fontEntry       int
+	styleNumber     int
+	styleName       strings.Builder
+	skipChars       int
+
+	text    strings.Builder
+	heading int
+	list    bool
+	depth   int
+	inTable bool
+	cell    strings.Builder
+	cells   []string
+	rows    [][]string
+	images  []string
+	pictHex strings.Builder
+	pictBin []byte
+
+	out office.Builder
+}
+
+func newParser(data []byte, ocr ocr) *parser {
+	return &parser{
+		data:            data,
+		ocr:             ocr,
+		st:              state{uc: 1},
+		defaultCodePage: 1252,
+		fonts:           make(map[int]int),
+		styles:          make(map[int]int),
+	}
+}
+
+func (p *parser) parse() {
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		p.pos++
+		switch c {
+		case '{':
+			p.openGroup()
+		case '}':
+			p.closeGroup()
+		case '\\':
+			p.control()
+		case '\r', '\n':
+		case '\t':
+			p.writeRune(' ')
+		default:
+			p.char(c)
+		}
+	}
+	p.endParagraph()
+	p.flushTable()
+	p.flushImages()
+}
+
+func (p *parser) openGroup() {
+	p.stack = append(p.stack, p.st)
+	if p.st.dest == destStyleSheet && !p.st.styleEntry {
+		p.st.styleEntry = true
+		p.styleNumber = 0
+		p.styleName.Reset()
+	}
+}
+
+func (p *parser) closeGroup() {
+	if len(p.stack) == 0 {
+		return
+	}
+	parent := p.stack[len(p.stack)-1]
+	p.stack = p.stack[:len(p.stack)-1]
+
+	if p.st.styleEntry && !parent.styleEntry {
+		p.addStyle()
+	}
+	if p.st.dest == destPicture && parent.dest != destPicture {
+		p.picture(p.st.blip)
+	}
+	p.st = parent
+	p.star = false
+}
+
+func (p *parser) control() {
+	if p.pos >= len(p.data) {
+		return
+	}
+	c := p.data[p.pos]
+	if isLetter(c) {
+		start := p.pos
+		for p.pos < len(p.data) && isLetter(p.data[p.pos]) {
+			p.pos++
+		}
+		word := string(p.data[start:p.pos])
+
+		start = p.pos
+		if p.pos < len(p.data) && p.data[p.pos] == '-' {
+			p.pos++
+		}
+		for p.pos < len(p.data) && p.data[p.pos] >= '0' && p.data[p.pos] <= '9' {
+			p.pos++
+		}
+		param, err := strconv.Atoi(string(p.data[start:p.pos]))
+		hasParam := err == nil
+		if p.pos < len(p.data) && p.data[p.pos] == ' ' {
+			p.pos++
+		}
+		p.word(word, param, hasParam)
+		return
+	}
+
+	p.pos++
+	switch c {
+	case '\'':
+		if p.pos+2 > len(p.data) {
+			return
+		}
+		b, err := strconv.ParseUint(string(p.data[p.pos:p.pos+2]), 16, 8)
+		p.pos += 2
+		if err == nil {
+			p.char(byte(b))
+		}
+	case '\\', '{', '}':
+		p.char(c)
+	case '~':
+		p.writeRune(' ')
+	case '_':
+		p.writeRune('-')
+	case '*':
+		p.star = true
+	case '\r', '\n':
+		p.word("par", 0, false)
+	}
+}
+
+func (p *parser) word(word string, param int, hasParam bool) {
+	if p.star {
+		p.star = false
+		if word != "shppict" {
+			p.st.dest = destSkip
+			return
+		}
+	}
+	if word == "bin" {
+		p.binary(param)
+		return
+	}
+	if word == "u" {
+		if param < 0 {
+			param += 65536
+		}
+		p.writeRune(rune(param))
+		p.skipChars = p.st.uc
+		return
+	}
+	if word == "uc" {
+		p.st.uc = param
+		return
+	}
+
+	switch p.st.dest {
+	case destSkip, destListText:
+		return
+	case destFontTable:
+		switch word {
+		case "f":
+			p.fontEntry = param
+		case "fcharset":
+			if cp, ok := charsets[param]; ok {
+				p.fonts[p.fontEntry] = cp
+			}
+		case "cpg":
+			p.fonts[p.fontEntry] = param
+		}
+		return
+	case destStyleSheet:
+		if word == "s" {
+			p.styleNumber = param
+		}
+		return
+	case destPicture:
+		if word == "pngblip" || word == "jpegblip" {
+			p.st.blip = true
+		}
+		return
+	}
+
+	if skipDestinations[word] {
+		p.st.dest = destSkip
+		return
+	}
+	if r, ok := specialChars[word]; ok {
+		p.writeRune(r)
+		return
+	}
+	switch word {
+	case "ansicpg":
+		p.defaultCodePage = param
+	case "fonttbl":
+		p.st.dest = destFontTable
+	case "stylesheet":
+		p.st.dest = destStyleSheet
+	case "pict":
+		p.st.dest = destPicture
+		p.st.blip = false
+		p.pictHex.Reset()
+		p.pictBin = nil
+	case "listtext", "pntext":
+		p.st.dest = destListText
+		p.list = true
+	case "f":
+		p.st.font = param
+	case "par":
+		p.endParagraph()
+	case "pard":
+		p.heading = 0
+		p.list = false
+		p.depth = 0
+		p.inTable = false
+	case "s":
+		p.heading = p.styles[param]
+	case "outlinelevel":
+		if hasParam && param < 9 {
+			p.heading = param + 1
+		}
+	case "ls":
+		p.list = true
+	case "ilvl":
+		p.depth = param
+	case "intbl":
+		p.inTable = true
+	case "cell":
+		p.endCell()
+	case "row":
+		if strings.TrimSpace(p.text.String()+p.cell.String()) != "" {
+			p.endCell()
+		}
+		if len(p.cells) > 0 {
+			p.rows = append(p.rows, p.cells)
+		}
+		p.cells = nil
+	}
+}
+
+// char обрабатывает один байт текста в кодировке текущего шрифта
+func (p *parser) char(b byte) {
+	if p.skipChars > 0 {
+		p.skipChars--
+		return
+	}
+	if p.st.dest == destPicture {
+		p.pictHex.WriteByte(b)
+		return
+	}
+	cp := p.defaultCodePage
+	if fontCP, ok := p.fonts[p.st.font]; ok && p.st.dest == destText {
+		cp = fontCP
+	}
+	p.writeRune(codePage(cp).DecodeByte(b))
+}
+
+func (p *parser) writeRune(r rune) {
+	switch p.st.dest {
+	case destText:
+		p.text.WriteRune(r)
+	case destStyleSheet:
+		p.styleName.WriteRune(r)
+	}
+}
+
+// binary пропускает двоичные данные \binN, внутри изображения сохраняет их
+func (p *parser) binary(n int) {
+	end := min(p.pos+max(n, 0), len(p.data))
+	if p.st.dest == destPicture {
+		p.pictBin = append(p.pictBin, p.data[p.pos:end]...)
+	}
+	p.pos = end
+}
+
+func (p *parser) addStyle() {
+	name := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(p.styleName.String()), ";"))
+	lower := strings.ToLower(name)
+	if lower == "title" {
+		p.styles[p.styleNumber] = 1
+		return
+	}
+	for _, prefix := range []string{"heading", "заголовок"} {
+		if level, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(lower, prefix))); err == nil && strings.HasPrefix(lower, prefix) {
+			p.styles[p.styleNumber] = level
+			return
+		}
+	}
+}
+
+func (p *parser) endCell() {
+	p.cell.WriteString(p.text.String())
+	p.text.Reset()
+	p.cells = append(p.cells, p.cell.String())
+	p.cell.Reset()
+}
+
+func (p *parser) endParagraph() {
+	text := p.text.String()
+	p.text.Reset()
+	if p.inTable {
+		p.cell.WriteString(text + " ")
+		return
+	}
+	p.flushTable()
+	switch {
+	case p.heading > 0:
+		p.out.Heading(p.heading, text)
+	case p.list:
+		p.out.ListItem(p.depth, text)
+	default:
+		p.out.Paragraph(text)
+	}
+	p.flushImages()
+}
+
+func (p *parser) flushTable() {
+	if len(p.cells) > 0 {
+		p.rows = append(p.rows, p.cells)
+		p.cells = nil
+	}
+	if len(p.rows) > 0 {
+		p.out.Table(p.rows)
+		p.rows = nil
+	}
+}
+
+func (p *parser) flushImages() {
+	for _, image := range p.images {
+		p.out.Paragraph(image)
+	}
+	p.images = nil
+}
+
+// picture распознает текст изображения из группы \pict
+func (p *parser) picture(supported bool) {
+	if !supported || p.ocr == nil {
+		return
+	}
+	data := p.pictBin
+	if len(data) == 0 {
+		raw := strings.Map(func(r rune) rune {
+			if strings.ContainsRune("0123456789abcdefABCDEF", r) {
+				return r
+			}
+			return -1
+		}, p.pictHex.String())
+		decoded, err := hex.DecodeString(raw[:len(raw)/2*2])
+		if err != nil {
+			return
+		}
+		data = decoded
+	}
+	text, err := office.RecognizeImage(p.ocr, data)
+	if err != nil {
+		slog.Warn("failed to recognize embedded image", "error", err)
+		return
+	}
+	if text != "" {
+		p.images = append(p.images, text)
+	}
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,36 @@
+package rtf
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const document = `{\rtf1\ansi\ansicpg1251\deff0
+{\fonttbl{\f0\fnil\fcharset204 Times New Roman;}{\f1\fnil\fcharset0 Arial;}}
+{\colortbl;\red0\green0\blue0;}
+{\stylesheet{\s0 Normal;}{\s1\outlinelevel0 heading 1;}}
+{\*\generator Test;}
+\pard\s1 \'c3\'eb\'e0\'e2\'e0\par
+\pard \f1 Caf\'e9 \f0\uc1\u1087?\'f0\'e8\'e2\'e5\'f2\par
+\pard{\listtext\f0 \'95\tab}\ls1\ilvl0 \'ef\'f3\'ed\'ea\'f2\par
+\pard\intbl a\cell b\cell\row
+\pard\intbl 1\cell 2\cell\row
+\pard end\par
+}`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	text, err := New(nil).Parse(bytes.NewReader([]byte(document)))
+	assert.NoError(t, err)
+	assert.Equal(t, "# Глава\n\nCafé привет\n\n- пункт\n\n| a | b |\n| 1 | 2 |\n\nend", text)
+}
+
+func TestParseNotRTF(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(nil).Parse(bytes.NewReader([]byte("plain text")))
+	assert.ErrorIs(t, err, ErrNotRTF)
+}
@@ -2,29 +2,41 @@ package service
 
 import (
 	"github.com/larek-tech/diploma/data/internal/domain/document"
+	"github.com/larek-tech/diploma/data/internal/domain/document/service/docx"
 	"github.com/larek-tech/diploma/data/internal/domain/document/service/html"
 	"github.com/larek-tech/diploma/data/internal/domain/document/service/img"
 	"github.com/larek-tech/diploma/data/internal/domain/document/service/markdown"
+	"github.com/larek-tech/diploma/data/internal/domain/document/service/odt"
 	"github.com/larek-tech/diploma/data/internal/domain/document/service/pdf"
+	"github.com/larek-tech/diploma/data/internal/domain/document/service/pptx"
+	"github.com/larek-tech/diploma/data/internal/domain/document/service/rtf"
+	"github.com/larek-tech/diploma/data/internal/domain/document/service/spreadsheet"
+	"github.com/larek-tech/diploma/data/internal/domain/document/service/txt"
 	"go.opentelemetry.io/otel/trace"
 )
 
 type Service struct {
-	documentStorage documentStorage
-	chunkStorage    chunkStorage
-	questionStorage questionStorage
-	questionService questionService
-	parsers         map[document.FileExtension]parser
-	embedder        embedder
-	trManager       trManager
-	tracer          trace.Tracer
+	documentStorage   documentStorage
+	sourceStorage     sourceStorage
+	chunkStorage      chunkStorage
+	structuredStorage structuredStorage
+	questionStorage   questionStorage
+	questionService   questionService
+	vectorStorage     vectorStorage
+	parsers           map[document.FileExtension]parser
+	embedder          embedder
+	trManager         trManager
+	tracer            trace.Tracer
 }
 
 func New(
 	documentStorage documentStorage,
+	sourceStorage sourceStorage,
 	chunkStorage chunkStorage,
+	structuredStorage structuredStorage,
 	questionStorage questionStorage,
 	questionService questionService,
+	vectorStorage vectorStorage,
 	embedder embedder,
 	ocr ocr,
 	trManager trManager,
@@ -33,16 +45,27 @@ func New(
 	img := img.New(ocr)
 
 	return &Service{
-		documentStorage: documentStorage,
-		chunkStorage:    chunkStorage,
-		questionStorage: questionStorage,
-		questionService: questionService,
+		documentStorage:   documentStorage,
+		sourceStorage:     sourceStorage,
+		chunkStorage:      chunkStorage,
+		structuredStorage: structuredStorage,
+		questionStorage:   questionStorage,
+		questionService:   questionService,
+		vectorStorage:     vectorStorage,
 		parsers: map[document.FileExtension]parser{
 			document.HTML: html.New(),
 			document.MD:   markdown.New(),
 			document.PNG:  img,
 			document.JPEG: img,
 			document.PDF:  pdf.New(ocr),
+			document.TXT:  txt.New(),
+			document.DOCX: docx.New(ocr),
+			document.PPTX: pptx.New(ocr),
+			document.ODT:  odt.New(ocr),
+			document.RTF:  rtf.New(ocr),
+			document.CSV:  spreadsheet.NewCSV(),
+			document.XLSX: spreadsheet.NewXLSX(),
+			document.ODS:  spreadsheet.NewODS(),
 		},
 		embedder:  embedder,
 		trManager: trManager,
@@ -0,0 +1,46 @@
+package splitter
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+)
+
+func lengthFunc(unit document.ChunkSizeUnit) func(string) int {
+	if unit == document.UnitTokens {
+		return EstimateTokens
+	}
+	return utf8.RuneCountInString
+}
+
+// EstimateTokens приблизительно оценивает количество токенов в тексте, словарь BPE-токенизатора не используется:
+// латинские слова в среднем занимают токен на 4 символа, остальные алфавиты - на 2 символа,
+// каждый знак препинания - отдельный токен. Для конкретной модели эмбеддингов реальное число токенов
+// может отличаться, поэтому размер чанка в токенах стоит задавать с запасом до лимита модели.
+// Оценка аддитивна: сумма по частям текста, разделенного по пробелам или знакам препинания, равна оценке всего текста.
+func EstimateTokens(text string) int {
+	tokens := 0
+	ascii, other := 0, 0
+	flush := func() {
+		tokens += (ascii+3)/4 + (other+1)/2
+		ascii, other = 0, 0
+	}
+	for _, r := range text {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if r < utf8.RuneSelf {
+				ascii++
+			} else {
+				other++
+			}
+		case unicode.IsSpace(r):
+			flush()
+		default:
+			flush()
+			tokens++
+		}
+	}
+	flush()
+	return tokens
+}
@@ -0,0 +1,73 @@
+package splitter
+
+import (
+	"regexp"
+	"strings"
+)
+
+var headingRe = regexp.MustCompile(`^(#{1,6})\s+(.+?)\s*#*\s*$`)
+
+// Markdown разбивает текст на разделы по заголовкам markdown и каждый раздел - рекурсивно,
+// путь заголовков раздела сохраняется в чанке.
+type Markdown struct {
+	recursive Recursive
+}
+
+type section struct {
+	headings []string
+	body     strings.Builder
+}
+
+type heading struct {
+	level int
+	title string
+}
+
+func (m Markdown) Split(text string) []Chunk {
+	var chunks []Chunk
+	for _, s := range splitSections(text) {
+		for _, part := range m.recursive.split(s.body.String()) {
+			chunks = append(chunks, Chunk{
+				Content:  part,
+				Headings: s.headings,
+			})
+		}
+	}
+	return chunks
+}
+
+// splitSections делит текст на разделы, начинающиеся с заголовков, заголовки внутри блоков кода пропускаются.
+func splitSections(text string) []*section {
+	var (
+		sections []*section
+		stack    []heading
+		inFence  bool
+	)
+	current := &section{}
+	sections = append(sections, current)
+	for _, line := range strings.SplitAfter(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+		}
+		if m := headingRe.FindStringSubmatch(trimmed); m != nil && !inFence {
+			level := len(m[1])
+			for len(stack) > 0 && stack[len(stack)-1].level >= level {
+				stack = stack[:len(stack)-1]
+			}
+			stack = append(stack, heading{level: level, title: m[2]})
+			current = &section{headings: headingTitles(stack)}
+			sections = append(sections, current)
+		}
+		current.body.WriteString(line)
+	}
+	return sections
+}
+
+func headingTitles(stack []heading) []string {
+	titles := make([]string, 0, len(stack))
+	for _, h := range stack {
+		titles = append(titles, h.title)
+	}
+	return titles
+}
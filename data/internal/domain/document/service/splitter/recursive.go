@@ -0,0 +1,112 @@
+package splitter
+
+import (
+	"strings"
+)
+
+// separators разделители в порядке убывания крупности: абзацы, строки, предложения, части предложений, слова.
+// Пустой разделитель означает разбиение по символам и используется, только если остальные не помогли.
+var separators = []string{"\n\n", "\n", ". ", "! ", "? ", "; ", ", ", " ", ""}
+
+// Recursive разбивает текст по самому крупному разделителю, а слишком большие части - рекурсивно
+// по следующим, после чего склеивает соседние части в чанки не больше size с перекрытием overlap.
+type Recursive struct {
+	size    int
+	overlap int
+	length  func(string) int
+}
+
+func newRecursive(size, overlap int, length func(string) int) Recursive {
+	return Recursive{
+		size:    size,
+		overlap: overlap,
+		length:  length,
+	}
+}
+
+func (r Recursive) Split(text string) []Chunk {
+	parts := r.split(text)
+	chunks := make([]Chunk, 0, len(parts))
+	for _, part := range parts {
+		chunks = append(chunks, Chunk{Content: part})
+	}
+	return chunks
+}
+
+func (r Recursive) split(text string) []string {
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+	return r.splitBy(text, separators)
+}
+
+func (r Recursive) splitBy(text string, seps []string) []string {
+	sep, rest := seps[len(seps)-1], []string(nil)
+	for i, s := range seps {
+		if s == "" || strings.Contains(text, s) {
+			sep, rest = s, seps[i+1:]
+			break
+		}
+	}
+
+	var pieces []string
+	if sep == "" {
+		// слова длиннее чанка режем по символам, а не по байтам
+		for _, c := range text {
+			pieces = append(pieces, string(c))
+		}
+	} else {
+		// разделитель остается в конце части, чтобы не терять пунктуацию и переносы
+		pieces = strings.SplitAfter(text, sep)
+	}
+
+	var chunks, fitting []string
+	for _, piece := range pieces {
+		if piece == "" {
+			continue
+		}
+		if r.length(piece) <= r.size {
+			fitting = append(fitting, piece)
+			continue
+		}
+		if len(fitting) > 0 {
+			chunks = append(chunks, r.merge(fitting)...)
+			fitting = nil
+		}
+		chunks = append(chunks, r.splitBy(piece, rest)...)
+	}
+	if len(fitting) > 0 {
+		chunks = append(chunks, r.merge(fitting)...)
+	}
+	return chunks
+}
+
+// merge склеивает части в чанки, следующий чанк начинается с последних частей предыдущего общей длиной не больше overlap.
+func (r Recursive) merge(pieces []string) []string {
+	var (
+		chunks  []string
+		current []string
+		total   int
+	)
+	emit := func() {
+		if chunk := strings.TrimSpace(strings.Join(current, "")); chunk != "" {
+			chunks = append(chunks, chunk)
+		}
+	}
+	for _, piece := range pieces {
+		l := r.length(piece)
+		if total+l > r.size && len(current) > 0 {
+			emit()
+			for len(current) > 0 && (total > r.overlap || total+l > r.size) {
+				total -= r.length(current[0])
+				current = current[1:]
+			}
+		}
+		current = append(current, piece)
+		total += l
+	}
+	if len(current) > 0 {
+		emit()
+	}
+	return chunks
+}
@@ -0,0 +1,28 @@
+package splitter
+
+import (
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+)
+
+// Chunk фрагмент документа
+type Chunk struct {
+	Content  string   // Content текст фрагмента
+	Headings []string // Headings путь заголовков раздела, в котором находится фрагмент
+}
+
+type Splitter interface {
+	Split(text string) []Chunk
+	SplitTable(sheet document.Sheet) []TableChunk
+}
+
+// New возвращает splitter для конфигурации, незаданные параметры заполняются значениями по умолчанию.
+func New(cfg document.ChunkingConfig) (Splitter, error) {
+	if err := cfg.Normalize(); err != nil {
+		return nil, err
+	}
+	recursive := newRecursive(cfg.Size, cfg.Overlap, lengthFunc(cfg.Unit))
+	if cfg.Strategy == document.ChunkingMarkdown {
+		return Markdown{recursive: recursive}, nil
+	}
+	return recursive, nil
+}
@@ -0,0 +1,130 @@
+package splitter
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecursiveSplit(t *testing.T) {
+	t.Parallel()
+
+	text := "Первый абзац. Второе предложение.\n\nВторой абзац без точки\n\n" + strings.Repeat("ж", 25)
+	s, err := New(document.ChunkingConfig{
+		Strategy: document.ChunkingRecursive,
+		Unit:     document.UnitChars,
+		Size:     20,
+		Overlap:  5,
+	})
+	assert.NoError(t, err)
+	chunks := s.Split(text)
+	assert.NotEmpty(t, chunks)
+	for _, chunk := range chunks {
+		assert.True(t, utf8.ValidString(chunk.Content), chunk.Content)
+		assert.LessOrEqual(t, utf8.RuneCountInString(chunk.Content), 20, chunk.Content)
+	}
+	assert.Equal(t, "Первый абзац.", chunks[0].Content)
+	assert.Equal(t, "Второе предложение.", chunks[1].Content)
+}
+
+func TestRecursiveOverlap(t *testing.T) {
+	t.Parallel()
+
+	s, err := New(document.ChunkingConfig{
+		Strategy: document.ChunkingRecursive,
+		Unit:     document.UnitChars,
+		Size:     11,
+		Overlap:  6,
+	})
+	assert.NoError(t, err)
+	chunks := s.Split("один два три четыре")
+	assert.Equal(t, []Chunk{
+		{Content: "один два"},
+		{Content: "два три"},
+		{Content: "три четыре"},
+	}, chunks)
+}
+
+func TestMarkdownSplit(t *testing.T) {
+	t.Parallel()
+
+	text := `Вступление
+
+# Руководство
+
+Общее описание.
+
+## Установка
+
+Скачайте архив.
+
+` + "```" + `
+# не заголовок
+` + "```" + `
+
+## Запуск
+
+Запустите сервис.
+
+# Приложение
+
+| a | b |
+| - | - |
+`
+	s, err := New(document.ChunkingConfig{Strategy: document.ChunkingMarkdown})
+	assert.NoError(t, err)
+	chunks := s.Split(text)
+	if !assert.Len(t, chunks, 5) {
+		return
+	}
+	assert.Nil(t, chunks[0].Headings)
+	assert.Equal(t, []string{"Руководство"}, chunks[1].Headings)
+	assert.Equal(t, []string{"Руководство", "Установка"}, chunks[2].Headings)
+	assert.Contains(t, chunks[2].Content, "# не заголовок")
+	assert.Equal(t, []string{"Руководство", "Запуск"}, chunks[3].Headings)
+	assert.Equal(t, []string{"Приложение"}, chunks[4].Headings)
+	assert.Contains(t, chunks[4].Content, "| a | b |")
+}
+
+func TestEstimateTokens(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 0, EstimateTokens(""))
+	assert.Equal(t, 2, EstimateTokens("hello"))
+	assert.Equal(t, 3, EstimateTokens("привет"))
+	assert.Equal(t, EstimateTokens("hello, ")+EstimateTokens("привет мир"), EstimateTokens("hello, привет мир"))
+}
+
+func TestNewInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(document.ChunkingConfig{Size: 10, Overlap: 10})
+	assert.ErrorIs(t, err, document.ErrInvalidChunkingConfig)
+	_, err = New(document.ChunkingConfig{Strategy: "semantic"})
+	assert.ErrorIs(t, err, document.ErrInvalidChunkingConfig)
+}
+
+func TestSplitTable(t *testing.T) {
+	t.Parallel()
+
+	s, err := New(document.ChunkingConfig{Unit: document.UnitChars, Size: 40})
+	assert.NoError(t, err)
+	chunks := s.SplitTable(document.Sheet{
+		Name:    "s",
+		Columns: []string{"a", "b"},
+		Rows: []document.Row{
+			{Number: 2, Cells: []string{"1", "2"}},
+			{Number: 3, Cells: []string{"3", "4"}},
+			{Number: 5, Cells: []string{"5", "6"}},
+			{Number: 6, Cells: []string{"a very long value that does not fit", "7"}},
+		},
+	})
+	assert.Equal(t, []TableChunk{
+		{Content: "# s\n| a | b |\n| 1 | 2 |\n| 3 | 4 |", FirstRow: 2, LastRow: 3},
+		{Content: "# s\n| a | b |\n| 5 | 6 |", FirstRow: 5, LastRow: 5},
+		{Content: "# s\n| a | b |\n| a very long value that does not fit | 7 |", FirstRow: 6, LastRow: 6},
+	}, chunks)
+}
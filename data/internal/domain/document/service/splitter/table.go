@@ -0,0 +1,58 @@
+package splitter
+
+import (
+	"strings"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+)
+
+// TableChunk фрагмент листа: заголовок таблицы и группа идущих подряд строк
+type TableChunk struct {
+	Content  string // Content текст фрагмента, строка заголовков повторяется в каждом фрагменте
+	FirstRow int    // FirstRow номер первой строки фрагмента в исходном файле
+	LastRow  int    // LastRow номер последней строки фрагмента в исходном файле
+}
+
+// SplitTable разбивает лист на группы строк не больше size вместе с заголовком.
+// Строки не разрезаются и не перекрываются: строка, которая не помещается в чанк целиком, становится отдельным чанком.
+func (r Recursive) SplitTable(sheet document.Sheet) []TableChunk {
+	header := document.RenderRow(sheet.Columns)
+	if sheet.Name != "" {
+		header = "# " + sheet.Name + "\n" + header
+	}
+	headerSize := r.length(header + "\n")
+
+	var chunks []TableChunk
+	var lines []string
+	var first, last, size int
+	emit := func() {
+		if len(lines) == 0 {
+			return
+		}
+		chunks = append(chunks, TableChunk{
+			Content:  header + "\n" + strings.Join(lines, "\n"),
+			FirstRow: first,
+			LastRow:  last,
+		})
+		lines, size = nil, 0
+	}
+	for _, row := range sheet.Rows {
+		line := document.RenderRow(row.Cells)
+		lineSize := r.length(line + "\n")
+		if len(lines) > 0 && headerSize+size+lineSize > r.size {
+			emit()
+		}
+		if len(lines) == 0 {
+			first = row.Number
+		}
+		lines = append(lines, line)
+		last = row.Number
+		size += lineSize
+	}
+	emit()
+	return chunks
+}
+
+func (m Markdown) SplitTable(sheet document.Sheet) []TableChunk {
+	return m.recursive.SplitTable(sheet)
+}
@@ -0,0 +1,69 @@
+package spreadsheet
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document/service/txt"
+)
+
+// delimiters допустимые разделители csv в порядке предпочтения
+var delimiters = []rune{',', ';', '\t', '|'}
+
+func readCSV(reader io.ReadSeeker) ([]grid, error) {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read content: %w", err)
+	}
+	text, err := txt.Decode(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode csv: %w", err)
+	}
+
+	r := csv.NewReader(strings.NewReader(text))
+	r.Comma = delimiter(text)
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+
+	g := newGrid("")
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read csv: %w", err)
+		}
+		line, _ := r.FieldPos(0)
+		for col, value := range record {
+			g.set(line, col, value)
+		}
+	}
+	return []grid{*g}, nil
+}
+
+// delimiter выбирает разделитель, который чаще всего встречается в первой строке вне кавычек
+func delimiter(text string) rune {
+	line, _, _ := strings.Cut(text, "\n")
+	counts := make(map[rune]int)
+	quoted := false
+	for _, c := range line {
+		if c == '"' {
+			quoted = !quoted
+			continue
+		}
+		if !quoted {
+			counts[c]++
+		}
+	}
+	best := delimiters[0]
+	for _, d := range delimiters {
+		if counts[d] > counts[best] {
+			best = d
+		}
+	}
+	return best
+}
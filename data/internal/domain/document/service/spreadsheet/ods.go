@@ -0,0 +1,132 @@
+package spreadsheet
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document/service/office"
+)
+
+const contentPart = "content.xml"
+
+func readODS(reader io.ReadSeeker) ([]grid, error) {
+	archive, err := office.Open(reader)
+	if err != nil {
+		return nil, err
+	}
+	root, err := archive.ReadXML(contentPart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ods: %w", err)
+	}
+	body := root.Find("spreadsheet")
+	if body == nil {
+		return nil, nil
+	}
+	var grids []grid
+	for _, table := range body.FindAll("table") {
+		g := newGrid(table.Attr("name"))
+		rowNumber := 1
+		for _, row := range table.FindAll("table-row") {
+			repeat := intAttr(row, "number-rows-repeated")
+			cells := odsRow(row)
+			if len(cells) == 0 {
+				rowNumber += repeat
+				continue
+			}
+			for i := 0; i < min(repeat, maxRepeat); i++ {
+				for _, c := range cells {
+					g.set(rowNumber, c.col, c.value)
+					if i == 0 && (c.rows > 1 || c.cols > 1) {
+						g.merges = append(g.merges, mergeRange{
+							top: rowNumber, left: c.col, bottom: rowNumber + c.rows - 1, right: c.col + c.cols - 1,
+						})
+					}
+				}
+				rowNumber++
+			}
+			rowNumber += max(repeat-maxRepeat, 0)
+		}
+		grids = append(grids, *g)
+	}
+	return grids, nil
+}
+
+type odsCell struct {
+	col        int
+	value      string
+	rows, cols int
+}
+
+// odsRow возвращает непустые и объединенные ячейки строки с их индексами столбцов
+func odsRow(row *office.Node) []odsCell {
+	var res []odsCell
+	col := 0
+	for _, c := range row.Children {
+		if c.Name != "table-cell" && c.Name != "covered-table-cell" {
+			continue
+		}
+		repeat := intAttr(c, "number-columns-repeated")
+		value := odsValue(c)
+		rows, cols := intAttr(c, "number-rows-spanned"), intAttr(c, "number-columns-spanned")
+		if value == "" && rows == 1 && cols == 1 {
+			col += repeat
+			continue
+		}
+		for i := 0; i < min(repeat, maxRepeat); i++ {
+			res = append(res, odsCell{col: col, value: value, rows: rows, cols: cols})
+			col++
+		}
+		col += max(repeat-maxRepeat, 0)
+	}
+	return res
+}
+
+// odsValue возвращает значение ячейки: для чисел и дат - исходное значение, иначе - текст
+func odsValue(c *office.Node) string {
+	switch c.Attr("value-type") {
+	case "float", "percentage", "currency":
+		if v := c.Attr("value"); v != "" {
+			return v
+		}
+	case "date":
+		if v := c.Attr("date-value"); v != "" {
+			return strings.TrimSuffix(v, "T00:00:00")
+		}
+	case "boolean":
+		if isTrue(c.Attr("boolean-value")) {
+			return "TRUE"
+		}
+		return "FALSE"
+	}
+	var parts []string
+	for _, p := range c.FindAll("p") {
+		parts = append(parts, odsText(p))
+	}
+	return strings.Join(parts, " ")
+}
+
+func odsText(n *office.Node) string {
+	var sb strings.Builder
+	for _, c := range n.Children {
+		switch {
+		case c.IsText():
+			sb.WriteString(c.Text)
+		case c.Name == "s":
+			sb.WriteString(" ")
+		case c.Name == "annotation":
+		default:
+			sb.WriteString(odsText(c))
+		}
+	}
+	return sb.String()
+}
+
+func intAttr(n *office.Node, name string) int {
+	v, err := strconv.Atoi(n.Attr(name))
+	if err != nil || v < 1 {
+		return 1
+	}
+	return v
+}
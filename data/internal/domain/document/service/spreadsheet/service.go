@@ -0,0 +1,56 @@
+package spreadsheet
+
+import (
+	"io"
+	"strings"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+)
+
+// Service парсер табличных документов: выделяет листы, строки заголовков и строки данных
+type Service struct {
+	read func(io.ReadSeeker) ([]grid, error)
+}
+
+// NewCSV возвращает парсер csv файлов, разделитель определяется по первой строке
+func NewCSV() *Service {
+	return &Service{read: readCSV}
+}
+
+// NewXLSX возвращает парсер книг excel
+func NewXLSX() *Service {
+	return &Service{read: readXLSX}
+}
+
+// NewODS возвращает парсер таблиц OpenDocument
+func NewODS() *Service {
+	return &Service{read: readODS}
+}
+
+// Parse возвращает листы документа в виде markdown таблиц
+func (s Service) Parse(reader io.ReadSeeker) (string, error) {
+	sheets, err := s.ParseSheets(reader)
+	if err != nil {
+		return "", err
+	}
+	rendered := make([]string, 0, len(sheets))
+	for _, sheet := range sheets {
+		rendered = append(rendered, sheet.Render())
+	}
+	return strings.Join(rendered, "\n\n"), nil
+}
+
+// ParseSheets возвращает непустые листы документа
+func (s Service) ParseSheets(reader io.ReadSeeker) ([]document.Sheet, error) {
+	grids, err := s.read(reader)
+	if err != nil {
+		return nil, err
+	}
+	sheets := make([]document.Sheet, 0, len(grids))
+	for _, g := range grids {
+		if sheet, ok := g.sheet(); ok {
+			sheets = append(sheets, sheet)
+		}
+	}
+	return sheets, nil
+}
@@ -0,0 +1,96 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildArchive(t *testing.T, files map[string]string) *bytes.Reader {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		assert.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestParseCSV(t *testing.T) {
+	t.Parallel()
+
+	content := "region;quarter;total\n\nNorth;Q3;\"1;5\"\nSouth;Q3;7\n"
+	sheets, err := NewCSV().ParseSheets(strings.NewReader(content))
+	assert.NoError(t, err)
+	assert.Equal(t, []document.Sheet{{
+		Columns: []string{"region", "quarter", "total"},
+		Rows: []document.Row{
+			{Number: 3, Cells: []string{"North", "Q3", "1;5"}},
+			{Number: 4, Cells: []string{"South", "Q3", "7"}},
+		},
+	}}, sheets)
+}
+
+const (
+	workbook = `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+ xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Отчет" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	workbookRels = `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="worksheet" Target="worksheets/sheet1.xml"/></Relationships>`
+	sharedStringsXML = `<sst><si><t>Регион</t></si><si><t>Продажи</t></si><si><r><t>Q</t></r><r><t>3</t></r></si><si><t>Q4</t></si><si><t>Север</t></si></sst>`
+	stylesXML        = `<styleSheet><cellXfs count="2"><xf numFmtId="0"/><xf numFmtId="14"/></cellXfs></styleSheet>`
+	sheetXML         = `<worksheet><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>
+<row r="2"><c r="B2" t="s"><v>2</v></c><c r="C2" t="s"><v>3</v></c><c r="D2"><v>0</v></c></row>
+<row r="3"><c r="A3" t="s"><v>4</v></c><c r="B3"><v>10.5</v></c><c r="C3" t="inlineStr"><is><t>n/a</t></is></c><c r="D3" s="1"><v>45658</v></c></row>
+</sheetData><mergeCells><mergeCell ref="A1:A2"/><mergeCell ref="B1:C1"/></mergeCells></worksheet>`
+)
+
+func TestParseXLSX(t *testing.T) {
+	t.Parallel()
+
+	reader := buildArchive(t, map[string]string{
+		"xl/workbook.xml":            workbook,
+		"xl/_rels/workbook.xml.rels": workbookRels,
+		"xl/sharedStrings.xml":       sharedStringsXML,
+		"xl/styles.xml":              stylesXML,
+		"xl/worksheets/sheet1.xml":   sheetXML,
+	})
+	sheets, err := NewXLSX().ParseSheets(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, []document.Sheet{{
+		Name:    "Отчет",
+		Columns: []string{"Регион", "Продажи / Q3", "Продажи / Q4", "0"},
+		Rows:    []document.Row{{Number: 3, Cells: []string{"Север", "10.5", "n/a", "2025-01-01"}}},
+	}}, sheets)
+}
+
+const contentXML = `<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0"
+ xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0"
+ xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0"><office:body><office:spreadsheet>
+<table:table table:name="Лист1">
+<table:table-row><table:table-cell><text:p>name</text:p></table:table-cell><table:table-cell office:value-type="string"><text:p>value</text:p></table:table-cell><table:table-cell table:number-columns-repeated="16000"/></table:table-row>
+<table:table-row table:number-rows-repeated="2"><table:table-cell table:number-columns-repeated="1024"/></table:table-row>
+<table:table-row><table:table-cell><text:p>a</text:p></table:table-cell><table:table-cell office:value-type="float" office:value="1234.5"><text:p>1 234,50</text:p></table:table-cell></table:table-row>
+<table:table-row table:number-rows-repeated="1048570"><table:table-cell table:number-columns-repeated="1024"/></table:table-row>
+</table:table></office:spreadsheet></office:body></office:document-content>`
+
+func TestParseODS(t *testing.T) {
+	t.Parallel()
+
+	text, err := NewODS().Parse(buildArchive(t, map[string]string{contentPart: contentXML}))
+	assert.NoError(t, err)
+	assert.Equal(t, "# Лист1\n\n| name | value |\n| a | 1234.5 |", text)
+
+	sheets, err := NewODS().ParseSheets(buildArchive(t, map[string]string{contentPart: contentXML}))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, sheets[0].Rows[0].Number)
+}
@@ -0,0 +1,145 @@
+package spreadsheet
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+)
+
+const (
+	// maxRepeat ограничение на размножение повторяющихся строк и ячеек
+	maxRepeat = 1000
+	// maxMergeCells ограничение на количество ячеек, заполняемых значением объединенной ячейки
+	maxMergeCells = 10000
+	// headerSeparator разделитель уровней многострочного заголовка столбца
+	headerSeparator = " / "
+)
+
+// mergeRange объединенные ячейки: строки с номерами top..bottom, столбцы с индексами left..right
+type mergeRange struct {
+	top, left, bottom, right int
+}
+
+// grid ячейки листа до выделения заголовка
+type grid struct {
+	name   string
+	rows   map[int][]string // номер строки, начиная с 1 -> значения ячеек
+	merges []mergeRange
+}
+
+func newGrid(name string) *grid {
+	return &grid{name: name, rows: make(map[int][]string)}
+}
+
+func (g *grid) set(row, col int, value string) {
+	value = strings.TrimSpace(value)
+	if value == "" || row < 1 || col < 0 {
+		return
+	}
+	cells := g.rows[row]
+	for len(cells) <= col {
+		cells = append(cells, "")
+	}
+	cells[col] = value
+	g.rows[row] = cells
+}
+
+func (g *grid) get(row, col int) string {
+	cells := g.rows[row]
+	if col < len(cells) {
+		return cells[col]
+	}
+	return ""
+}
+
+// sheet выделяет заголовок и строки данных. Заголовок начинается с первой непустой строки;
+// если ячейки этой строки объединены по горизонтали или вертикали, заголовок продолжается
+// до последней строки объединения или до следующей строки с подзаголовками.
+func (g *grid) sheet() (document.Sheet, bool) {
+	var headerMerges []mergeRange
+	first := g.firstRow()
+	for _, m := range g.merges {
+		if m.top == first {
+			headerMerges = append(headerMerges, m)
+		}
+	}
+	g.fillMerges()
+
+	numbers := make([]int, 0, len(g.rows))
+	width := 0
+	for n, cells := range g.rows {
+		numbers = append(numbers, n)
+		width = max(width, len(cells))
+	}
+	if len(numbers) == 0 {
+		return document.Sheet{}, false
+	}
+	sort.Ints(numbers)
+
+	headerEnd := numbers[0]
+	for _, m := range headerMerges {
+		headerEnd = max(headerEnd, m.bottom)
+		if m.right > m.left && len(numbers) > 1 {
+			headerEnd = max(headerEnd, numbers[1])
+		}
+	}
+
+	columns := make([]string, width)
+	for c := range columns {
+		var parts []string
+		for _, n := range numbers {
+			if n > headerEnd {
+				break
+			}
+			value := g.get(n, c)
+			if value != "" && (len(parts) == 0 || parts[len(parts)-1] != value) {
+				parts = append(parts, value)
+			}
+		}
+		columns[c] = strings.Join(parts, headerSeparator)
+		if columns[c] == "" {
+			columns[c] = fmt.Sprintf("column %d", c+1)
+		}
+	}
+
+	sheet := document.Sheet{Name: g.name, Columns: columns}
+	for _, n := range numbers {
+		if n <= headerEnd {
+			continue
+		}
+		cells := make([]string, width)
+		copy(cells, g.rows[n])
+		sheet.Rows = append(sheet.Rows, document.Row{Number: n, Cells: cells})
+	}
+	return sheet, true
+}
+
+func (g *grid) firstRow() int {
+	first := 0
+	for n := range g.rows {
+		if first == 0 || n < first {
+			first = n
+		}
+	}
+	return first
+}
+
+// fillMerges записывает значение объединенной ячейки во все ячейки объединения
+func (g *grid) fillMerges() {
+	for _, m := range g.merges {
+		if (m.bottom-m.top+1)*(m.right-m.left+1) > maxMergeCells {
+			continue
+		}
+		value := g.get(m.top, m.left)
+		if value == "" {
+			continue
+		}
+		for r := m.top; r <= m.bottom; r++ {
+			for c := m.left; c <= m.right; c++ {
+				g.set(r, c, value)
+			}
+		}
+	}
+}
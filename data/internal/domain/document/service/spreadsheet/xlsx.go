@@ -0,0 +1,231 @@
+package spreadsheet
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document/service/office"
+)
+
+const workbookPart = "xl/workbook.xml"
+
+var (
+	cellRef = regexp.MustCompile(`^\$?([A-Za-z]+)\$?(\d+)$`)
+	// formatLiterals строки в кавычках, экранированные символы и условия в квадратных скобках формата числа
+	formatLiterals = regexp.MustCompile(`"[^"]*"|\\.|\[[^\]]*\]`)
+)
+
+var (
+	excelEpoch     = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+	excelEpoch1904 = time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func readXLSX(reader io.ReadSeeker) ([]grid, error) {
+	archive, err := office.Open(reader)
+	if err != nil {
+		return nil, err
+	}
+	workbook, err := archive.ReadXML(workbookPart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read xlsx: %w", err)
+	}
+	w := xlsxWorkbook{
+		strings:   sharedStrings(archive),
+		dateStyle: dateStyles(archive),
+		epoch:     excelEpoch,
+	}
+	if props := workbook.Find("workbookPr"); props != nil && isTrue(props.Attr("date1904")) {
+		w.epoch = excelEpoch1904
+	}
+
+	rels := archive.Relationships(workbookPart)
+	var grids []grid
+	for _, sheet := range workbook.FindAll("sheet") {
+		part, ok := rels[sheet.Attr("id")]
+		if !ok {
+			continue
+		}
+		root, err := archive.ReadXML(part)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read xlsx sheet %s: %w", sheet.Attr("name"), err)
+		}
+		grids = append(grids, *w.sheet(sheet.Attr("name"), root))
+	}
+	return grids, nil
+}
+
+type xlsxWorkbook struct {
+	strings   []string
+	dateStyle map[int]bool
+	epoch     time.Time
+}
+
+func (w xlsxWorkbook) sheet(name string, root *office.Node) *grid {
+	g := newGrid(name)
+	if data := root.Find("sheetData"); data != nil {
+		rowNumber := 0
+		for _, row := range data.FindAll("row") {
+			if n, err := strconv.Atoi(row.Attr("r")); err == nil {
+				rowNumber = n
+			} else {
+				rowNumber++
+			}
+			col := -1
+			for _, c := range row.FindAll("c") {
+				if _, refCol, ok := parseRef(c.Attr("r")); ok {
+					col = refCol
+				} else {
+					col++
+				}
+				g.set(rowNumber, col, w.value(c))
+			}
+		}
+	}
+	for _, merge := range root.FindAll("mergeCell") {
+		from, to, _ := strings.Cut(merge.Attr("ref"), ":")
+		top, left, ok1 := parseRef(from)
+		bottom, right, ok2 := parseRef(to)
+		if ok1 && ok2 {
+			g.merges = append(g.merges, mergeRange{top: top, left: left, bottom: bottom, right: right})
+		}
+	}
+	return g
+}
+
+// value возвращает значение ячейки с учетом ее типа, даты приводятся к ISO формату
+func (w xlsxWorkbook) value(c *office.Node) string {
+	v := ""
+	if node := c.Child("v"); node != nil {
+		v = node.InnerText()
+	}
+	switch c.Attr("t") {
+	case "s":
+		idx, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil || idx < 0 || idx >= len(w.strings) {
+			return ""
+		}
+		return w.strings[idx]
+	case "inlineStr":
+		if is := c.Child("is"); is != nil {
+			return richText(is)
+		}
+		return ""
+	case "b":
+		if isTrue(v) {
+			return "TRUE"
+		}
+		return "FALSE"
+	case "str", "e":
+		return v
+	}
+	style, _ := strconv.Atoi(c.Attr("s"))
+	if w.dateStyle[style] {
+		if serial, err := strconv.ParseFloat(v, 64); err == nil {
+			return w.date(serial)
+		}
+	}
+	return v
+}
+
+func (w xlsxWorkbook) date(serial float64) string {
+	days := math.Floor(serial)
+	seconds := math.Round((serial - days) * 24 * 60 * 60)
+	t := w.epoch.AddDate(0, 0, int(days)).Add(time.Duration(seconds) * time.Second)
+	if seconds == 0 {
+		return t.Format(time.DateOnly)
+	}
+	return t.Format(time.DateTime)
+}
+
+// sharedStrings возвращает таблицу общих строк книги
+func sharedStrings(archive *office.Archive) []string {
+	root, err := archive.ReadXML("xl/sharedStrings.xml")
+	if err != nil {
+		return nil
+	}
+	items := root.FindAll("si")
+	res := make([]string, len(items))
+	for i, si := range items {
+		res[i] = richText(si)
+	}
+	return res
+}
+
+// richText собирает текст строки, пропуская фонетические подсказки
+func richText(n *office.Node) string {
+	var sb strings.Builder
+	for _, c := range n.Children {
+		switch c.Name {
+		case "t":
+			sb.WriteString(c.InnerText())
+		case "r":
+			if t := c.Child("t"); t != nil {
+				sb.WriteString(t.InnerText())
+			}
+		}
+	}
+	return sb.String()
+}
+
+// dateStyles возвращает индексы стилей ячеек, формат которых - дата или время
+func dateStyles(archive *office.Archive) map[int]bool {
+	res := make(map[int]bool)
+	root, err := archive.ReadXML("xl/styles.xml")
+	if err != nil {
+		return res
+	}
+	formats := make(map[int]string)
+	for _, f := range root.FindAll("numFmt") {
+		if id, err := strconv.Atoi(f.Attr("numFmtId")); err == nil {
+			formats[id] = f.Attr("formatCode")
+		}
+	}
+	xfs := root.Find("cellXfs")
+	if xfs == nil {
+		return res
+	}
+	for i, xf := range xfs.FindAll("xf") {
+		id, err := strconv.Atoi(xf.Attr("numFmtId"))
+		if err != nil {
+			continue
+		}
+		code, custom := formats[id]
+		res[i] = custom && isDateFormat(code) || !custom && isBuiltinDateFormat(id)
+	}
+	return res
+}
+
+func isBuiltinDateFormat(id int) bool {
+	return id >= 14 && id <= 22 || id >= 27 && id <= 36 || id >= 45 && id <= 47 || id >= 50 && id <= 58
+}
+
+func isDateFormat(code string) bool {
+	code = strings.ToLower(formatLiterals.ReplaceAllString(code, ""))
+	return strings.ContainsAny(code, "ydh") || strings.Contains(code, "ss")
+}
+
+// parseRef разбирает ссылку на ячейку вида B12 в номер строки и индекс столбца
+func parseRef(ref string) (int, int, bool) {
+	m := cellRef.FindStringSubmatch(ref)
+	if m == nil {
+		return 0, 0, false
+	}
+	col := 0
+	for _, c := range strings.ToUpper(m[1]) {
+		col = col*26 + int(c-'A'+1)
+	}
+	row, err := strconv.Atoi(m[2])
+	if err != nil {
+		return 0, 0, false
+	}
+	return row, col - 1, true
+}
+
+func isTrue(v string) bool {
+	return v == "1" || strings.EqualFold(v, "true")
+}
@@ -0,0 +1,50 @@
+package txt
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
+)
+
+type Service struct{}
+
+func New() *Service {
+	return &Service{}
+}
+
+// Parse возвращает текст файла в utf-8. Файлы с BOM декодируются по нему,
+// файлы с некорректным utf-8 считаются записанными в windows-1251.
+func (s Service) Parse(reader io.ReadSeeker) (string, error) {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to read content: %w", err)
+	}
+	text, err := Decode(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode text: %w", err)
+	}
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+	return strings.TrimSpace(text), nil
+}
+
+// Decode приводит текст к utf-8 по BOM, при некорректном utf-8 текст считается записанным в windows-1251
+func Decode(content []byte) (string, error) {
+	switch {
+	case bytes.HasPrefix(content, []byte{0xEF, 0xBB, 0xBF}):
+		return string(content[3:]), nil
+	case bytes.HasPrefix(content, []byte{0xFF, 0xFE}), bytes.HasPrefix(content, []byte{0xFE, 0xFF}):
+		decoded, err := unicode.UTF16(unicode.LittleEndian, unicode.ExpectBOM).NewDecoder().Bytes(content)
+		return string(decoded), err
+	case utf8.Valid(content):
+		return string(content), nil
+	default:
+		decoded, err := charmap.Windows1251.NewDecoder().Bytes(content)
+		return string(decoded), err
+	}
+}
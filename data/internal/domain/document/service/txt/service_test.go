@@ -0,0 +1,31 @@
+package txt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content []byte
+		want    string
+	}{
+		{name: "utf8", content: []byte("Привет\r\n\r\nмир\n"), want: "Привет\n\nмир"},
+		{name: "bom", content: append([]byte{0xEF, 0xBB, 0xBF}, []byte("текст")...), want: "текст"},
+		{name: "utf16", content: []byte{0xFF, 0xFE, 'o', 0, 'k', 0}, want: "ok"},
+		{name: "windows-1251", content: []byte{0xcf, 0xf0, 0xe8, 0xe2, 0xe5, 0xf2}, want: "Привет"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := New().Parse(bytes.NewReader(tt.content))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
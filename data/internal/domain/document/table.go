@@ -0,0 +1,41 @@
+package document
+
+import (
+	"strings"
+)
+
+// Sheet лист табличного документа (csv, xlsx, ods)
+type Sheet struct {
+	Name    string   // название листа
+	Columns []string // заголовки столбцов, объединенные ячейки заголовка раскрываются через " / "
+	Rows    []Row    // строки данных без заголовка
+}
+
+// Row строка данных листа
+type Row struct {
+	Number int      // номер строки в исходном файле, начиная с 1
+	Cells  []string // значения ячеек в порядке столбцов листа
+}
+
+// RenderRow записывает строку таблицы в формате markdown
+func RenderRow(cells []string) string {
+	escaped := make([]string, len(cells))
+	for i, cell := range cells {
+		escaped[i] = strings.ReplaceAll(strings.Join(strings.Fields(cell), " "), "|", "/")
+	}
+	return "| " + strings.Join(escaped, " | ") + " |"
+}
+
+// Render записывает лист в формате markdown: название листа заголовком, затем таблица
+func (s Sheet) Render() string {
+	lines := make([]string, 0, len(s.Rows)+1)
+	lines = append(lines, RenderRow(s.Columns))
+	for _, row := range s.Rows {
+		lines = append(lines, RenderRow(row.Cells))
+	}
+	table := strings.Join(lines, "\n")
+	if s.Name == "" {
+		return table
+	}
+	return "# " + s.Name + "\n\n" + table
+}
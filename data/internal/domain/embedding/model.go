@@ -0,0 +1,100 @@
+package embedding
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrModelNotFound       = errors.New("embedding model not found")                 // модель отсутствует в реестре
+	ErrNoActiveModel       = errors.New("no active embedding model")                 // поиск не переключен ни на одну модель
+	ErrModelNotConfigured  = errors.New("embedding model is not configured")         // для модели не задан эмбеддер
+	ErrDimensionsMismatch  = errors.New("embedding model dimensions mismatch")       // модель уже зарегистрирована с другой размерностью
+	ErrCoverageIncomplete  = errors.New("embedding model does not cover all chunks") // не все чанки и вопросы получили векторы модели
+	ErrUnsupportedIndex    = errors.New("unsupported vector index type")             // неизвестный тип индекса
+	ErrIndexDimensionLimit = errors.New("too many dimensions for vector index")      // pgvector индексирует не более MaxIndexDimensions
+)
+
+// MaxIndexDimensions максимальная размерность vector, для которой pgvector строит ivfflat и hnsw индексы
+const MaxIndexDimensions = 2000
+
+// IndexType тип векторного индекса таблиц модели
+type IndexType string
+
+const (
+	IndexIVFFlat IndexType = "ivfflat" // быстрое построение, качество зависит от данных на момент создания
+	IndexHNSW    IndexType = "hnsw"    // граф, строится дольше, лучше качество и не требует переобучения
+)
+
+// ParseIndexType возвращает тип индекса по названию, пустое название - ivfflat
+func ParseIndexType(name string) (IndexType, error) {
+	switch IndexType(name) {
+	case "", IndexIVFFlat:
+		return IndexIVFFlat, nil
+	case IndexHNSW:
+		return IndexHNSW, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedIndex, name)
+	}
+}
+
+// Model модель эмбеддингов в реестре, векторы чанков и вопросов модели хранятся в собственных таблицах
+type Model struct {
+	ID          int        `db:"id"`
+	Name        string     `db:"name"`         // название модели на сервере моделей
+	Version     string     `db:"version"`      // версия, меняется при изменении модели без смены названия
+	Dimensions  int        `db:"dimensions"`   // размерность векторов
+	IndexType   IndexType  `db:"index_type"`   // тип векторного индекса
+	Active      bool       `db:"active"`       // используется ли модель в VectorSearch
+	CreatedAt   time.Time  `db:"created_at"`   // время регистрации
+	ActivatedAt *time.Time `db:"activated_at"` // время переключения поиска на модель
+}
+
+// Key название модели с версией, записывается в чанки и вопросы
+func (m Model) Key() string {
+	return ModelKey(m.Name, m.Version)
+}
+
+// ChunkTable таблица векторов чанков модели в схеме embeddings
+func (m Model) ChunkTable() string {
+	return fmt.Sprintf("chunks_%d", m.ID)
+}
+
+// QuestionTable таблица векторов гипотетических вопросов модели в схеме embeddings
+func (m Model) QuestionTable() string {
+	return fmt.Sprintf("questions_%d", m.ID)
+}
+
+// ModelKey объединяет название модели и версию, без версии возвращает название
+func ModelKey(name, version string) string {
+	if version == "" {
+		return name
+	}
+	return name + "@" + version
+}
+
+// Coverage количество чанков и вопросов и сколько из них имеют векторы модели
+type Coverage struct {
+	Chunks            int `db:"chunks"`
+	EmbeddedChunks    int `db:"embedded_chunks"`
+	Questions         int `db:"questions"`
+	EmbeddedQuestions int `db:"embedded_questions"`
+}
+
+// Complete возвращает true, если у всех чанков и вопросов есть векторы модели
+func (c Coverage) Complete() bool {
+	return c.EmbeddedChunks >= c.Chunks && c.EmbeddedQuestions >= c.Questions
+}
+
+// Vector вектор чанка или вопроса с его идентификатором
+type Vector struct {
+	ID         string
+	Embeddings []float32
+}
+
+// Text текст чанка или вопроса без вектора модели
+type Text struct {
+	ID      string `db:"id"`
+	Content string `db:"content"`
+}
@@ -0,0 +1,25 @@
+package embedding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIndexType(t *testing.T) {
+	index, err := ParseIndexType("")
+	assert.NoError(t, err)
+	assert.Equal(t, IndexIVFFlat, index)
+
+	index, err = ParseIndexType("hnsw")
+	assert.NoError(t, err)
+	assert.Equal(t, IndexHNSW, index)
+
+	_, err = ParseIndexType("flat")
+	assert.ErrorIs(t, err, ErrUnsupportedIndex)
+}
+
+func TestModelKey(t *testing.T) {
+	assert.Equal(t, "bge-m3:latest", ModelKey("bge-m3:latest", ""))
+	assert.Equal(t, "bge-m3:latest@2", Model{Name: "bge-m3:latest", Version: "2"}.Key())
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+
+	"github.com/larek-tech/diploma/data/internal/domain/embedding"
+)
+
+type (
+	// Embedder сервер моделей, считающий векторы текстов
+	Embedder interface {
+		CreateEmbedding(ctx context.Context, inputTexts []string) ([][]float32, error)
+		EmbeddingsModel() string
+	}
+	cache interface {
+		Get(ctx context.Context, model string, hashes []string) (map[string][]float32, error)
+		Save(ctx context.Context, model string, embeddings map[string][]float32) error
+	}
+	modelStorage interface {
+		Register(ctx context.Context, model *embedding.Model) (*embedding.Model, error)
+		GetActive(ctx context.Context) (*embedding.Model, error)
+		SaveChunkVectors(ctx context.Context, model *embedding.Model, vectors []embedding.Vector) error
+		SaveQuestionVectors(ctx context.Context, model *embedding.Model, vectors []embedding.Vector) error
+	}
+)
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+	"github.com/larek-tech/diploma/data/internal/domain/embedding"
+	"github.com/larek-tech/diploma/data/internal/domain/question"
+)
+
+// probeText текст, по вектору которого определяется размерность модели
+const probeText = "probe"
+
+type registered struct {
+	model    *embedding.Model
+	embedder Embedder
+}
+
+// Registry модели эмбеддингов, для которых в процессе настроен эмбеддер. Первая зарегистрированная
+// модель основная, ею считаются векторы при обработке документов. Векторы остальных моделей
+// записываются вместе с основными, чтобы поиск можно было переключить на новую модель.
+type Registry struct {
+	models  modelStorage
+	entries []registered
+}
+
+func NewRegistry(models modelStorage) *Registry {
+	return &Registry{models: models}
+}
+
+// Register добавляет модель эмбеддера в реестр, размерность векторов определяется пробным запросом.
+func (r *Registry) Register(ctx context.Context, name, version string, index embedding.IndexType, embedder Embedder) (*embedding.Model, error) {
+	probe, err := embedder.CreateEmbedding(ctx, []string{probeText})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get embedding dimensions of %s: %w", embedding.ModelKey(name, version), err)
+	}
+	if len(probe) == 0 || len(probe[0]) == 0 {
+		return nil, fmt.Errorf("got empty embedding from %s", embedding.ModelKey(name, version))
+	}
+	model, err := r.models.Register(ctx, &embedding.Model{
+		Name:       name,
+		Version:    version,
+		Dimensions: len(probe[0]),
+		IndexType:  index,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to register embedding model: %w", err)
+	}
+	for _, e := range r.entries {
+		if e.model.ID == model.ID {
+			return nil, fmt.Errorf("embedding model %s is already configured", model.Key())
+		}
+	}
+	r.entries = append(r.entries, registered{model: model, embedder: embedder})
+	return model, nil
+}
+
+// Primary возвращает основную модель и ее эмбеддер.
+func (r *Registry) Primary() (*embedding.Model, Embedder) {
+	if len(r.entries) == 0 {
+		return nil, nil
+	}
+	return r.entries[0].model, r.entries[0].embedder
+}
+
+// Models возвращает настроенные модели, основная - первая.
+func (r *Registry) Models() []*embedding.Model {
+	models := make([]*embedding.Model, 0, len(r.entries))
+	for _, e := range r.entries {
+		models = append(models, e.model)
+	}
+	return models
+}
+
+// Embedder возвращает эмбеддер модели, false - если модель в процессе не настроена.
+func (r *Registry) Embedder(modelID int) (Embedder, bool) {
+	for _, e := range r.entries {
+		if e.model.ID == modelID {
+			return e.embedder, true
+		}
+	}
+	return nil, false
+}
+
+// Active возвращает модель, на которую переключен поиск, и ее эмбеддер. Если эмбеддер активной модели
+// в процессе не настроен, возвращается ErrModelNotConfigured: векторы запроса другой моделью несравнимы с индексом.
+func (r *Registry) Active(ctx context.Context) (*embedding.Model, Embedder, error) {
+	model, err := r.models.GetActive(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	e, ok := r.Embedder(model.ID)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %s", embedding.ErrModelNotConfigured, model.Key())
+	}
+	return model, e, nil
+}
+
+// Embed считает векторы текстов моделью modelID.
+func (r *Registry) Embed(ctx context.Context, modelID int, texts []embedding.Text) ([]embedding.Vector, error) {
+	e, ok := r.Embedder(modelID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", embedding.ErrModelNotConfigured, modelID)
+	}
+	if len(texts) == 0 {
+		return nil, nil
+	}
+	contents := make([]string, 0, len(texts))
+	for _, t := range texts {
+		contents = append(contents, t.Content)
+	}
+	embeddings, err := e.CreateEmbedding(ctx, contents)
+	if err != nil {
+		return nil, err
+	}
+	if len(embeddings) != len(texts) {
+		return nil, fmt.Errorf("got %d embeddings for %d texts", len(embeddings), len(texts))
+	}
+	vectors := make([]embedding.Vector, 0, len(texts))
+	for i, t := range texts {
+		vectors = append(vectors, embedding.Vector{ID: t.ID, Embeddings: embeddings[i]})
+	}
+	return vectors, nil
+}
+
+// SaveVectors сохраняет векторы чанков и вопросов во все настроенные модели. Векторы основной модели
+// уже посчитаны при обработке документа, для остальных моделей они считаются по текстам.
+func (r *Registry) SaveVectors(ctx context.Context, chunks []*document.Chunk, questions []*question.Questions) error {
+	for i, e := range r.entries {
+		var chunkVectors, questionVectors []embedding.Vector
+		if i == 0 {
+			for _, c := range chunks {
+				chunkVectors = append(chunkVectors, embedding.Vector{ID: c.ID, Embeddings: c.Embeddings})
+			}
+			for _, q := range questions {
+				questionVectors = append(questionVectors, embedding.Vector{ID: q.ID, Embeddings: q.Embeddings})
+			}
+		} else {
+			chunkTexts := make([]embedding.Text, 0, len(chunks))
+			for _, c := range chunks {
+				chunkTexts = append(chunkTexts, embedding.Text{ID: c.ID, Content: c.Content})
+			}
+			questionTexts := make([]embedding.Text, 0, len(questions))
+			for _, q := range questions {
+				questionTexts = append(questionTexts, embedding.Text{ID: q.ID, Content: q.Question})
+			}
+			var err error
+			if chunkVectors, err = r.Embed(ctx, e.model.ID, chunkTexts); err != nil {
+				return fmt.Errorf("failed to embed chunks with %s: %w", e.model.Key(), err)
+			}
+			if questionVectors, err = r.Embed(ctx, e.model.ID, questionTexts); err != nil {
+				return fmt.Errorf("failed to embed questions with %s: %w", e.model.Key(), err)
+			}
+		}
+		if err := r.models.SaveChunkVectors(ctx, e.model, chunkVectors); err != nil {
+			return err
+		}
+		if err := r.models.SaveQuestionVectors(ctx, e.model, questionVectors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+	"github.com/larek-tech/diploma/data/internal/domain/embedding"
+	"github.com/larek-tech/diploma/data/internal/domain/question"
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryModels struct {
+	models    []*embedding.Model
+	active    int
+	chunks    map[int][]embedding.Vector
+	questions map[int][]embedding.Vector
+}
+
+func (m *memoryModels) Register(_ context.Context, model *embedding.Model) (*embedding.Model, error) {
+	for _, registered := range m.models {
+		if registered.Key() == model.Key() {
+			return registered, nil
+		}
+	}
+	model.ID = len(m.models) + 1
+	model.Active = m.active == 0
+	if model.Active {
+		m.active = model.ID
+	}
+	m.models = append(m.models, model)
+	return model, nil
+}
+
+func (m *memoryModels) GetActive(_ context.Context) (*embedding.Model, error) {
+	if m.active == 0 {
+		return nil, embedding.ErrNoActiveModel
+	}
+	return m.models[m.active-1], nil
+}
+
+func (m *memoryModels) SaveChunkVectors(_ context.Context, model *embedding.Model, vectors []embedding.Vector) error {
+	m.chunks[model.ID] = append(m.chunks[model.ID], vectors...)
+	return nil
+}
+
+func (m *memoryModels) SaveQuestionVectors(_ context.Context, model *embedding.Model, vectors []embedding.Vector) error {
+	m.questions[model.ID] = append(m.questions[model.ID], vectors...)
+	return nil
+}
+
+func TestRegistrySaveVectors(t *testing.T) {
+	ctx := context.Background()
+	models := &memoryModels{chunks: map[int][]embedding.Vector{}, questions: map[int][]embedding.Vector{}}
+	r := NewRegistry(models)
+
+	primary, err := r.Register(ctx, "bge-m3", "", embedding.IndexIVFFlat, &fakeEmbedder{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, primary.Dimensions)
+	next, err := r.Register(ctx, "e5", "2", embedding.IndexHNSW, &fakeEmbedder{})
+	assert.NoError(t, err)
+	assert.False(t, next.Active)
+
+	err = r.SaveVectors(ctx,
+		[]*document.Chunk{{ID: "c1", Content: "chunk", Embeddings: []float32{42}}},
+		[]*question.Questions{{ID: "q1", Question: "why?", Embeddings: []float32{7}}},
+	)
+	assert.NoError(t, err)
+	// векторы основной модели уже посчитаны, следующая модель считает их по текстам
+	assert.Equal(t, []embedding.Vector{{ID: "c1", Embeddings: []float32{42}}}, models.chunks[primary.ID])
+	assert.Equal(t, []embedding.Vector{{ID: "c1", Embeddings: []float32{5}}}, models.chunks[next.ID])
+	assert.Equal(t, []embedding.Vector{{ID: "q1", Embeddings: []float32{4}}}, models.questions[next.ID])
+}
+
+func TestRegistryActiveNotConfigured(t *testing.T) {
+	ctx := context.Background()
+	models := &memoryModels{}
+	_, err := models.Register(ctx, &embedding.Model{Name: "bge-m3", Dimensions: 1024})
+	assert.NoError(t, err)
+
+	r := NewRegistry(models)
+	_, err = r.Register(ctx, "e5", "", embedding.IndexHNSW, &fakeEmbedder{})
+	assert.NoError(t, err)
+
+	_, _, err = r.Active(ctx)
+	assert.ErrorIs(t, err, embedding.ErrModelNotConfigured)
+}
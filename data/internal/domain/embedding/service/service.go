@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+
+	"github.com/larek-tech/diploma/data/internal/domain/embedding"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Service эмбеддер с кэшем по (модель, размерность, хэш текста): одинаковые тексты, например шапки страниц
+// или повторяющиеся пункты документов, отправляются в модель только один раз.
+type Service struct {
+	embedder Embedder
+	cache    cache
+	model    string
+	cacheKey string
+	tracer   trace.Tracer
+}
+
+// New создает эмбеддер с кэшем, version отличает векторы модели, обновленной без смены названия,
+// dimensions - векторы одной модели, запрошенные с разной размерностью (0 - размерность модели по умолчанию).
+func New(embedder Embedder, cache cache, version string, dimensions int, tracer trace.Tracer) *Service {
+	model := embedding.ModelKey(embedder.EmbeddingsModel(), version)
+	return &Service{
+		embedder: embedder,
+		cache:    cache,
+		model:    model,
+		cacheKey: fmt.Sprintf("%s/%d", model, dimensions),
+		tracer:   tracer,
+	}
+}
+
+// EmbeddingsModel возвращает название модели эмбеддингов с версией.
+func (s Service) EmbeddingsModel() string {
+	return s.model
+}
+
+func (s Service) CreateEmbedding(ctx context.Context, inputTexts []string) ([][]float32, error) {
+	ctx, span := s.tracer.Start(ctx, "embeddingService.CreateEmbedding", trace.WithAttributes(
+		attribute.String("model", s.model),
+		attribute.Int("texts", len(inputTexts)),
+	))
+	defer span.End()
+
+	hashes := make([]string, len(inputTexts))
+	unique := make([]string, 0, len(inputTexts))
+	seen := make(map[string]struct{}, len(inputTexts))
+	for i, text := range inputTexts {
+		hashes[i] = contentHash(text)
+		if _, ok := seen[hashes[i]]; !ok {
+			seen[hashes[i]] = struct{}{}
+			unique = append(unique, hashes[i])
+		}
+	}
+
+	cached, err := s.cache.Get(ctx, s.cacheKey, unique)
+	if err != nil {
+		// без кэша эмбеддинги все равно можно посчитать
+		slog.Warn("failed to get cached embeddings", "error", err)
+		span.RecordError(err)
+		cached = make(map[string][]float32)
+	}
+
+	var (
+		missingTexts  []string
+		missingHashes []string
+	)
+	for i, hash := range hashes {
+		if _, ok := cached[hash]; ok {
+			continue
+		}
+		cached[hash] = nil
+		missingTexts = append(missingTexts, inputTexts[i])
+		missingHashes = append(missingHashes, hash)
+	}
+	span.SetAttributes(attribute.Int("cacheMisses", len(missingTexts)))
+
+	if len(missingTexts) > 0 {
+		embeddings, err := s.embedder.CreateEmbedding(ctx, missingTexts)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		if len(embeddings) != len(missingTexts) {
+			return nil, fmt.Errorf("got %d embeddings for %d texts", len(embeddings), len(missingTexts))
+		}
+		created := make(map[string][]float32, len(embeddings))
+		for i, hash := range missingHashes {
+			cached[hash] = embeddings[i]
+			created[hash] = embeddings[i]
+		}
+		if err = s.cache.Save(ctx, s.cacheKey, created); err != nil {
+			slog.Warn("failed to save embeddings to cache", "error", err)
+			span.RecordError(err)
+		}
+	}
+
+	res := make([][]float32, len(inputTexts))
+	for i, hash := range hashes {
+		res[i] = cached[hash]
+	}
+	return res, nil
+}
+
+func contentHash(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
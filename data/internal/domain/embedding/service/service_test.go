@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type fakeEmbedder struct {
+	calls [][]string
+}
+
+func (e *fakeEmbedder) CreateEmbedding(_ context.Context, inputTexts []string) ([][]float32, error) {
+	e.calls = append(e.calls, inputTexts)
+	res := make([][]float32, len(inputTexts))
+	for i, text := range inputTexts {
+		res[i] = []float32{float32(len(text))}
+	}
+	return res, nil
+}
+
+func (e *fakeEmbedder) EmbeddingsModel() string {
+	return "bge-m3"
+}
+
+type memoryCache map[string][]float32
+
+func (c memoryCache) Get(_ context.Context, model string, hashes []string) (map[string][]float32, error) {
+	res := make(map[string][]float32)
+	for _, hash := range hashes {
+		if e, ok := c[model+hash]; ok {
+			res[hash] = e
+		}
+	}
+	return res, nil
+}
+
+func (c memoryCache) Save(_ context.Context, model string, embeddings map[string][]float32) error {
+	for hash, e := range embeddings {
+		c[model+hash] = e
+	}
+	return nil
+}
+
+func TestCreateEmbedding(t *testing.T) {
+	t.Parallel()
+
+	e := &fakeEmbedder{}
+	s := New(e, memoryCache{}, "", 0, noop.NewTracerProvider().Tracer(""))
+
+	res, err := s.CreateEmbedding(context.Background(), []string{"header", "text", "header"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]float32{{6}, {4}, {6}}, res)
+	assert.Equal(t, [][]string{{"header", "text"}}, e.calls)
+
+	res, err = s.CreateEmbedding(context.Background(), []string{"footer", "header"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]float32{{6}, {6}}, res)
+	assert.Equal(t, []string{"footer"}, e.calls[1])
+}
+
+func TestCreateEmbeddingCacheByDimensions(t *testing.T) {
+	t.Parallel()
+
+	cache := memoryCache{}
+	e := &fakeEmbedder{}
+	_, err := New(e, cache, "", 1024, noop.NewTracerProvider().Tracer("")).CreateEmbedding(context.Background(), []string{"text"})
+	assert.NoError(t, err)
+	_, err = New(e, cache, "", 512, noop.NewTracerProvider().Tracer("")).CreateEmbedding(context.Background(), []string{"text"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"text"}, {"text"}}, e.calls)
+}
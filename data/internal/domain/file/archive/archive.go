@@ -0,0 +1,320 @@
+package archive
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// Format формат архива
+type Format string
+
+const (
+	Undefined Format = ""
+	Zip       Format = "zip"
+	Tar       Format = "tar"
+	Gzip      Format = "gzip"
+)
+
+// ErrUnsupportedFormat содержимое не является архивом поддерживаемого формата
+var ErrUnsupportedFormat = errors.New("unsupported archive format")
+
+// Reason причина, по которой файл из архива не был принят
+type Reason string
+
+const (
+	ReasonUnsafePath     Reason = "unsafe path"
+	ReasonNotRegular     Reason = "not a regular file"
+	ReasonFileTooLarge   Reason = "file size limit exceeded"
+	ReasonTotalSize      Reason = "total size limit exceeded"
+	ReasonTooManyEntries Reason = "entry count limit exceeded"
+	ReasonRatio          Reason = "compression ratio limit exceeded"
+	ReasonDepth          Reason = "nesting depth limit exceeded"
+	ReasonCorrupt        Reason = "corrupt archive"
+)
+
+// Limits ограничения распаковки, защищающие от архивных бомб
+type Limits struct {
+	// MaxDepth максимальная вложенность архивов друг в друга
+	MaxDepth int
+	// MaxEntries максимальное количество извлекаемых файлов
+	MaxEntries int
+	// MaxFileSize максимальный размер одного файла после распаковки
+	MaxFileSize int64
+	// MaxTotalSize максимальный суммарный размер извлеченных файлов
+	MaxTotalSize int64
+	// MaxRatio максимальная степень сжатия, проверяется для данных больше minRatioSize
+	MaxRatio float64
+}
+
+// DefaultLimits ограничения по умолчанию
+func DefaultLimits() Limits {
+	return Limits{
+		MaxDepth:     3,
+		MaxEntries:   1000,
+		MaxFileSize:  100 << 20,
+		MaxTotalSize: 1 << 30,
+		MaxRatio:     100,
+	}
+}
+
+// minRatioSize размер распакованных данных, начиная с которого проверяется степень сжатия,
+// маленькие файлы из повторяющихся символов сжимаются сильнее любого разумного порога
+const minRatioSize = 1 << 20
+
+// EntryFunc обрабатывает файл архива по мере распаковки, path - путь файла внутри архива,
+// для вложенных архивов включает путь к ним. Чтение r завершается ошибкой при нарушении ограничений,
+// такую ошибку нужно вернуть из EntryFunc, а прочитанные данные отбросить: файл попадет в Result.Rejected.
+// Любая другая ошибка EntryFunc прекращает распаковку и возвращается из Extract.
+type EntryFunc func(path string, r io.Reader) error
+
+// rejectError отказ обработчика принять файл
+type rejectError struct {
+	reason Reason
+}
+
+func (e *rejectError) Error() string {
+	return string(e.reason)
+}
+
+// Reject возвращается из EntryFunc, чтобы отклонить файл с причиной reason, файл можно не читать
+func Reject(reason Reason) error {
+	return &rejectError{reason: reason}
+}
+
+// Rejection файл или вложенный архив, который не был принят
+type Rejection struct {
+	Path   string
+	Reason Reason
+}
+
+// Result результат распаковки
+type Result struct {
+	// Extracted количество файлов, успешно переданных в EntryFunc
+	Extracted int
+	Rejected  []Rejection
+}
+
+// limitError общее ограничение превышено, распаковка прекращается
+type limitError struct {
+	path   string
+	reason Reason
+}
+
+func (e *limitError) Error() string {
+	return fmt.Sprintf("%s: %s", e.path, e.reason)
+}
+
+// entryError ошибка обработчика файла, распаковка прекращается
+type entryError struct {
+	err error
+}
+
+func (e *entryError) Error() string {
+	return e.err.Error()
+}
+
+func (e *entryError) Unwrap() error {
+	return e.err
+}
+
+// errRatio превышена степень сжатия потока
+var errRatio = errors.New(string(ReasonRatio))
+
+// errFileTooLarge размер файла превышает ограничение
+var errFileTooLarge = errors.New(string(ReasonFileTooLarge))
+
+// errTotalSize суммарный размер извлеченных файлов превышает ограничение
+var errTotalSize = errors.New(string(ReasonTotalSize))
+
+type extractor struct {
+	limits Limits
+	fn     EntryFunc
+	total  int64
+	result *Result
+}
+
+// Extract распаковывает архив, рекурсивно обрабатывая вложенные архивы, и передает каждый файл в fn
+// по мере чтения, не сохраняя содержимое файлов в памяти. В памяти целиком читаются только вложенные архивы.
+// Формат определяется по сигнатуре, для tar без сигнатуры используется расширение name.
+// Файлы, нарушающие ограничения, попадают в Result.Rejected с причиной отказа;
+// при превышении общего количества или размера распаковка прекращается.
+func Extract(name string, data []byte, limits Limits, fn EntryFunc) (*Result, error) {
+	format := Detect(name, data)
+	if format == Undefined {
+		return nil, ErrUnsupportedFormat
+	}
+	e := &extractor{
+		limits: limits,
+		fn:     fn,
+		result: &Result{},
+	}
+	err := e.extract("", name, format, data, 1)
+	var (
+		limitErr *limitError
+		entryErr *entryError
+	)
+	switch {
+	case errors.As(err, &entryErr):
+		return nil, entryErr.err
+	case errors.As(err, &limitErr):
+		e.reject(limitErr.path, limitErr.reason)
+	case errors.Is(err, errRatio):
+		e.reject(name, ReasonRatio)
+	case err != nil:
+		return nil, fmt.Errorf("failed to extract archive: %w", err)
+	}
+	return e.result, nil
+}
+
+// IsArchive проверяет, считается ли файл архивом по его имени.
+// Офисные документы тоже являются zip, поэтому сигнатуры недостаточно.
+func IsArchive(name string) bool {
+	name = strings.ToLower(name)
+	for _, ext := range []string{".zip", ".tar", ".tgz", ".gz"} {
+		if strings.HasSuffix(name, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// Detect определяет формат архива по сигнатуре содержимого
+func Detect(name string, data []byte) Format {
+	switch {
+	case bytes.HasPrefix(data, []byte("PK\x03\x04")), bytes.HasPrefix(data, []byte("PK\x05\x06")):
+		return Zip
+	case bytes.HasPrefix(data, []byte{0x1f, 0x8b}):
+		return Gzip
+	case isTar(data):
+		return Tar
+	case strings.HasSuffix(strings.ToLower(name), ".tar") && len(data) > 0:
+		// старые архивы v7 не содержат сигнатуры ustar
+		return Tar
+	}
+	return Undefined
+}
+
+func isTar(data []byte) bool {
+	const magicOffset = 257
+	return len(data) >= magicOffset+5 && string(data[magicOffset:magicOffset+5]) == "ustar"
+}
+
+// extract распаковывает архив name, пути файлов дополняются префиксом prefix
+func (e *extractor) extract(prefix, name string, format Format, data []byte, depth int) error {
+	switch format {
+	case Zip:
+		return e.extractZip(prefix, data, depth)
+	case Tar:
+		return e.extractTar(prefix, bytes.NewReader(data), depth)
+	case Gzip:
+		return e.extractGzip(prefix, name, data, depth)
+	default:
+		return ErrUnsupportedFormat
+	}
+}
+
+// add принимает извлеченный файл, вложенные архивы читаются в память и распаковываются рекурсивно
+func (e *extractor) add(filePath string, r io.Reader, depth int) error {
+	if !IsArchive(filePath) {
+		return e.emit(filePath, r)
+	}
+	data, err := readLimited(r, e.limits.MaxFileSize)
+	if errors.Is(err, errFileTooLarge) {
+		e.reject(filePath, ReasonFileTooLarge)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", filePath, err)
+	}
+	return e.addArchive(filePath, data, depth)
+}
+
+// emit передает файл обработчику, размер файла и суммарный размер проверяются при чтении
+func (e *extractor) emit(filePath string, r io.Reader) error {
+	if e.result.Extracted >= e.limits.MaxEntries {
+		return &limitError{path: filePath, reason: ReasonTooManyEntries}
+	}
+	er := &entryReader{r: r, e: e}
+	err := e.fn(filePath, er)
+	if err == nil && er.err == nil {
+		e.result.Extracted++
+		return nil
+	}
+	// отклоненный файл не учитывается в суммарном размере
+	e.total -= er.read
+	var rejectErr *rejectError
+	switch {
+	case er.err == nil && errors.As(err, &rejectErr):
+		e.reject(filePath, rejectErr.reason)
+		return nil
+	case er.err == nil:
+		return &entryError{err: err}
+	case errors.Is(er.err, errFileTooLarge):
+		e.reject(filePath, ReasonFileTooLarge)
+		return nil
+	case errors.Is(er.err, errTotalSize):
+		return &limitError{path: filePath, reason: ReasonTotalSize}
+	default:
+		return fmt.Errorf("failed to read %s: %w", filePath, er.err)
+	}
+}
+
+func (e *extractor) addArchive(filePath string, data []byte, depth int) error {
+	if depth >= e.limits.MaxDepth {
+		e.reject(filePath, ReasonDepth)
+		return nil
+	}
+	format := Detect(filePath, data)
+	if format == Undefined {
+		e.reject(filePath, ReasonCorrupt)
+		return nil
+	}
+	err := e.extract(filePath, path.Base(filePath), format, data, depth+1)
+	var (
+		limitErr *limitError
+		entryErr *entryError
+	)
+	switch {
+	case errors.As(err, &limitErr), errors.As(err, &entryErr):
+		return err
+	case errors.Is(err, errRatio):
+		e.reject(filePath, ReasonRatio)
+	case err != nil:
+		e.reject(filePath, ReasonCorrupt)
+	}
+	return nil
+}
+
+func (e *extractor) reject(filePath string, reason Reason) {
+	e.result.Rejected = append(e.result.Rejected, Rejection{Path: filePath, Reason: reason})
+}
+
+// joinPath добавляет к пути файла внутри архива путь самого архива
+func joinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "/" + name
+}
+
+// cleanPath нормализует путь внутри архива, абсолютные пути и выход за пределы архива запрещены
+func cleanPath(name string) (string, bool) {
+	name = strings.ReplaceAll(name, "\\", "/")
+	if strings.HasPrefix(name, "/") || (len(name) > 1 && name[1] == ':') {
+		return "", false
+	}
+	for _, part := range strings.Split(name, "/") {
+		if part == ".." {
+			return "", false
+		}
+	}
+	cleaned := path.Clean(name)
+	if cleaned == "." {
+		return "", false
+	}
+	return cleaned, true
+}
@@ -0,0 +1,265 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testFile struct {
+	name    string
+	content []byte
+}
+
+func makeZip(t *testing.T, files ...testFile) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range files {
+		fw, err := w.Create(f.name)
+		assert.NoError(t, err)
+		_, err = fw.Write(f.content)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func makeTar(t *testing.T, files ...testFile) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := tar.NewWriter(&buf)
+	for _, f := range files {
+		assert.NoError(t, w.WriteHeader(&tar.Header{
+			Name:     f.name,
+			Mode:     0o644,
+			Size:     int64(len(f.content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := w.Write(f.content)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func makeGzip(t *testing.T, content []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write(content)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+// collector сохраняет переданные обработчику файлы
+type collector struct {
+	paths []string
+	data  map[string][]byte
+}
+
+func (c *collector) add(path string, r io.Reader) error {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if c.data == nil {
+		c.data = make(map[string][]byte)
+	}
+	c.paths = append(c.paths, path)
+	c.data[path] = content
+	return nil
+}
+
+func extract(t *testing.T, name string, data []byte, limits Limits) (*Result, *collector) {
+	t.Helper()
+	c := &collector{}
+	res, err := Extract(name, data, limits, c.add)
+	assert.NoError(t, err)
+	assert.Equal(t, len(c.paths), res.Extracted)
+	return res, c
+}
+
+func TestExtract(t *testing.T) {
+	t.Parallel()
+
+	inner := makeTar(t, testFile{name: "b.txt", content: []byte("b")})
+	data := makeZip(t,
+		testFile{name: "docs/a.txt", content: []byte("a")},
+		testFile{name: "docs/inner.tar.gz", content: makeGzip(t, inner)},
+		testFile{name: "report.docx", content: makeZip(t, testFile{name: "word/document.xml", content: []byte("<w/>")})},
+		testFile{name: "c.md.gz", content: makeGzip(t, []byte("# c"))},
+		testFile{name: "../evil.txt", content: []byte("x")},
+	)
+
+	res, c := extract(t, "upload.zip", data, DefaultLimits())
+	assert.Equal(t, []string{"docs/a.txt", "docs/inner.tar.gz/b.txt", "report.docx", "c.md.gz/c.md"}, c.paths)
+	assert.Equal(t, []byte("b"), c.data["docs/inner.tar.gz/b.txt"])
+	assert.Equal(t, []Rejection{{Path: "../evil.txt", Reason: ReasonUnsafePath}}, res.Rejected)
+}
+
+func TestExtractFormats(t *testing.T) {
+	t.Parallel()
+
+	tarData := makeTar(t, testFile{name: "a.txt", content: []byte("a")})
+	for _, tc := range []struct {
+		name string
+		data []byte
+		want []string
+	}{
+		{name: "files.tar", data: tarData, want: []string{"a.txt"}},
+		{name: "files.tgz", data: makeGzip(t, tarData), want: []string{"a.txt"}},
+		{name: "notes.txt.gz", data: makeGzip(t, []byte("notes")), want: []string{"notes.txt"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, c := extract(t, tc.name, tc.data, DefaultLimits())
+			assert.Equal(t, tc.want, c.paths)
+		})
+	}
+
+	_, err := Extract("file.txt", []byte("plain text"), DefaultLimits(), (&collector{}).add)
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestExtractLimits(t *testing.T) {
+	t.Parallel()
+
+	t.Run("depth", func(t *testing.T) {
+		t.Parallel()
+		level2 := makeZip(t, testFile{name: "deep.txt", content: []byte("deep")})
+		level1 := makeZip(t, testFile{name: "level2.zip", content: level2})
+		data := makeZip(t, testFile{name: "level1.zip", content: level1})
+		limits := DefaultLimits()
+		limits.MaxDepth = 2
+		res, c := extract(t, "root.zip", data, limits)
+		assert.Empty(t, c.paths)
+		assert.Equal(t, []Rejection{{Path: "level1.zip/level2.zip", Reason: ReasonDepth}}, res.Rejected)
+	})
+
+	t.Run("entries", func(t *testing.T) {
+		t.Parallel()
+		data := makeZip(t,
+			testFile{name: "1.txt", content: []byte("1")},
+			testFile{name: "2.txt", content: []byte("2")},
+			testFile{name: "3.txt", content: []byte("3")},
+		)
+		limits := DefaultLimits()
+		limits.MaxEntries = 2
+		res, c := extract(t, "root.zip", data, limits)
+		assert.Equal(t, []string{"1.txt", "2.txt"}, c.paths)
+		assert.Equal(t, []Rejection{{Path: "3.txt", Reason: ReasonTooManyEntries}}, res.Rejected)
+	})
+
+	t.Run("file size", func(t *testing.T) {
+		t.Parallel()
+		data := makeTar(t,
+			testFile{name: "big.txt", content: []byte("0123456789")},
+			testFile{name: "small.txt", content: []byte("0")},
+		)
+		limits := DefaultLimits()
+		limits.MaxFileSize = 5
+		res, c := extract(t, "root.tar", data, limits)
+		assert.Equal(t, []string{"small.txt"}, c.paths)
+		assert.Equal(t, []Rejection{{Path: "big.txt", Reason: ReasonFileTooLarge}}, res.Rejected)
+	})
+
+	t.Run("total size", func(t *testing.T) {
+		t.Parallel()
+		data := makeZip(t,
+			testFile{name: "a.txt", content: []byte("aaaa")},
+			testFile{name: "b.txt", content: []byte("bbbb")},
+		)
+		limits := DefaultLimits()
+		limits.MaxTotalSize = 6
+		res, c := extract(t, "root.zip", data, limits)
+		assert.Equal(t, []string{"a.txt"}, c.paths)
+		assert.Equal(t, []Rejection{{Path: "b.txt", Reason: ReasonTotalSize}}, res.Rejected)
+	})
+
+	t.Run("ratio", func(t *testing.T) {
+		t.Parallel()
+		bomb := []byte(strings.Repeat("0", 4*minRatioSize))
+		data := makeZip(t,
+			testFile{name: "bomb.txt", content: bomb},
+			testFile{name: "nested.gz", content: makeGzip(t, bomb)},
+			testFile{name: "ok.txt", content: []byte("ok")},
+		)
+		res, c := extract(t, "root.zip", data, DefaultLimits())
+		assert.Equal(t, []string{"ok.txt"}, c.paths)
+		assert.Equal(t, []Rejection{
+			{Path: "bomb.txt", Reason: ReasonRatio},
+			{Path: "nested.gz", Reason: ReasonRatio},
+		}, res.Rejected)
+	})
+}
+
+func TestExtractStreaming(t *testing.T) {
+	t.Parallel()
+
+	t.Run("gzip size checked while reading", func(t *testing.T) {
+		t.Parallel()
+		// размер файла в gzip заранее неизвестен, ограничение срабатывает при чтении
+		limits := DefaultLimits()
+		limits.MaxFileSize = 5
+		res, c := extract(t, "notes.txt.gz", makeGzip(t, []byte("0123456789")), limits)
+		assert.Empty(t, c.paths)
+		assert.Equal(t, []Rejection{{Path: "notes.txt", Reason: ReasonFileTooLarge}}, res.Rejected)
+	})
+
+	t.Run("handler error stops extraction", func(t *testing.T) {
+		t.Parallel()
+		errUpload := errors.New("upload failed")
+		data := makeTar(t,
+			testFile{name: "a.txt", content: []byte("a")},
+			testFile{name: "b.txt", content: []byte("b")},
+		)
+		calls := 0
+		_, err := Extract("root.tar", data, DefaultLimits(), func(string, io.Reader) error {
+			calls++
+			return errUpload
+		})
+		assert.ErrorIs(t, err, errUpload)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("handler rejects without reading", func(t *testing.T) {
+		t.Parallel()
+		data := makeZip(t,
+			testFile{name: "a.exe", content: []byte("MZ")},
+			testFile{name: "b.txt", content: []byte("b")},
+		)
+		limits := DefaultLimits()
+		limits.MaxEntries = 1
+		c := &collector{}
+		res, err := Extract("root.zip", data, limits, func(path string, r io.Reader) error {
+			if strings.HasSuffix(path, ".exe") {
+				return Reject("unsupported file type")
+			}
+			return c.add(path, r)
+		})
+		assert.NoError(t, err)
+		// отклоненный файл не расходует ограничение на количество
+		assert.Equal(t, []string{"b.txt"}, c.paths)
+		assert.Equal(t, 1, res.Extracted)
+		assert.Equal(t, []Rejection{{Path: "a.exe", Reason: "unsupported file type"}}, res.Rejected)
+	})
+
+	t.Run("nested handler error", func(t *testing.T) {
+		t.Parallel()
+		errUpload := errors.New("upload failed")
+		data := makeZip(t, testFile{name: "inner.zip", content: makeZip(t, testFile{name: "a.txt", content: []byte("a")})})
+		_, err := Extract("root.zip", data, DefaultLimits(), func(string, io.Reader) error {
+			return errUpload
+		})
+		assert.ErrorIs(t, err, errUpload)
+	})
+}
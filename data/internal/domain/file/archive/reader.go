@@ -0,0 +1,204 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+func (e *extractor) extractZip(prefix string, data []byte, depth int) error {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return fmt.Errorf("failed to open zip: %w", err)
+	}
+	for _, f := range r.File {
+		name, ok := cleanPath(f.Name)
+		if !ok {
+			e.reject(joinPath(prefix, f.Name), ReasonUnsafePath)
+			continue
+		}
+		filePath := joinPath(prefix, name)
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		if !f.Mode().IsRegular() {
+			e.reject(filePath, ReasonNotRegular)
+			continue
+		}
+		if f.UncompressedSize64 > uint64(e.limits.MaxFileSize) {
+			e.reject(filePath, ReasonFileTooLarge)
+			continue
+		}
+		if exceedsRatio(int64(f.UncompressedSize64), int64(f.CompressedSize64), e.limits.MaxRatio) {
+			e.reject(filePath, ReasonRatio)
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %w", filePath, err)
+		}
+		err = e.add(filePath, newRatioReader(rc, int64(f.CompressedSize64), e.limits.MaxRatio), depth)
+		rc.Close()
+		if errors.Is(err, errRatio) {
+			// заявленному в заголовке размеру доверять нельзя, степень сжатия проверяется при чтении
+			e.reject(filePath, ReasonRatio)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *extractor) extractTar(prefix string, r io.Reader, depth int) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read tar: %w", err)
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir, tar.TypeXGlobalHeader:
+			continue
+		}
+		name, ok := cleanPath(hdr.Name)
+		if !ok {
+			e.reject(joinPath(prefix, hdr.Name), ReasonUnsafePath)
+			continue
+		}
+		filePath := joinPath(prefix, name)
+		if !hdr.FileInfo().Mode().IsRegular() {
+			e.reject(filePath, ReasonNotRegular)
+			continue
+		}
+		if hdr.Size > e.limits.MaxFileSize {
+			e.reject(filePath, ReasonFileTooLarge)
+			continue
+		}
+		if err = e.add(filePath, tr, depth); err != nil {
+			return err
+		}
+	}
+}
+
+// extractGzip распаковывает gzip: tar внутри обрабатывается потоково как единый архив,
+// любой другой файл становится отдельной записью
+func (e *extractor) extractGzip(prefix, name string, data []byte, depth int) error {
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to open gzip: %w", err)
+	}
+	defer gr.Close()
+	br := bufio.NewReader(newRatioReader(gr, int64(len(data)), e.limits.MaxRatio))
+	header, err := br.Peek(512)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return err
+	}
+	innerName := gzipInnerName(name, gr.Name)
+	if isTar(header) || strings.HasSuffix(strings.ToLower(innerName), ".tar") {
+		return e.extractTar(prefix, br, depth)
+	}
+	return e.add(joinPath(prefix, innerName), br, depth)
+}
+
+// gzipInnerName имя сжатого файла: из заголовка gzip или имя архива без расширения
+func gzipInnerName(archiveName, headerName string) string {
+	if name, ok := cleanPath(headerName); ok {
+		return path.Base(name)
+	}
+	base := path.Base(archiveName)
+	lower := strings.ToLower(base)
+	switch {
+	case strings.HasSuffix(lower, ".tgz"):
+		return base[:len(base)-len(".tgz")] + ".tar"
+	case strings.HasSuffix(lower, ".gz") && len(base) > len(".gz"):
+		return base[:len(base)-len(".gz")]
+	}
+	return base
+}
+
+// readLimited читает не больше limit байт, при превышении возвращает errFileTooLarge
+func readLimited(r io.Reader, limit int64) ([]byte, error) {
+	content, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > limit {
+		return nil, errFileTooLarge
+	}
+	return content, nil
+}
+
+func exceedsRatio(uncompressed, compressed int64, maxRatio float64) bool {
+	if uncompressed <= minRatioSize {
+		return false
+	}
+	return compressed <= 0 || float64(uncompressed)/float64(compressed) > maxRatio
+}
+
+// entryReader читает файл архива, проверяя ограничения на его размер и суммарный размер распаковки.
+// Первая ошибка запоминается, чтобы отличить нарушение ограничений от ошибки обработчика.
+type entryReader struct {
+	r    io.Reader
+	e    *extractor
+	read int64
+	err  error
+}
+
+func (r *entryReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	n, err := r.r.Read(p)
+	r.read += int64(n)
+	r.e.total += int64(n)
+	switch {
+	case r.read > r.e.limits.MaxFileSize:
+		r.err = errFileTooLarge
+	case r.e.total > r.e.limits.MaxTotalSize:
+		r.err = errTotalSize
+	case err != nil && !errors.Is(err, io.EOF):
+		r.err = err
+	}
+	if r.err != nil {
+		return n, r.err
+	}
+	return n, err
+}
+
+// ratioReader прерывает чтение распакованного потока, если степень сжатия превышает ограничение.
+// Заголовкам архива доверять нельзя, поэтому считаются реально прочитанные байты.
+type ratioReader struct {
+	r          io.Reader
+	compressed int64
+	maxRatio   float64
+	read       int64
+}
+
+func newRatioReader(r io.Reader, compressed int64, maxRatio float64) *ratioReader {
+	return &ratioReader{
+		r:          r,
+		compressed: compressed,
+		maxRatio:   maxRatio,
+	}
+}
+
+func (r *ratioReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.read += int64(n)
+	if exceedsRatio(r.read, r.compressed, r.maxRatio) {
+		return n, errRatio
+	}
+	return n, err
+}
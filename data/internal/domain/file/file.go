@@ -14,9 +14,11 @@ type Archive struct {
 }
 
 type File struct {
-	ID        string    `db:"id"`
-	SourceID  string    `db:"source_id"`
-	Filename  string    `db:"filename"`
+	ID       string `db:"id"`
+	SourceID string `db:"source_id"`
+	Filename string `db:"filename"`
+	// Path исходный путь файла внутри загруженного архива
+	Path      string    `db:"path"`
 	Extension string    `db:"extension"`
 	Raw       []byte    `db:"-" json:"-"`
 	Size      int64     `db:"size"`
@@ -1,5 +1,15 @@
 package object_store
 
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var ErrInvalidConfig = errors.New("invalid object storage config")
+
 type ObjectStore struct {
 	ID       string `db:"id"`        // ID uuid идентификатор источника
 	SourceID string `db:"source_id"` // ID uuid идентификатора источника
@@ -12,15 +22,52 @@ type Config struct {
 	AccessKey string `json:"accessKey"`
 	SecretKey string `json:"secretKey"`
 	Api       string `json:"api"`
-	Path      string `json:"path"`
+	Path      string `json:"path"`   // Path префикс объектов внутри бакета
+	Bucket    string `json:"bucket"` // Bucket название бакета
+	UseSSL    bool   `json:"useSSL"` // UseSSL использовать https при подключении
+}
+
+// Normalize заполняет endpoint, бакет и префикс из Url вида http(s)://host:port/bucket/prefix,
+// если они не заданы явно, и проверяет обязательные поля.
+func (c *Config) Normalize() error {
+	if c.Url != "" {
+		u, err := url.Parse(c.Url)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+		}
+		if c.Endpoint == "" {
+			c.Endpoint = u.Host
+			c.UseSSL = c.UseSSL || u.Scheme == "https"
+		}
+		bucket, prefix, _ := strings.Cut(strings.TrimPrefix(u.Path, "/"), "/")
+		if c.Bucket == "" {
+			c.Bucket = bucket
+		}
+		if c.Path == "" {
+			c.Path = prefix
+		}
+	}
+	c.Path = strings.TrimPrefix(c.Path, "/")
+	if c.Endpoint == "" {
+		return fmt.Errorf("%w: endpoint is empty", ErrInvalidConfig)
+	}
+	if c.Bucket == "" {
+		return fmt.Errorf("%w: bucket is empty", ErrInvalidConfig)
+	}
+	return nil
 }
 
+// Object объект бакета, уже загруженный в систему
 type Object struct {
-	ID           string `db:"id"` // ID uuid идентификатор объекта
-	Data         []byte // Data данные объекта
-	Size         int    `db:"content_size"`  // Size размер объекта
-	ContentType  string `db:"content_type"`  // ContentType тип содержимого объекта
-	RawContentID string `db:"raw_object_id"` // raw_content_id идентификатор в dwh хранилище
-	CreatedAt    string `db:"created_at"`    // CreatedAt дата создания объекта
-	UpdatedAt    string `db:"updated_at"`    // UpdatedAt дата обновления объекта
+	ID              string    `db:"id"`                // ID uuid идентификатор объекта
+	ObjectStorageID string    `db:"object_storage_id"` // ObjectStorageID идентификатор объектного хранилища
+	Key             string    `db:"object_key"`        // Key ключ объекта в бакете
+	ETag            string    `db:"etag"`              // ETag версия объекта, по которой определяются изменения
+	FileID          string    `db:"file_id"`           // FileID идентификатор файла, созданного из объекта
+	Size            int64     `db:"content_size"`      // Size размер объекта
+	ContentType     string    `db:"content_type"`      // ContentType тип содержимого объекта
+	RawContentID    string    `db:"raw_object_id"`     // raw_content_id идентификатор в dwh хранилище
+	LastModified    time.Time `db:"last_modified"`     // LastModified время изменения объекта в бакете
+	CreatedAt       time.Time `db:"created_at"`        // CreatedAt дата создания объекта
+	UpdatedAt       time.Time `db:"updated_at"`        // UpdatedAt дата обновления объекта
 }
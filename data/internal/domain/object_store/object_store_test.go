@@ -0,0 +1,28 @@
+package object_store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigNormalize(t *testing.T) {
+	cfg := Config{
+		Url:       "https://minio.local:9000/reports/2025/q1/",
+		AccessKey: "minio",
+		SecretKey: "minio123",
+	}
+	assert.NoError(t, cfg.Normalize())
+	assert.Equal(t, "minio.local:9000", cfg.Endpoint)
+	assert.Equal(t, "reports", cfg.Bucket)
+	assert.Equal(t, "2025/q1/", cfg.Path)
+	assert.True(t, cfg.UseSSL)
+
+	cfg = Config{Endpoint: "localhost:9000", Bucket: "reports", Path: "/archive"}
+	assert.NoError(t, cfg.Normalize())
+	assert.Equal(t, "archive", cfg.Path)
+	assert.False(t, cfg.UseSSL)
+
+	cfg = Config{Endpoint: "localhost:9000"}
+	assert.ErrorIs(t, cfg.Normalize(), ErrInvalidConfig)
+}
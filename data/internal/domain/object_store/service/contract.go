@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	"github.com/larek-tech/diploma/data/internal/domain/file"
+	"github.com/larek-tech/diploma/data/internal/domain/object_store"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/s3"
+)
+
+type (
+	objectStorage interface {
+		GetObjects(ctx context.Context, objectStorageID string) ([]*object_store.Object, error)
+		SaveObject(ctx context.Context, object *object_store.Object) error
+		DeleteObjects(ctx context.Context, objects []*object_store.Object) error
+	}
+	fileStorage interface {
+		Save(ctx context.Context, file *file.File) error
+		DeleteObjects(ctx context.Context, files []*file.File)
+	}
+	bucket interface {
+		List(ctx context.Context, bucketName, prefix string) ([]s3.ObjectInfo, error)
+		Download(ctx context.Context, bucketName, key string) (*s3.Object, error)
+	}
+	publisher interface {
+		Publish(ctx context.Context, rawMsg []any, opts ...qaas.PublishOption) ([]string, error)
+	}
+	transactionalManager interface {
+		Do(context.Context, func(context.Context) error) error
+	}
+)
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+	"github.com/larek-tech/diploma/data/internal/domain/file"
+	"github.com/larek-tech/diploma/data/internal/domain/object_store"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/s3"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SyncResult итог синхронизации бакета
+type SyncResult struct {
+	Total   int // Total количество поддерживаемых объектов в бакете
+	Changed int // Changed количество новых и измененных объектов, отправленных на обработку
+	Deleted int // Deleted количество объектов, удаленных из бакета с прошлой синхронизации
+}
+
+type Service struct {
+	objectStorage objectStorage
+	fileStorage   fileStorage
+	pub           publisher
+	trManager     transactionalManager
+	tracer        trace.Tracer
+	newBucket     func(cfg object_store.Config) (bucket, error)
+}
+
+func New(objectStorage objectStorage, fileStorage fileStorage, pub publisher, trManager transactionalManager, tracer trace.Tracer) *Service {
+	return &Service{
+		objectStorage: objectStorage,
+		fileStorage:   fileStorage,
+		pub:           pub,
+		trManager:     trManager,
+		tracer:        tracer,
+		newBucket:     newS3Bucket,
+	}
+}
+
+func newS3Bucket(cfg object_store.Config) (bucket, error) {
+	return s3.New(s3.NewCredentials(cfg.Endpoint, cfg.AccessKey, cfg.SecretKey, cfg.UseSSL))
+}
+
+// SyncBucket загружает новые и измененные объекты бакета и отправляет их на обработку,
+// объекты без изменений etag пропускаются, пропавшие из бакета удаляются вместе с документами.
+// Задачи на обработку помечаются поколением источника generation.
+func (s Service) SyncBucket(ctx context.Context, store *object_store.ObjectStore, externalKey string, generation int) (SyncResult, error) {
+	ctx, span := s.tracer.Start(ctx, "objectStoreService.SyncBucket", trace.WithAttributes(
+		attribute.String("objectStorageID", store.ID),
+		attribute.String("sourceID", store.SourceID),
+		attribute.String("bucket", store.Config.Bucket),
+		attribute.String("prefix", store.Config.Path),
+	))
+	defer span.End()
+
+	var res SyncResult
+	client, err := s.newBucket(store.Config)
+	if err != nil {
+		err = fmt.Errorf("failed to connect to bucket: %w", err)
+		span.RecordError(err)
+		return res, err
+	}
+	listed, err := client.List(ctx, store.Config.Bucket, store.Config.Path)
+	if err != nil {
+		err = fmt.Errorf("failed to list bucket: %w", err)
+		span.RecordError(err)
+		return res, err
+	}
+	known, err := s.objectStorage.GetObjects(ctx, store.ID)
+	if err != nil {
+		err = fmt.Errorf("failed to get known objects: %w", err)
+		span.RecordError(err)
+		return res, err
+	}
+	knownByKey := make(map[string]*object_store.Object, len(known))
+	for _, obj := range known {
+		knownByKey[obj.Key] = obj
+	}
+
+	files := make([]*file.File, 0)
+	for _, info := range listed {
+		if !isSupported(info.Key) {
+			continue
+		}
+		res.Total++
+		existing, ok := knownByKey[info.Key]
+		delete(knownByKey, info.Key)
+		if ok && existing.ETag == info.ETag && existing.FileID != "" {
+			continue
+		}
+		f, err := s.syncObject(ctx, client, store, info, existing)
+		if err != nil {
+			// один битый объект не должен останавливать синхронизацию всего бакета
+			slog.Error("failed to sync object", "bucket", store.Config.Bucket, "key", info.Key, "error", err)
+			span.RecordError(err)
+			continue
+		}
+		files = append(files, f)
+	}
+
+	vanished := make([]*object_store.Object, 0, len(knownByKey))
+	for _, obj := range knownByKey {
+		vanished = append(vanished, obj)
+	}
+	if len(vanished) > 0 {
+		err = s.trManager.Do(ctx, func(ctx context.Context) error {
+			return s.objectStorage.DeleteObjects(ctx, vanished)
+		})
+		if err != nil {
+			err = fmt.Errorf("failed to delete vanished objects: %w", err)
+			span.RecordError(err)
+			return res, err
+		}
+		// копии пропавших объектов удаляются из бакета файлов после коммита
+		s.fileStorage.DeleteObjects(ctx, objectFiles(store.SourceID, vanished))
+		res.Deleted = len(vanished)
+	}
+
+	if err = s.publishFileJobs(ctx, files, externalKey, generation); err != nil {
+		span.RecordError(err)
+		return res, err
+	}
+	res.Changed = len(files)
+	slog.Info("bucket synced",
+		"bucket", store.Config.Bucket,
+		"prefix", store.Config.Path,
+		"total", res.Total,
+		"changed", res.Changed,
+		"deleted", res.Deleted,
+	)
+	return res, nil
+}
+
+// syncObject скачивает объект и сохраняет его как файл источника, при обновлении файл сохраняет прежний ID.
+func (s Service) syncObject(ctx context.Context, client bucket, store *object_store.ObjectStore, info s3.ObjectInfo, existing *object_store.Object) (*file.File, error) {
+	raw, err := client.Download(ctx, store.Config.Bucket, info.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download object: %w", err)
+	}
+
+	obj := &object_store.Object{
+		ID:              uuid.NewString(),
+		ObjectStorageID: store.ID,
+		Key:             info.Key,
+		ETag:            info.ETag,
+		Size:            info.Size,
+		ContentType:     string(raw.GetContentType()),
+		LastModified:    info.LastModified,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+	f := file.NewFile(store.SourceID, strings.TrimPrefix(path.Ext(info.Key), "."))
+	if existing != nil {
+		obj.ID = existing.ID
+		obj.CreatedAt = existing.CreatedAt
+		if existing.FileID != "" {
+			f.ID = existing.FileID
+		}
+	}
+	f.Filename = info.Key
+	f.Raw = raw.GetData()
+	f.Size = info.Size
+	obj.FileID = f.ID
+	obj.RawContentID = f.ID
+
+	err = s.trManager.Do(ctx, func(ctx context.Context) error {
+		if err := s.fileStorage.Save(ctx, f); err != nil {
+			return fmt.Errorf("failed to save file: %w", err)
+		}
+		if err := s.objectStorage.SaveObject(ctx, obj); err != nil {
+			return fmt.Errorf("failed to save object: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// objectFiles возвращает файлы, в которые были скопированы объекты бакета
+func objectFiles(sourceID string, objects []*object_store.Object) []*file.File {
+	files := make([]*file.File, 0, len(objects))
+	for _, obj := range objects {
+		if obj.FileID == "" {
+			continue
+		}
+		f := file.NewFile(sourceID, strings.TrimPrefix(path.Ext(obj.Key), "."))
+		f.ID = obj.FileID
+		files = append(files, f)
+	}
+	return files
+}
+
+func (s Service) publishFileJobs(ctx context.Context, files []*file.File, externalKey string, generation int) error {
+	if len(files) == 0 {
+		return nil
+	}
+	jobs := make([]any, 0, len(files))
+	for _, f := range files {
+		jobs = append(jobs, qaas.FileJob{
+			Payload: f,
+			Delay:   0,
+			Metadata: map[string]any{
+				"externalKey":      externalKey,
+				qaas.GenerationKey: generation,
+			},
+		})
+	}
+	_, err := s.pub.Publish(ctx, jobs,
+		qaas.WithQueue(qaas.ParseFileQueue),
+		qaas.WithSourceQueue(qaas.ParseFileQueue),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to publish file jobs: %w", err)
+	}
+	return nil
+}
+
+func isSupported(key string) bool {
+	_, ok := document.FileExtensionMap[strings.ToLower(path.Ext(key))]
+	return ok
+}
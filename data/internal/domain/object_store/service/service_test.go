@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/larek-tech/diploma/data/internal/domain/file"
+	"github.com/larek-tech/diploma/data/internal/domain/object_store"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/s3"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type fakeBucket struct {
+	objects    []s3.ObjectInfo
+	downloaded []string
+}
+
+func (b *fakeBucket) List(_ context.Context, _, _ string) ([]s3.ObjectInfo, error) {
+	return b.objects, nil
+}
+
+func (b *fakeBucket) Download(_ context.Context, bucketName, key string) (*s3.Object, error) {
+	b.downloaded = append(b.downloaded, key)
+	return s3.NewObject(bucketName, key, []byte("content"), s3.ContentTypeText), nil
+}
+
+type fakeObjectStorage struct {
+	objects []*object_store.Object
+	saved   []*object_store.Object
+	deleted []*object_store.Object
+}
+
+func (s *fakeObjectStorage) GetObjects(_ context.Context, _ string) ([]*object_store.Object, error) {
+	return s.objects, nil
+}
+
+func (s *fakeObjectStorage) SaveObject(_ context.Context, object *object_store.Object) error {
+	s.saved = append(s.saved, object)
+	return nil
+}
+
+func (s *fakeObjectStorage) DeleteObjects(_ context.Context, objects []*object_store.Object) error {
+	s.deleted = append(s.deleted, objects...)
+	return nil
+}
+
+type fakeFileStorage struct {
+	saved   []*file.File
+	deleted []*file.File
+}
+
+func (s *fakeFileStorage) Save(_ context.Context, f *file.File) error {
+	s.saved = append(s.saved, f)
+	return nil
+}
+
+func (s *fakeFileStorage) DeleteObjects(_ context.Context, files []*file.File) {
+	s.deleted = append(s.deleted, files...)
+}
+
+type fakePublisher struct {
+	published []any
+}
+
+func (p *fakePublisher) Publish(_ context.Context, rawMsg []any, _ ...qaas.PublishOption) ([]string, error) {
+	p.published = append(p.published, rawMsg...)
+	return nil, nil
+}
+
+type fakeTrManager struct{}
+
+func (fakeTrManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestSyncBucket(t *testing.T) {
+	t.Parallel()
+
+	b := &fakeBucket{objects: []s3.ObjectInfo{
+		{Key: "docs/new.pdf", ETag: "1"},
+		{Key: "docs/same.md", ETag: "2"},
+		{Key: "docs/changed.txt", ETag: "4"},
+		{Key: "docs/binary.exe", ETag: "5"},
+	}}
+	objects := &fakeObjectStorage{objects: []*object_store.Object{
+		{ID: "o1", Key: "docs/same.md", ETag: "2", FileID: "f1"},
+		{ID: "o2", Key: "docs/changed.txt", ETag: "3", FileID: "f2"},
+		{ID: "o3", Key: "docs/removed.pdf", ETag: "6", FileID: "f3"},
+	}}
+	files := &fakeFileStorage{}
+	pub := &fakePublisher{}
+	s := New(objects, files, pub, fakeTrManager{}, noop.NewTracerProvider().Tracer(""))
+	s.newBucket = func(object_store.Config) (bucket, error) {
+		return b, nil
+	}
+
+	res, err := s.SyncBucket(context.Background(), &object_store.ObjectStore{
+		ID:       "store",
+		SourceID: "source",
+		Config:   object_store.Config{Bucket: "bucket", Path: "docs/"},
+	}, "key", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, SyncResult{Total: 3, Changed: 2, Deleted: 1}, res)
+	assert.ElementsMatch(t, []string{"docs/new.pdf", "docs/changed.txt"}, b.downloaded)
+	assert.Len(t, pub.published, 2)
+	if assert.Len(t, objects.deleted, 1) {
+		assert.Equal(t, "o3", objects.deleted[0].ID)
+	}
+	// копия пропавшего объекта удаляется из бакета файлов
+	if assert.Len(t, files.deleted, 1) {
+		assert.Equal(t, "f3", files.deleted[0].ID)
+		assert.Equal(t, "pdf", files.deleted[0].Extension)
+	}
+	for _, f := range files.saved {
+		assert.Equal(t, "source", f.SourceID)
+		if f.Filename == "docs/changed.txt" {
+			// при изменении объекта файл сохраняет прежний идентификатор
+			assert.Equal(t, "f2", f.ID)
+		}
+	}
+}
@@ -1,8 +1,9 @@
 package question
 
 type Questions struct {
-	ID         string    `db:"id"`
-	ChunkID    string    `db:"chunk_id"`
-	Question   string    `db:"question"`
-	Embeddings []float32 `db:"embeddings"`
+	ID             string    `db:"id"`
+	ChunkID        string    `db:"chunk_id"`
+	Question       string    `db:"question"`
+	Embeddings     []float32 `db:"-"`               // вектор основной модели, хранится в таблице векторов модели
+	EmbeddingModel string    `db:"embedding_model"` // модель с версией, которой получен вектор
 }
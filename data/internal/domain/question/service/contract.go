@@ -10,5 +10,6 @@ type (
 	}
 	embedder interface {
 		CreateEmbedding(ctx context.Context, inputTexts []string) ([][]float32, error)
+		EmbeddingsModel() string
 	}
 )
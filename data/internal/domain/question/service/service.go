@@ -52,10 +52,11 @@ func (s Service) GenerateQuestions(ctx context.Context, chunks []*document.Chunk
 		}
 
 		questions = append(questions, &question.Questions{
-			ID:         uuid.NewString(),
-			ChunkID:    chunk.ID,
-			Question:   llmQuestions,
-			Embeddings: embeds[0],
+			ID:             uuid.NewString(),
+			ChunkID:        chunk.ID,
+			Question:       llmQuestions,
+			Embeddings:     embeds[0],
+			EmbeddingModel: s.embedder.EmbeddingsModel(),
 		})
 
 	}
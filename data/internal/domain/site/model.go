@@ -9,19 +9,33 @@ import (
 	"github.com/google/uuid"
 )
 
+// SkipReasonRobots страница запрещена к обходу правилами robots.txt
+const SkipReasonRobots = "robots.txt"
+
 var (
 	ErrInvalidSiteID = errors.New("invalid site ID must be a valid UUID")
 	ErrInvalidURL    = errors.New("invalid URL must be a valid URL")
 )
 
+// CrawlMode способ обхода страниц сайта
+type CrawlMode int16
+
+const (
+	CrawlSitemap CrawlMode = iota // обход в ширину по ссылкам, начиная со страниц из sitemap.xml
+	CrawlLinks                    // sitemap.xml недоступен, обход в ширину по ссылкам начинается с корневой страницы
+)
+
 // TODO: add sitemap
 type Site struct {
 	ID             string    `db:"id"`              // ID uuid идентификатор сайта
 	SourceID       string    `db:"source_id"`       // SourceID идентификатор источника к которому относится сайт
 	URL            string    `db:"url"`             // URL корневой адрес сайта
 	AvailablePages []string  `db:"available_pages"` // url страниц полученных из sitemap
+	CrawlMode      CrawlMode `db:"crawl_mode"`      // CrawlMode способ обхода страниц сайта
 	CreatedAt      time.Time `db:"created_at"`      // CreatedAt время создания сайта
 	UpdatedAt      time.Time `db:"updated_at"`      // UpdatedAt время последнего обновления сайта
+
+	PageLastMod map[string]time.Time `db:"-" json:"page_last_mod,omitempty"` // PageLastMod время изменения страниц из sitemap
 }
 
 func NewSite(sourceID, siteURL string) (*Site, error) {
@@ -58,6 +72,10 @@ type Page struct {
 	Raw           string            `db:"-" json:"-"`    // Raw необработанное содержание страницы
 	Content       string            `db:"content"`       // Content текстовое содержание страницы
 	OutgoingPages []string          `db:"outgoing"`      // OutgoingPages список UUID страниц на которые ссылается текущая страница
+	SkipReason    string            `db:"skip_reason"`   // SkipReason причина, по которой страница не была загружена
+	ETag          string            `db:"etag"`          // ETag заголовок ответа для условных запросов
+	LastModified  string            `db:"last_modified"` // LastModified заголовок ответа для условных запросов
+	ContentHash   string            `db:"content_hash"`  // ContentHash хэш нормализованного текста страницы
 	CreatedAt     time.Time         `db:"created_at"`    // CreatedAt время создания страницы
 	UpdatedAt     time.Time         `db:"updated_at"`    // UpdatedAt время последнего обновления страницы
 }
@@ -88,3 +106,10 @@ func NewPage(siteID string, pageURL string) (*Page, error) {
 	}
 	return page, nil
 }
+
+// PageTask параметры обхода страницы в рамках задачи обхода сайта
+type PageTask struct {
+	SiteJobID string    // SiteJobID идентификатор задачи обхода сайта
+	Depth     int       // Depth глубина страницы от стартовых страниц
+	LastMod   time.Time // LastMod время изменения страницы из sitemap, если известно
+}
@@ -0,0 +1,69 @@
+package crawler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/larek-tech/diploma/data/internal/domain/site"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// removePage удаляет страницу вместе с ее документами и чанками.
+func (s Service) removePage(ctx context.Context, page *site.Page) error {
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		return s.pageStore.Delete(ctx, page.ID)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete page: %w", err)
+	}
+	return nil
+}
+
+// CleanupSite удаляет страницы сайта, которые не встретились при завершенном обходе siteJobID, и url самого обхода.
+// Если обход был остановлен лимитом MaxPages, часть страниц могла остаться непросмотренной и удаляются только url обхода.
+func (s Service) CleanupSite(ctx context.Context, siteID, siteJobID string) (int, error) {
+	ctx, span := s.tracer.Start(ctx, "crawlerService.CleanupSite", trace.WithAttributes(
+		attribute.String("siteID", siteID),
+		attribute.String("siteJobID", siteJobID),
+	))
+	defer span.End()
+
+	if s.cfg.MaxPages > 0 {
+		registered, err := s.pageJobStore.GetRegisteredURLCount(ctx, siteJobID)
+		if err != nil {
+			err = fmt.Errorf("failed to get registered url count: %w", err)
+			span.RecordError(err)
+			return 0, err
+		}
+		if registered >= s.cfg.MaxPages {
+			slog.Info("crawl reached max pages limit, skipping cleanup", "siteID", siteID, "siteJobID", siteJobID)
+			if err = s.pageJobStore.DeleteURLs(ctx, siteJobID); err != nil {
+				err = fmt.Errorf("failed to delete crawl urls: %w", err)
+				span.RecordError(err)
+				return 0, err
+			}
+			return 0, nil
+		}
+	}
+
+	var deleted []string
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		var txErr error
+		deleted, txErr = s.pageStore.DeleteVanished(ctx, siteID, siteJobID)
+		if txErr != nil {
+			return fmt.Errorf("failed to delete vanished pages: %w", txErr)
+		}
+		if txErr = s.pageJobStore.DeleteURLs(ctx, siteJobID); txErr != nil {
+			return fmt.Errorf("failed to delete crawl urls: %w", txErr)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+	span.SetAttributes(attribute.Int("deleted", len(deleted)))
+	return len(deleted), nil
+}
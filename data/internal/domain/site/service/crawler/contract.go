@@ -3,6 +3,7 @@ package crawler
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/larek-tech/diploma/data/internal/domain/site"
 )
@@ -23,8 +24,17 @@ type (
 		GetByID(ctx context.Context, id string) (*site.Page, error)
 		GetByURL(ctx context.Context, url string) (*site.Page, error)
 		Save(ctx context.Context, page *site.Page) error
+		Delete(ctx context.Context, id string) error
+		DeleteVanished(ctx context.Context, siteID, siteJobID string) ([]string, error)
 	}
 	pageJobStore interface {
 		IsAlreadyParsed(ctx context.Context, parseSiteJobID string) (bool, error)
+		RegisterURLs(ctx context.Context, siteID, parseSiteJobID string, urls []string) ([]string, error)
+		GetRegisteredURLCount(ctx context.Context, parseSiteJobID string) (int, error)
+		DeleteURLs(ctx context.Context, parseSiteJobID string) error
+	}
+	hostStore interface {
+		// Reserve резервирует следующий запрос к хосту и возвращает время ожидания до него
+		Reserve(ctx context.Context, host string, interval time.Duration) (time.Duration, error)
 	}
 )
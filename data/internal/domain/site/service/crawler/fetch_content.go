@@ -3,6 +3,7 @@ package crawler
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -15,7 +16,14 @@ import (
 	"github.com/larek-tech/diploma/data/internal/domain/site"
 )
 
-func (s Service) fetchContent(ctx context.Context, page *site.Page) ([]string, error) {
+var (
+	errNotModified = errors.New("page is not modified")
+	errPageGone    = errors.New("page is gone")
+)
+
+// fetchContent загружает страницу, для ранее сохраненной страницы запрос выполняется условно.
+// Возвращает errNotModified при ответе 304 и errPageGone, если страница удалена с сайта.
+func (s Service) fetchContent(ctx context.Context, page *site.Page, stored *site.Page, crawlDelay time.Duration) ([]string, error) {
 	ctx, span := s.tracer.Start(ctx, "crawlerService.fetchContent", trace.WithAttributes(
 		attribute.String("url", page.URL),
 		attribute.String("pageID", page.ID),
@@ -28,9 +36,24 @@ func (s Service) fetchContent(ctx context.Context, page *site.Page) ([]string, e
 		return nil, err
 	}
 
-	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; DataEngineCrawler/1.0)")
+	req.Header.Set("User-Agent", userAgent)
+	if stored != nil && stored.ContentHash != "" {
+		if stored.ETag != "" {
+			req.Header.Set("If-None-Match", stored.ETag)
+		}
+		if stored.LastModified != "" {
+			req.Header.Set("If-Modified-Since", stored.LastModified)
+		}
+	}
 
+	release, err := s.limiter.acquire(ctx, req.URL.Host, crawlDelay)
+	if err != nil {
+		err = fmt.Errorf("wait for host limiter error: %w", err)
+		span.RecordError(err)
+		return nil, err
+	}
 	resp, err := s.httpClient.Do(req)
+	release()
 	if err != nil {
 		err = fmt.Errorf("http request error: %w", err)
 		span.RecordError(err)
@@ -38,6 +61,17 @@ func (s Service) fetchContent(ctx context.Context, page *site.Page) ([]string, e
 	}
 	defer resp.Body.Close()
 
+	switch {
+	case resp.StatusCode == http.StatusNotModified:
+		return nil, errNotModified
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return nil, errPageGone
+	case resp.StatusCode >= http.StatusBadRequest:
+		err = fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		span.RecordError(err)
+		return nil, err
+	}
+
 	// Read response body
 	raw, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -65,10 +99,14 @@ func (s Service) fetchContent(ctx context.Context, page *site.Page) ([]string, e
 		return nil, err
 	}
 
+	links := extractLinks(doc, page.URL)
+
 	page.Raw = rawContent
 	page.Metadata = metadata
+	page.ETag = resp.Header.Get("ETag")
+	page.LastModified = resp.Header.Get("Last-Modified")
+	page.ContentHash = contentHash(doc)
 	page.UpdatedAt = time.Now()
 
-	links := extractLinks(doc, page.URL)
 	return links, nil
 }
@@ -1,6 +1,10 @@
 package crawler
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"log/slog"
 
 	"net/url"
@@ -60,3 +64,28 @@ func extractLinks(doc *goquery.Document, pageUrl string) []string {
 	})
 	return links
 }
+
+// linksFromRaw извлекает ссылки из ранее сохраненного содержимого страницы.
+func linksFromRaw(raw string, pageUrl string) ([]string, error) {
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader([]byte(raw)))
+	if err != nil {
+		return nil, fmt.Errorf("create goquery document error: %w", err)
+	}
+	return extractLinks(doc, pageUrl), nil
+}
+
+// contentHash считает хэш видимого текста страницы без скриптов, стилей и различий в пробелах,
+// чтобы изменения разметки не приводили к повторной обработке.
+// Удаляет элементы из doc, поэтому вызывается после извлечения ссылок.
+func contentHash(doc *goquery.Document) string {
+	doc.Find("script, style, noscript, template").Remove()
+	// текстовые узлы берутся по отдельности, иначе текст соседних блоков склеивается
+	words := make([]string, 0)
+	doc.Find("*").Contents().Each(func(_ int, sel *goquery.Selection) {
+		if goquery.NodeName(sel) == "#text" {
+			words = append(words, strings.Fields(sel.Text())...)
+		}
+	})
+	sum := sha256.Sum256([]byte(strings.Join(words, " ")))
+	return hex.EncodeToString(sum[:])
+}
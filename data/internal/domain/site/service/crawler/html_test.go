@@ -0,0 +1,30 @@
+package crawler
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+)
+
+func hashOf(t *testing.T, raw string) string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(raw))
+	assert.NoError(t, err)
+	return contentHash(doc)
+}
+
+func TestContentHash(t *testing.T) {
+	base := hashOf(t, `<html><body><h1>Отчет</h1><p>Выручка за май</p></body></html>`)
+
+	same := hashOf(t, `<html><head><script>var ts = 1717000000;</script></head>
+<body>
+	<h1>Отчет</h1>
+	<p>Выручка   за май</p>
+	<style>p { color: red; }</style>
+</body></html>`)
+	assert.Equal(t, base, same)
+
+	changed := hashOf(t, `<html><body><h1>Отчет</h1><p>Выручка за июнь</p></body></html>`)
+	assert.NotEqual(t, base, changed)
+}
@@ -0,0 +1,115 @@
+package crawler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/larek-tech/diploma/data/internal/domain/site"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// skippedExtensions ссылки на файлы, которые не являются html страницами
+var skippedExtensions = map[string]struct{}{
+	".jpg": {}, ".jpeg": {}, ".png": {}, ".gif": {}, ".svg": {}, ".webp": {}, ".ico": {},
+	".css": {}, ".js": {}, ".json": {}, ".xml": {},
+	".zip": {}, ".gz": {}, ".tar": {}, ".rar": {}, ".7z": {},
+	".mp3": {}, ".mp4": {}, ".avi": {}, ".mov": {},
+	".pdf": {}, ".doc": {}, ".docx": {}, ".xls": {}, ".xlsx": {}, ".ppt": {}, ".pptx": {},
+}
+
+// discoverPages отбирает ссылки страницы, которые еще не были поставлены в очередь в рамках обхода.
+func (s Service) discoverPages(ctx context.Context, currentSite *site.Site, page *site.Page, links []string, parseSiteJobID string) ([]*site.Page, error) {
+	ctx, span := s.tracer.Start(ctx, "crawlerService.discoverPages", trace.WithAttributes(
+		attribute.String("siteID", currentSite.ID),
+		attribute.String("pageID", page.ID),
+		attribute.Int("links", len(links)),
+	))
+	defer span.End()
+
+	siteURL, err := url.Parse(currentSite.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse site url: %w", err)
+	}
+	candidates := filterLinks(siteURL, links)
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	if s.cfg.MaxPages > 0 {
+		registered, err := s.pageJobStore.GetRegisteredURLCount(ctx, parseSiteJobID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get registered url count: %w", err)
+		}
+		left := s.cfg.MaxPages - registered
+		if left <= 0 {
+			slog.Debug("max pages limit reached", "siteJobID", parseSiteJobID, "limit", s.cfg.MaxPages)
+			return nil, nil
+		}
+		if len(candidates) > left {
+			candidates = candidates[:left]
+		}
+	}
+
+	newURLs, err := s.pageJobStore.RegisterURLs(ctx, currentSite.ID, parseSiteJobID, candidates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register urls: %w", err)
+	}
+
+	pages := make([]*site.Page, 0, len(newURLs))
+	for _, u := range newURLs {
+		p, err := site.NewPage(currentSite.ID, u)
+		if err != nil {
+			slog.Error("failed to create page", "url", u, "error", err)
+			continue
+		}
+		pages = append(pages, p)
+	}
+	span.SetAttributes(attribute.Int("discovered", len(pages)))
+	return pages, nil
+}
+
+// filterLinks оставляет уникальные http(s) ссылки на тот же хост, что и у сайта.
+func filterLinks(siteURL *url.URL, links []string) []string {
+	seen := make(map[string]struct{}, len(links))
+	res := make([]string, 0, len(links))
+	for _, link := range links {
+		normalized, ok := normalizeLink(siteURL, link)
+		if !ok {
+			continue
+		}
+		if _, exists := seen[normalized]; exists {
+			continue
+		}
+		seen[normalized] = struct{}{}
+		res = append(res, normalized)
+	}
+	return res
+}
+
+func normalizeLink(siteURL *url.URL, link string) (string, bool) {
+	u, err := url.Parse(strings.TrimSpace(link))
+	if err != nil {
+		return "", false
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", false
+	}
+	if !strings.EqualFold(u.Hostname(), siteURL.Hostname()) {
+		return "", false
+	}
+	if _, skip := skippedExtensions[strings.ToLower(path.Ext(u.Path))]; skip {
+		return "", false
+	}
+	u.Fragment = ""
+	u.RawFragment = ""
+	u.Host = strings.ToLower(u.Host)
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	return u.String(), true
+}
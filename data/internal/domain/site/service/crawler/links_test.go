@@ -0,0 +1,30 @@
+package crawler
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterLinks(t *testing.T) {
+	siteURL, err := url.Parse("https://example.com/docs")
+	assert.NoError(t, err)
+
+	links := []string{
+		"https://example.com/docs/intro",
+		"https://EXAMPLE.com/docs/intro",
+		"https://example.com",
+		"https://other.com/page",
+		"mailto:info@example.com",
+		"https://example.com/files/report.pdf",
+		"http://example.com/about?lang=ru",
+	}
+
+	res := filterLinks(siteURL, links)
+	assert.Equal(t, []string{
+		"https://example.com/docs/intro",
+		"https://example.com/",
+		"http://example.com/about?lang=ru",
+	}, res)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"net/url"
 	"time"
 
 	"github.com/larek-tech/diploma/data/internal/domain/site"
@@ -12,7 +14,10 @@ import (
 const ParsingDelta = time.Hour * 12
 
 // ParsePage parses the page and returns a list of outgoing pages.
-func (s Service) ParsePage(ctx context.Context, page *site.Page, parseSiteJobID string) ([]*site.Page, bool, error) {
+// Outgoing pages are returned until cfg.MaxDepth is reached, including for pages that are not modified.
+// The bool result reports whether the page content changed and has to be embedded again;
+// it is false for pages skipped by robots.txt, not modified since the previous crawl or removed from the site.
+func (s Service) ParsePage(ctx context.Context, page *site.Page, task site.PageTask) ([]*site.Page, bool, error) {
 	ctx, span := s.tracer.Start(ctx, "ParsePage")
 	defer span.End()
 
@@ -26,20 +31,85 @@ func (s Service) ParsePage(ctx context.Context, page *site.Page, parseSiteJobID
 		return nil, false, errors.New("page already parsed")
 	}
 
-	_, err = s.fetchContent(ctx, page)
+	pageURL, err := url.Parse(page.URL)
 	if err != nil {
-		return nil, false, fmt.Errorf("failed to fetch content: %w", err)
+		return nil, false, fmt.Errorf("failed to parse page url: %w", err)
 	}
-	if page.Raw == "" {
-		return nil, false, fmt.Errorf("page raw content is empty")
+	rules := s.getRobots(ctx, pageURL)
+	if !rules.allowed(pageURL) {
+		page.SkipReason = site.SkipReasonRobots
+		err = s.pageStore.Save(ctx, page)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to save skipped page: %w", err)
+		}
+		slog.Info("page is disallowed by robots.txt", "url", page.URL)
+		return nil, false, nil
 	}
 
-	err = s.pageStore.Save(ctx, page)
+	stored, err := s.pageStore.GetByURL(ctx, page.URL)
 	if err != nil {
-		return nil, false, fmt.Errorf("failed to save page: %w", err)
+		return nil, false, fmt.Errorf("failed to get stored page: %w", err)
+	}
+	if stored != nil && stored.ContentHash != "" && !task.LastMod.IsZero() && !task.LastMod.After(stored.UpdatedAt) {
+		// sitemap сообщает, что страница не менялась с последнего обхода
+		slog.Debug("page is not modified according to sitemap", "url", page.URL)
+		links, err := linksFromRaw(stored.Raw, stored.URL)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to extract links from stored page: %w", err)
+		}
+		return s.outgoingPages(ctx, page, task, links), false, nil
+	}
+
+	changed := true
+	links, err := s.fetchContent(ctx, page, stored, rules.crawlDelay)
+	switch {
+	case errors.Is(err, errPageGone):
+		if stored != nil {
+			if err = s.removePage(ctx, stored); err != nil {
+				return nil, false, err
+			}
+		}
+		slog.Info("page is removed from site", "url", page.URL)
+		return nil, false, nil
+	case errors.Is(err, errNotModified):
+		changed = false
+		links, err = linksFromRaw(stored.Raw, stored.URL)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to extract links from stored page: %w", err)
+		}
+	case err != nil:
+		return nil, false, fmt.Errorf("failed to fetch content: %w", err)
+	default:
+		if page.Raw == "" {
+			return nil, false, fmt.Errorf("page raw content is empty")
+		}
+		changed = stored == nil || stored.ContentHash != page.ContentHash
+		err = s.pageStore.Save(ctx, page)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to save page: %w", err)
+		}
 	}
 
-	return nil, true, nil
+	return s.outgoingPages(ctx, page, task, links), changed, nil
+}
+
+// outgoingPages возвращает найденные на странице страницы сайта, которые еще не были поставлены в очередь обхода.
+// Ошибки логируются: без ссылок страница все равно считается обработанной.
+func (s Service) outgoingPages(ctx context.Context, page *site.Page, task site.PageTask, links []string) []*site.Page {
+	if s.cfg.MaxDepth > 0 && task.Depth >= s.cfg.MaxDepth {
+		return nil
+	}
+	currentSite, err := s.siteStore.GetByID(ctx, page.SiteID)
+	if err != nil || currentSite == nil {
+		slog.Error("failed to get site for page", "siteID", page.SiteID, "error", err)
+		return nil
+	}
+	outgoing, err := s.discoverPages(ctx, currentSite, page, links, task.SiteJobID)
+	if err != nil {
+		slog.Error("failed to discover outgoing pages", "pageID", page.ID, "error", err)
+		return nil
+	}
+	return outgoing
 }
 
 func validate(page *site.Page) error {
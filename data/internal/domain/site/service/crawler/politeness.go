@@ -0,0 +1,76 @@
+package crawler
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// hostLimiter ограничивает число одновременных запросов процесса к одному хосту и частоту запросов к хосту.
+// Частота ограничивается общим для всех процессов краулера состоянием в hostStore,
+// поэтому интервал соблюдается при любом количестве запущенных парсеров.
+type hostLimiter struct {
+	mu          sync.Mutex
+	hosts       map[string]chan struct{}
+	concurrency int
+	interval    time.Duration
+	store       hostStore
+}
+
+func newHostLimiter(concurrency int, interval time.Duration, store hostStore) *hostLimiter {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	return &hostLimiter{
+		hosts:       make(map[string]chan struct{}),
+		concurrency: concurrency,
+		interval:    interval,
+		store:       store,
+	}
+}
+
+func (l *hostLimiter) sem(host string) chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	sem, ok := l.hosts[host]
+	if !ok {
+		sem = make(chan struct{}, l.concurrency)
+		l.hosts[host] = sem
+	}
+	return sem
+}
+
+// acquire ждет свободный слот и резервирует время запроса к хосту с учетом интервала.
+// crawlDelay из robots.txt применяется, если он больше настроенного интервала.
+func (l *hostLimiter) acquire(ctx context.Context, host string, crawlDelay time.Duration) (func(), error) {
+	host = strings.ToLower(host)
+	sem := l.sem(host)
+	select {
+	case sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	release := func() { <-sem }
+
+	interval := max(l.interval, crawlDelay)
+	if interval <= 0 {
+		return release, nil
+	}
+	wait, err := l.store.Reserve(ctx, host, interval)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+	return release, nil
+}
@@ -0,0 +1,60 @@
+package crawler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeHostStore struct {
+	reserved []time.Duration
+	wait     time.Duration
+	err      error
+}
+
+func (s *fakeHostStore) Reserve(_ context.Context, _ string, interval time.Duration) (time.Duration, error) {
+	s.reserved = append(s.reserved, interval)
+	return s.wait, s.err
+}
+
+func TestHostLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no interval", func(t *testing.T) {
+		store := &fakeHostStore{}
+		release, err := newHostLimiter(1, 0, store).acquire(ctx, "example.com", 0)
+		assert.NoError(t, err)
+		release()
+		assert.Empty(t, store.reserved)
+	})
+
+	t.Run("crawl delay", func(t *testing.T) {
+		store := &fakeHostStore{}
+		release, err := newHostLimiter(1, time.Second, store).acquire(ctx, "example.com", 2*time.Second)
+		assert.NoError(t, err)
+		release()
+		assert.Equal(t, []time.Duration{2 * time.Second}, store.reserved)
+	})
+
+	t.Run("store error releases slot", func(t *testing.T) {
+		store := &fakeHostStore{err: errors.New("db is down")}
+		l := newHostLimiter(1, time.Second, store)
+		_, err := l.acquire(ctx, "example.com", 0)
+		assert.Error(t, err)
+		store.err = nil
+		release, err := l.acquire(ctx, "example.com", 0)
+		assert.NoError(t, err)
+		release()
+	})
+
+	t.Run("cancelled wait", func(t *testing.T) {
+		store := &fakeHostStore{wait: time.Hour}
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		_, err := newHostLimiter(1, time.Second, store).acquire(ctx, "example.com", 0)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
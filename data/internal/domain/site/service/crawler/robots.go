@@ -0,0 +1,253 @@
+package crawler
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	userAgent      = "Mozilla/5.0 (compatible; DataEngineCrawler/1.0)"
+	robotsAgent    = "DataEngineCrawler"
+	robotsTTL      = time.Hour * 24
+	robotsErrorTTL = time.Minute * 10
+	robotsMaxSize  = 512 * 1024
+)
+
+type robotsRule struct {
+	allow   bool
+	pattern string
+	re      *regexp.Regexp
+}
+
+// robotsRules правила robots.txt, применимые к краулеру
+type robotsRules struct {
+	rules      []robotsRule
+	crawlDelay time.Duration
+}
+
+// allowed проверяет путь по правилу самого длинного совпадения, при равной длине побеждает Allow.
+func (r *robotsRules) allowed(u *url.URL) bool {
+	if r == nil {
+		return true
+	}
+	target := u.EscapedPath()
+	if target == "" {
+		target = "/"
+	}
+	if u.RawQuery != "" {
+		target += "?" + u.RawQuery
+	}
+	matchLen := -1
+	allow := true
+	for _, rule := range r.rules {
+		if !rule.re.MatchString(target) {
+			continue
+		}
+		l := len(rule.pattern)
+		if l > matchLen || (l == matchLen && rule.allow) {
+			matchLen = l
+			allow = rule.allow
+		}
+	}
+	return allow
+}
+
+// compileRobotsPattern поддерживает '*' и завершающий '$' из спецификации robots.txt.
+func compileRobotsPattern(pattern string) (*regexp.Regexp, error) {
+	anchored := strings.HasSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(pattern, "$")
+	parts := strings.Split(pattern, "*")
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+	expr := "^" + strings.Join(parts, ".*")
+	if anchored {
+		expr += "$"
+	}
+	return regexp.Compile(expr)
+}
+
+// matchesAgent сравнивает значение user-agent с токеном продукта краулера по RFC 9309:
+// токен состоит из букв, '-' и '_', сравнение без учета регистра, остаток значения (например, версия) игнорируется.
+func matchesAgent(value string) bool {
+	end := strings.IndexFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r == '-' || r == '_')
+	})
+	if end >= 0 {
+		value = value[:end]
+	}
+	return value != "" && strings.EqualFold(value, robotsAgent)
+}
+
+// parseRobots выбирает группу для DataEngineCrawler, а при ее отсутствии группу '*'.
+func parseRobots(content string) *robotsRules {
+	var (
+		specific, wildcard *robotsRules
+		current            []*robotsRules
+		inAgents           bool
+	)
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		if key == "user-agent" {
+			if !inAgents {
+				current = nil
+			}
+			inAgents = true
+			switch {
+			case value == "*":
+				if wildcard == nil {
+					wildcard = &robotsRules{}
+				}
+				current = append(current, wildcard)
+			case matchesAgent(value):
+				if specific == nil {
+					specific = &robotsRules{}
+				}
+				current = append(current, specific)
+			}
+			continue
+		}
+		inAgents = false
+		for _, group := range current {
+			switch key {
+			case "allow", "disallow":
+				if value == "" {
+					continue
+				}
+				re, err := compileRobotsPattern(value)
+				if err != nil {
+					continue
+				}
+				group.rules = append(group.rules, robotsRule{allow: key == "allow", pattern: value, re: re})
+			case "crawl-delay":
+				seconds, err := strconv.ParseFloat(value, 64)
+				if err == nil && seconds > 0 {
+					group.crawlDelay = time.Duration(seconds * float64(time.Second))
+				}
+			}
+		}
+	}
+	if specific != nil {
+		return specific
+	}
+	if wildcard != nil {
+		return wildcard
+	}
+	return &robotsRules{}
+}
+
+type robotsEntry struct {
+	rules   *robotsRules
+	expires time.Time
+}
+
+// robotsCache кэш robots.txt по хостам
+type robotsCache struct {
+	mu      sync.Mutex
+	entries map[string]robotsEntry
+}
+
+func newRobotsCache() *robotsCache {
+	return &robotsCache{
+		entries: make(map[string]robotsEntry),
+	}
+}
+
+func (c *robotsCache) get(host string) (*robotsRules, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[host]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.rules, true
+}
+
+func (c *robotsCache) set(host string, rules *robotsRules, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[host] = robotsEntry{
+		rules:   rules,
+		expires: time.Now().Add(ttl),
+	}
+}
+
+// getRobots возвращает правила robots.txt для хоста страницы, загружая их при отсутствии в кэше.
+func (s Service) getRobots(ctx context.Context, pageURL *url.URL) *robotsRules {
+	host := strings.ToLower(pageURL.Host)
+	if rules, ok := s.robots.get(host); ok {
+		return rules
+	}
+	ctx, span := s.tracer.Start(ctx, "crawlerService.getRobots", trace.WithAttributes(
+		attribute.String("host", host),
+	))
+	defer span.End()
+
+	rules, err := s.fetchRobots(ctx, pageURL)
+	if err != nil {
+		// robots.txt недоступен, не блокируем обход, но повторим попытку раньше
+		slog.Warn("failed to fetch robots.txt", "host", host, "error", err)
+		span.RecordError(err)
+		s.robots.set(host, &robotsRules{}, robotsErrorTTL)
+		return &robotsRules{}
+	}
+	s.robots.set(host, rules, robotsTTL)
+	return rules
+}
+
+func (s Service) fetchRobots(ctx context.Context, pageURL *url.URL) (*robotsRules, error) {
+	robotsURL := url.URL{
+		Scheme: pageURL.Scheme,
+		Host:   pageURL.Host,
+		Path:   "/robots.txt",
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, robotsURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request error: %w", err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http request error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 500:
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	case resp.StatusCode >= 300:
+		// robots.txt отсутствует, ограничений нет
+		return &robotsRules{}, nil
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, robotsMaxSize))
+	if err != nil {
+		return nil, fmt.Errorf("read response body error: %w", err)
+	}
+	return parseRobots(string(raw)), nil
+}
@@ -0,0 +1,72 @@
+package crawler
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testRobots = `
+User-agent: *
+Disallow: /
+
+User-agent: Googlebot
+User-agent: DataEngineCrawler
+Disallow: /private/
+Allow: /private/public$
+Disallow: /*.php
+Crawl-delay: 1.5
+`
+
+func TestParseRobots(t *testing.T) {
+	rules := parseRobots(testRobots)
+	assert.Equal(t, time.Millisecond*1500, rules.crawlDelay)
+
+	cases := map[string]bool{
+		"https://example.com/":                   true,
+		"https://example.com/docs":               true,
+		"https://example.com/private/":           false,
+		"https://example.com/private/public":     true,
+		"https://example.com/private/public/doc": false,
+		"https://example.com/index.php?id=1":     false,
+	}
+	for raw, expected := range cases {
+		u, err := url.Parse(raw)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, rules.allowed(u), raw)
+	}
+}
+
+func TestParseRobotsWildcard(t *testing.T) {
+	rules := parseRobots("User-agent: *\nDisallow: /admin\n")
+	u, err := url.Parse("https://example.com/admin/users")
+	assert.NoError(t, err)
+	assert.False(t, rules.allowed(u))
+
+	rules = parseRobots("")
+	assert.True(t, rules.allowed(u))
+}
+
+func TestMatchesAgent(t *testing.T) {
+	cases := map[string]bool{
+		"DataEngineCrawler":     true,
+		"dataenginecrawler":     true,
+		"DataEngineCrawler/1.0": true,
+		"DataEngine":            false,
+		"Data":                  false,
+		"DataEngineCrawlerBot":  false,
+		"Mozilla/5.0 (compatible; DataEngineCrawler/1.0)": false,
+		"": false,
+	}
+	for value, expected := range cases {
+		assert.Equal(t, expected, matchesAgent(value), value)
+	}
+
+	// группа для префикса токена не применяется к краулеру
+	rules := parseRobots("User-agent: Data\nDisallow: /\n")
+	u, err := url.Parse("https://example.com/docs")
+	assert.NoError(t, err)
+	assert.True(t, rules.allowed(u))
+}
@@ -1,32 +1,50 @@
 package crawler
 
 import (
+	"time"
+
 	"go.opentelemetry.io/otel/trace"
 )
 
+// Config ограничения обхода сайта
+type Config struct {
+	MaxDepth            int           // MaxDepth максимальная глубина от стартовых страниц
+	MaxPages            int           // MaxPages максимальное количество страниц в рамках одного обхода
+	HostConcurrency     int           // HostConcurrency максимальное число одновременных запросов к одному хосту
+	HostRequestInterval time.Duration // HostRequestInterval минимальный интервал между запросами к одному хосту
+}
+
 type Service struct {
+	cfg          Config
 	httpClient   httpClient
 	siteStore    siteStore
 	pageStore    pageStore
 	pageJobStore pageJobStore
 	trManager    transactionalManager
 	tracer       trace.Tracer
+	robots       *robotsCache
+	limiter      *hostLimiter
 }
 
 func New(
+	cfg Config,
 	httpClient httpClient,
 	siteStorage siteStore,
 	pageStorage pageStore,
 	pageJobStore pageJobStore,
+	hostStore hostStore,
 	trManager transactionalManager,
 	tracer trace.Tracer,
 ) *Service {
 	return &Service{
+		cfg:          cfg,
 		httpClient:   httpClient,
 		siteStore:    siteStorage,
 		pageStore:    pageStorage,
 		pageJobStore: pageJobStore,
 		trManager:    trManager,
 		tracer:       tracer,
+		robots:       newRobotsCache(),
+		limiter:      newHostLimiter(cfg.HostConcurrency, cfg.HostRequestInterval, hostStore),
 	}
 }
@@ -1,6 +1,18 @@
 package sitemap
 
-import "encoding/xml"
+import (
+	"encoding/xml"
+	"strings"
+	"time"
+)
+
+// lastModLayouts форматы W3C Datetime, допустимые в lastmod
+var lastModLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02",
+}
 
 // URLSet represents the root element of a sitemap XML
 type URLSet struct {
@@ -23,6 +35,20 @@ type URLResult struct {
 	LastMod    string `json:"lastmod,omitempty"`
 }
 
+// LastModTime возвращает время последнего изменения страницы, false если lastmod отсутствует или некорректен.
+func (r URLResult) LastModTime() (time.Time, bool) {
+	value := strings.TrimSpace(r.LastMod)
+	if value == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range lastModLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
 type SitemapIndex struct {
 	XMLName  xml.Name      `xml:"sitemapindex"`
 	Sitemaps []SitemapInfo `xml:"sitemap"`
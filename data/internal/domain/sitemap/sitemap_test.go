@@ -0,0 +1,27 @@
+package sitemap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURLResultLastModTime(t *testing.T) {
+	cases := map[string]time.Time{
+		"2025-05-20":                time.Date(2025, 5, 20, 0, 0, 0, 0, time.UTC),
+		"2025-05-20T10:30:00Z":      time.Date(2025, 5, 20, 10, 30, 0, 0, time.UTC),
+		"2025-05-20T13:30:00+03:00": time.Date(2025, 5, 20, 10, 30, 0, 0, time.UTC),
+		"2025-05-20T10:30Z":         time.Date(2025, 5, 20, 10, 30, 0, 0, time.UTC),
+	}
+	for raw, expected := range cases {
+		lastMod, ok := URLResult{LastMod: raw}.LastModTime()
+		assert.True(t, ok, raw)
+		assert.True(t, expected.Equal(lastMod), raw)
+	}
+
+	_, ok := URLResult{}.LastModTime()
+	assert.False(t, ok)
+	_, ok = URLResult{LastMod: "yesterday"}.LastModTime()
+	assert.False(t, ok)
+}
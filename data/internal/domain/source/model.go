@@ -1,9 +1,15 @@
 package source
 
 import (
+	"errors"
 	"time"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document"
 )
 
+// ErrSourceNotFound источник удален, задачи его обработки больше не нужны
+var ErrSourceNotFound = errors.New("source not found")
+
 type Type uint8
 
 const (
@@ -14,26 +20,49 @@ const (
 	S3WithCredentials
 )
 
+// Cron расписание обновления в формате cron, отрицательное значение поля означает любое значение
+type Cron struct {
+	Minute  int32 `json:"minute"`   // минута 0-59
+	Hour    int32 `json:"hour"`     // час 0-23
+	Day     int32 `json:"day"`      // день месяца 1-31
+	Month   int32 `json:"month"`    // месяц 1-12
+	WeekDay int32 `json:"week_day"` // день недели 0-6, 0 - воскресенье
+}
+
 type UpdateParams struct {
-	EveryPeriod int       `json:"every_period"` // обновлять каждые X секунд
-	OnTime      time.Time `json:"on_time"`      // обновлять при наступлении даты+времени
+	EveryPeriod *int64 `json:"every_period,omitempty"` // обновлять каждые X секунд
+	Cron        *Cron  `json:"cron,omitempty"`         // обновлять по расписанию cron
 }
 
 // отправляем в source_topic
 type DataMessage struct {
 	ExternalKey  []byte
-	Title        string       `json:"title"`
-	Content      []byte       `json:"content"` // byte-строка с url или считанный файл
-	Type         Type         `json:"type"`
-	Credentials  []byte       `json:"credentials"`
-	UpdateParams UpdateParams `json:"update_params"`
+	Title        string                   `json:"title"`
+	Content      []byte                   `json:"content"` // byte-строка с url или считанный файл
+	Type         Type                     `json:"type"`
+	Credentials  []byte                   `json:"credentials"`
+	UpdateParams *UpdateParams            `json:"update_params"`
+	Chunking     *document.ChunkingConfig `json:"chunking"`
+}
+
+// UpdateMessage получаем из source_update_topic, новое содержимое загружается в отдельное поколение индекса
+type UpdateMessage struct {
+	SourceID string `json:"source"` // uuid ID источника
+	// SettingsOnly изменены только название или расписание обновления, источник не переиндексируется
+	SettingsOnly bool `json:"settings_only,omitempty"`
+	DataMessage
 }
 
 type Source struct {
-	ID          string `db:"id"`          // ID uuid идентификатор источника
-	Title       string `db:"title"`       // Title название источника
-	Type        Type   `db:"type"`        // Type тип источника (с паролем, без пароля, архив)
-	Credentials []byte `db:"credentials"` // Credentials учетные данные для доступа к источнику
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID            string                   `db:"id"`              // ID uuid идентификатор источника
+	Title         string                   `db:"title"`           // Title название источника
+	Type          Type                     `db:"type"`            // Type тип источника (с паролем, без пароля, архив)
+	Credentials   []byte                   `db:"credentials"`     // Credentials учетные данные для доступа к источнику
+	ExternalKey   string                   `db:"external_key"`    // ExternalKey идентификатор источника во внешней системе, ключ сообщений статуса
+	Content       []byte                   `db:"content"`         // Content url веб источника, необходимый для повторного обхода
+	UpdateParams  *UpdateParams            `db:"update_params"`   // UpdateParams расписание обновления источника
+	NextRefreshAt *time.Time               `db:"next_refresh_at"` // NextRefreshAt время следующего запланированного обновления
+	Chunking      *document.ChunkingConfig `db:"chunking"`        // Chunking параметры разбиения документов на чанки, nil - по умолчанию
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
 }
@@ -0,0 +1,57 @@
+package source
+
+import "time"
+
+// ObjectState этап обработки страницы или файла источника
+type ObjectState string
+
+const (
+	StateQueued   ObjectState = "queued"   // задача на загрузку ожидает обработки
+	StateFetched  ObjectState = "fetched"  // содержимое загружено, текст еще не извлечен
+	StateParsed   ObjectState = "parsed"   // текст извлечен и ожидает индексации
+	StateEmbedded ObjectState = "embedded" // документ разбит на чанки и проиндексирован
+	StateSkipped  ObjectState = "skipped"  // объект пропущен, причина в Error
+	StateFailed   ObjectState = "failed"   // попытки обработки исчерпаны, последняя ошибка в Error
+)
+
+// ObjectType тип объекта источника
+type ObjectType string
+
+const (
+	ObjectPage ObjectType = "page"
+	ObjectFile ObjectType = "file"
+)
+
+// IngestionObject состояние обработки страницы или файла источника
+type IngestionObject struct {
+	ID         string      `db:"id"`
+	Type       ObjectType  `db:"type"`
+	Name       string      `db:"name"` // url страницы или путь файла
+	State      ObjectState `db:"state"`
+	Error      string      `db:"error"`
+	ChunkCount int         `db:"chunk_count"`
+	CreatedAt  time.Time   `db:"created_at"`
+	UpdatedAt  time.Time   `db:"updated_at"`
+}
+
+// ReportFilter параметры выборки отчета об обработке источника
+type ReportFilter struct {
+	SourceID string
+	States   []ObjectState // пустой список - все состояния
+	Type     ObjectType    // пустое значение - все типы
+	Page     int
+	Size     int
+}
+
+// StateCount количество объектов источника в состоянии
+type StateCount struct {
+	State ObjectState `db:"state"`
+	Count int         `db:"count"`
+}
+
+// IngestionReport отчет об обработке объектов строящегося поколения источника
+type IngestionReport struct {
+	Total   int          // количество объектов, подходящих под фильтр
+	Counts  []StateCount // количество объектов по состояниям без учета фильтра по состоянию
+	Objects []*IngestionObject
+}
@@ -0,0 +1,76 @@
+package source
+
+import "time"
+
+// maxCronLookupDays глубина поиска следующего срабатывания, покрывает 29 февраля
+const maxCronLookupDays = 366 * 4
+
+// Next возвращает время следующего обновления после after, false если расписание не задано.
+func (p *UpdateParams) Next(after time.Time) (time.Time, bool) {
+	if p == nil {
+		return time.Time{}, false
+	}
+	if p.EveryPeriod != nil {
+		if *p.EveryPeriod <= 0 {
+			return time.Time{}, false
+		}
+		return after.Add(time.Duration(*p.EveryPeriod) * time.Second).Truncate(time.Second), true
+	}
+	if p.Cron != nil {
+		return p.Cron.Next(after)
+	}
+	return time.Time{}, false
+}
+
+// Next возвращает ближайшую минуту строго после after, подходящую под расписание.
+// Как и в cron, если заданы и день месяца, и день недели, достаточно совпадения одного из них.
+func (c Cron) Next(after time.Time) (time.Time, bool) {
+	start := after.Truncate(time.Minute).Add(time.Minute)
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	for i := 0; i <= maxCronLookupDays; i++ {
+		d := day.AddDate(0, 0, i)
+		if !c.matchDay(d) {
+			continue
+		}
+		for _, hour := range cronValues(c.Hour, 0, 23) {
+			for _, minute := range cronValues(c.Minute, 0, 59) {
+				candidate := time.Date(d.Year(), d.Month(), d.Day(), hour, minute, 0, 0, d.Location())
+				if !candidate.Before(start) {
+					return candidate, true
+				}
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+func (c Cron) matchDay(d time.Time) bool {
+	if c.Month > 0 && int32(d.Month()) != c.Month {
+		return false
+	}
+	dayRestricted := c.Day > 0
+	weekDayRestricted := c.WeekDay >= 0
+	switch {
+	case dayRestricted && weekDayRestricted:
+		return int32(d.Day()) == c.Day || int32(d.Weekday()) == c.WeekDay%7
+	case dayRestricted:
+		return int32(d.Day()) == c.Day
+	case weekDayRestricted:
+		return int32(d.Weekday()) == c.WeekDay%7
+	}
+	return true
+}
+
+func cronValues(value int32, from, to int) []int {
+	if value >= 0 {
+		if int(value) < from || int(value) > to {
+			return nil
+		}
+		return []int{int(value)}
+	}
+	values := make([]int, 0, to-from+1)
+	for v := from; v <= to; v++ {
+		values = append(values, v)
+	}
+	return values
+}
@@ -0,0 +1,71 @@
+package source
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateParamsNextEveryPeriod(t *testing.T) {
+	period := int64(3600)
+	after := time.Date(2025, 6, 1, 10, 15, 30, 0, time.UTC)
+
+	next, ok := (&UpdateParams{EveryPeriod: &period}).Next(after)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2025, 6, 1, 11, 15, 30, 0, time.UTC), next)
+
+	_, ok = (*UpdateParams)(nil).Next(after)
+	assert.False(t, ok)
+}
+
+func TestCronNext(t *testing.T) {
+	after := time.Date(2025, 6, 1, 10, 15, 0, 0, time.UTC) // воскресенье
+
+	cases := []struct {
+		name     string
+		cron     Cron
+		expected time.Time
+	}{
+		{
+			name:     "daily",
+			cron:     Cron{Minute: 0, Hour: 3, Day: -1, Month: -1, WeekDay: -1},
+			expected: time.Date(2025, 6, 2, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "later today",
+			cron:     Cron{Minute: 30, Hour: 10, Day: -1, Month: -1, WeekDay: -1},
+			expected: time.Date(2025, 6, 1, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "every minute",
+			cron:     Cron{Minute: -1, Hour: -1, Day: -1, Month: -1, WeekDay: -1},
+			expected: time.Date(2025, 6, 1, 10, 16, 0, 0, time.UTC),
+		},
+		{
+			name:     "weekly on monday",
+			cron:     Cron{Minute: 0, Hour: 9, Day: -1, Month: -1, WeekDay: 1},
+			expected: time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "yearly",
+			cron:     Cron{Minute: 0, Hour: 0, Day: 1, Month: 1, WeekDay: -1},
+			expected: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "leap day",
+			cron:     Cron{Minute: 0, Hour: 0, Day: 29, Month: 2, WeekDay: -1},
+			expected: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			next, ok := tc.cron.Next(after)
+			assert.True(t, ok)
+			assert.Equal(t, tc.expected, next)
+		})
+	}
+
+	_, ok := Cron{Minute: 0, Hour: 0, Day: 31, Month: 2, WeekDay: -1}.Next(after)
+	assert.False(t, ok)
+}
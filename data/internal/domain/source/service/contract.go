@@ -3,8 +3,10 @@ package service
 import (
 	"context"
 	"net/url"
+	"time"
 
 	"github.com/larek-tech/diploma/data/internal/domain/file"
+	"github.com/larek-tech/diploma/data/internal/domain/object_store"
 	"github.com/larek-tech/diploma/data/internal/domain/sitemap"
 	"github.com/larek-tech/diploma/data/internal/domain/source"
 	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
@@ -15,10 +17,30 @@ type (
 		GetByName(ctx context.Context, name string) (*source.Source, error)
 		GetByID(ctx context.Context, id string) (*source.Source, error)
 		Save(ctx context.Context, source *source.Source) error
+		ClaimRefresh(ctx context.Context, id string, scheduledFor time.Time, next *time.Time) (bool, error)
+		Delete(ctx context.Context, id string) error
+		BeginGeneration(ctx context.Context, id string) (int, error)
+		TargetGeneration(ctx context.Context, id string) (int, error)
+		SwapGeneration(ctx context.Context, id string, generation int) (int, bool, error)
+		DeleteStaleGenerations(ctx context.Context, id string, generation int) error
 	}
 	fileStorage interface {
 		GetByID(ctx context.Context, id string) (*file.File, error)
+		GetBySourceID(ctx context.Context, sourceID string) ([]*file.File, error)
+		GetTargetBySourceID(ctx context.Context, sourceID string) ([]*file.File, error)
+		GetStaleBySourceID(ctx context.Context, sourceID string, generation int) ([]*file.File, error)
 		Save(ctx context.Context, file *file.File) error
+		SaveRejected(ctx context.Context, file *file.File, reason string) error
+		DeleteObjects(ctx context.Context, files []*file.File)
+	}
+	pageStorage interface {
+		GetIDsBySourceID(ctx context.Context, sourceID string) ([]string, error)
+		GetStaleIDs(ctx context.Context, sourceID string, generation int) ([]string, error)
+		DeleteObjects(ctx context.Context, ids []string)
+	}
+	objectStoreStorage interface {
+		SaveStore(ctx context.Context, store *object_store.ObjectStore) error
+		GetStoreBySourceID(ctx context.Context, sourceID string) (*object_store.ObjectStore, error)
 	}
 
 	publisher interface {
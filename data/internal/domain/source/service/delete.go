@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/larek-tech/diploma/data/internal/domain/file"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DeleteSource удаляет источник вместе с сайтами, url их обходов, страницами, файлами, документами, чанками и вопросами.
+// Записи в БД удаляются одной транзакцией, поэтому поиск перестает возвращать источник сразу после ее завершения;
+// содержимое страниц и файлов удаляется из S3 после коммита. Повторное удаление не считается ошибкой.
+func (s Service) DeleteSource(ctx context.Context, id string) error {
+	ctx, span := s.tracer.Start(ctx, "sourceService.DeleteSource", trace.WithAttributes(
+		attribute.String("sourceID", id),
+	))
+	defer span.End()
+
+	var (
+		pageIDs []string
+		files   []*file.File
+	)
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		pageIDs, err = s.pageStorage.GetIDsBySourceID(ctx, id)
+		if err != nil {
+			return err
+		}
+		files, err = s.fileStorage.GetBySourceID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get source files: %w", err)
+		}
+		if err = s.sourceStorage.Delete(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete source: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	span.SetAttributes(
+		attribute.Int("pages", len(pageIDs)),
+		attribute.Int("files", len(files)),
+	)
+	s.pageStorage.DeleteObjects(ctx, pageIDs)
+	s.fileStorage.DeleteObjects(ctx, files)
+	return nil
+}
@@ -1,17 +1,27 @@
 package service
 
 import (
-	"archive/zip"
+	"context"
+	"errors"
 	"fmt"
 	"io"
-	"os"
+	"log/slog"
+	"path"
 	"path/filepath"
 	"strings"
 
+	"github.com/larek-tech/diploma/data/internal/domain/document"
 	"github.com/larek-tech/diploma/data/internal/domain/file"
+	"github.com/larek-tech/diploma/data/internal/domain/file/archive"
 	"github.com/larek-tech/diploma/data/internal/domain/source"
 )
 
+// reasonUnsupportedType файл из архива не может быть разобран ни одним парсером
+const reasonUnsupportedType archive.Reason = "unsupported file type"
+
+// ErrEmptyArchive в архиве нет ни одного файла, пригодного для обработки
+var ErrEmptyArchive = errors.New("archive has no supported files")
+
 func getFileExtension(filename string) string {
 	ext := filepath.Ext(filename)
 	if ext == "" {
@@ -27,83 +37,55 @@ func (s Service) createFile(src *source.Source, msg source.DataMessage) (*file.F
 	return f, nil
 }
 
-func (s Service) createArchive(src *source.Source, msg source.DataMessage) ([]*file.File, error) {
-	// create temp file for archive
-	tmpZipFile, err := os.CreateTemp("", "archive-*.zip")
-	if err != nil {
-		return nil, fmt.Errorf("failed to create temp zip file: %w", err)
-	}
-	defer tmpZipFile.Close()
-
-	defer os.Remove(tmpZipFile.Name())
-
-	// write archive to temp file
-	if _, err := tmpZipFile.Write(msg.Content); err != nil {
-		return nil, fmt.Errorf("failed to write archive content: %w", err)
-	}
-
-	tmpDir, err := os.MkdirTemp("", "unzipped-archive-*")
-	if err != nil {
-		return nil, fmt.Errorf("failed to create temp dir: %w", err)
-	}
-	defer os.RemoveAll(tmpDir)
-
-	archive, err := zip.OpenReader(tmpZipFile.Name())
-	if err != nil {
-		return nil, fmt.Errorf("failed to open archive: %w", err)
-	}
-	defer archive.Close()
-
-	var files []*file.File
-
-	for _, f := range archive.File {
-		filePath := filepath.Join(tmpDir, f.Name)
-		if !strings.HasPrefix(filePath, filepath.Clean(tmpDir)+string(os.PathSeparator)) {
-			return nil, fmt.Errorf("invalid file path: %s", filePath)
-		}
-		if f.FileInfo().IsDir() {
-			if err := os.MkdirAll(filePath, os.ModePerm); err != nil {
-				return nil, fmt.Errorf("failed to create dir: %w", err)
-			}
-			continue
-		}
-
-		if err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
-			return nil, fmt.Errorf("failed to create parent dir: %w", err)
+// createArchive распаковывает архив и сохраняет каждый поддерживаемый документ сразу после чтения,
+// в памяти одновременно находится содержимое только одного файла. Расширение проверяется до чтения файла.
+// Отклоненные файлы сохраняются в состоянии skipped с причиной отказа, путь внутри архива сохраняется в file.Path.
+// Возвращает сохраненные файлы без содержимого.
+func (s Service) createArchive(ctx context.Context, src *source.Source, msg source.DataMessage) ([]*file.File, error) {
+	files := make([]*file.File, 0)
+	res, err := archive.Extract(msg.Title, msg.Content, s.archiveLimits, func(entryPath string, r io.Reader) error {
+		if !isSupported(entryPath) {
+			return archive.Reject(reasonUnsupportedType)
 		}
-
-		dstFile, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, f.Mode())
+		content, err := io.ReadAll(r)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create file: %w", err)
+			return err
 		}
-
-		fileInArchive, err := f.Open()
-		if err != nil {
-			dstFile.Close()
-			return nil, fmt.Errorf("failed to open file in archive: %w", err)
+		f := newArchiveFile(src.ID, entryPath)
+		f.Raw = content
+		f.Size = int64(len(content))
+		if err = s.fileStorage.Save(ctx, f); err != nil {
+			return fmt.Errorf("failed to save file: %w", err)
 		}
-
-		content, err := io.ReadAll(fileInArchive)
-		if err != nil {
-			fileInArchive.Close()
-			dstFile.Close()
-			return nil, fmt.Errorf("failed to read file content: %w", err)
-		}
-
-		if _, err := dstFile.Write(content); err != nil {
-			fileInArchive.Close()
-			dstFile.Close()
-			return nil, fmt.Errorf("failed to write file content: %w", err)
+		f.Raw = nil
+		files = append(files, f)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range res.Rejected {
+		slog.Warn("archive entry rejected", "sourceID", src.ID, "path", r.Path, "reason", r.Reason)
+		if err = s.fileStorage.SaveRejected(ctx, newArchiveFile(src.ID, r.Path), string(r.Reason)); err != nil {
+			return nil, err
 		}
-
-		dstFile.Close()
-		fileInArchive.Close()
-
-		newFile := file.NewFile(src.ID, getFileExtension(f.Name))
-		newFile.Filename = f.Name
-		newFile.Raw = content
-		files = append(files, newFile)
 	}
-
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%w: %d entries rejected", ErrEmptyArchive, len(res.Rejected))
+	}
 	return files, nil
 }
+
+// isSupported проверяет, есть ли парсер для файла с таким расширением
+func isSupported(name string) bool {
+	_, ok := document.FileExtensionMap[strings.ToLower(path.Ext(name))]
+	return ok
+}
+
+func newArchiveFile(sourceID, entryPath string) *file.File {
+	name := path.Base(entryPath)
+	f := file.NewFile(sourceID, getFileExtension(name))
+	f.Filename = name
+	f.Path = entryPath
+	return f
+}
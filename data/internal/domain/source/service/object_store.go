@@ -0,0 +1,32 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/larek-tech/diploma/data/internal/domain/object_store"
+	"github.com/larek-tech/diploma/data/internal/domain/source"
+)
+
+// createObjectStore собирает конфигурацию бакета из учетных данных сообщения,
+// если адрес бакета не указан в учетных данных, он берется из content.
+func (s Service) createObjectStore(src *source.Source, msg source.DataMessage) (*object_store.ObjectStore, error) {
+	var cfg object_store.Config
+	if len(msg.Credentials) > 0 {
+		if err := json.Unmarshal(msg.Credentials, &cfg); err != nil {
+			return nil, fmt.Errorf("%w: %w", object_store.ErrInvalidConfig, err)
+		}
+	}
+	if cfg.Url == "" && cfg.Endpoint == "" {
+		cfg.Url = string(msg.Content)
+	}
+	if err := cfg.Normalize(); err != nil {
+		return nil, err
+	}
+	return &object_store.ObjectStore{
+		ID:       uuid.NewString(),
+		SourceID: src.ID,
+		Config:   cfg,
+	}, nil
+}
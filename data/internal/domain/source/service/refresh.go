@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/larek-tech/diploma/data/internal/domain/source"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// scheduleRefresh ставит в очередь следующее обновление источника, если у него есть расписание.
+func (s Service) scheduleRefresh(ctx context.Context, src *source.Source) error {
+	if src.NextRefreshAt == nil {
+		return nil
+	}
+	_, err := s.pub.Publish(ctx, []any{qaas.RefreshSourceJob{
+		SourceID:     src.ID,
+		ScheduledFor: *src.NextRefreshAt,
+	}}, qaas.WithQueue(qaas.RefreshSourceQueue))
+	if err != nil {
+		return fmt.Errorf("failed to publish refresh source job: %w", err)
+	}
+	return nil
+}
+
+// RefreshSource повторно запускает обработку источника по расписанию и планирует следующее обновление.
+// Возвращает nil источник, если обновление уже выполнено другой репликой или расписание было изменено.
+// Следующее обновление планируется до обработки, поэтому ошибка текущего запуска не останавливает расписание.
+func (s Service) RefreshSource(ctx context.Context, sourceID string, scheduledFor time.Time) (*source.Source, string, error) {
+	ctx, span := s.tracer.Start(ctx, "sourceService.RefreshSource", trace.WithAttributes(
+		attribute.String("sourceID", sourceID),
+		attribute.String("scheduledFor", scheduledFor.String()),
+	))
+	defer span.End()
+
+	src, err := s.sourceStorage.GetByID(ctx, sourceID)
+	if err != nil {
+		err = fmt.Errorf("failed to get source: %w", err)
+		span.RecordError(err)
+		return nil, "", err
+	}
+	if src == nil {
+		slog.Info("source for refresh not found", "sourceID", sourceID)
+		return nil, "", nil
+	}
+
+	src.NextRefreshAt = nil
+	if next, ok := src.UpdateParams.Next(time.Now()); ok {
+		src.NextRefreshAt = &next
+	}
+	claimed, err := s.sourceStorage.ClaimRefresh(ctx, src.ID, scheduledFor, src.NextRefreshAt)
+	if err != nil {
+		err = fmt.Errorf("failed to claim source refresh: %w", err)
+		span.RecordError(err)
+		return nil, "", err
+	}
+	if !claimed {
+		slog.Debug("source refresh is already claimed", "sourceID", sourceID, "scheduledFor", scheduledFor)
+		return nil, "", nil
+	}
+	if err = s.scheduleRefresh(ctx, src); err != nil {
+		// очередь задач не участвует в транзакции источника, поэтому захват откатывается,
+		// чтобы повтор задачи снова смог захватить обновление scheduledFor
+		if _, releaseErr := s.sourceStorage.ClaimRefresh(ctx, src.ID, *src.NextRefreshAt, &scheduledFor); releaseErr != nil {
+			slog.Error("failed to release source refresh claim", "sourceID", src.ID, "error", releaseErr)
+		}
+		span.RecordError(err)
+		return src, "", err
+	}
+
+	jobID, err := s.reingest(ctx, src)
+	if err != nil {
+		span.RecordError(err)
+		return src, "", err
+	}
+	return src, jobID, nil
+}
+
+// reingest публикует задачи повторной обработки источника и возвращает идентификатор запуска.
+// Задачи помечаются поколением, в которое сейчас записываются данные источника.
+func (s Service) reingest(ctx context.Context, src *source.Source) (string, error) {
+	generation, err := s.sourceStorage.TargetGeneration(ctx, src.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get source generation: %w", err)
+	}
+	switch src.Type {
+	case source.Web:
+		webSource, err := s.createSite(src, source.DataMessage{Content: src.Content})
+		if err != nil {
+			return "", fmt.Errorf("failed to create site: %w", err)
+		}
+		return s.publishSiteJob(ctx, webSource, src.ExternalKey, generation)
+	case source.SingleFile, source.ArchivedFiles:
+		// во время обновления источника повторно обрабатываются файлы нового поколения
+		files, err := s.fileStorage.GetTargetBySourceID(ctx, src.ID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get source files: %w", err)
+		}
+		if err = s.publishFileJobs(ctx, files, src.ExternalKey, generation); err != nil {
+			return "", err
+		}
+		return uuid.NewString(), nil
+	case source.S3WithCredentials:
+		store, err := s.objectStorage.GetStoreBySourceID(ctx, src.ID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get object storage: %w", err)
+		}
+		if store == nil {
+			return "", fmt.Errorf("object storage for source %s not found", src.ID)
+		}
+		jobID := uuid.NewString()
+		if err = s.publishS3Job(ctx, store, src.ExternalKey, jobID, generation); err != nil {
+			return "", err
+		}
+		return jobID, nil
+	default:
+		return "", fmt.Errorf("unsupported source type: %v", src.Type)
+	}
+}
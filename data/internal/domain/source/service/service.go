@@ -3,11 +3,12 @@ package service
 import (
 	"context"
 	"fmt"
-	"log/slog"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/larek-tech/diploma/data/internal/domain/file"
+	"github.com/larek-tech/diploma/data/internal/domain/file/archive"
+	"github.com/larek-tech/diploma/data/internal/domain/object_store"
 	"github.com/larek-tech/diploma/data/internal/domain/site"
 	"github.com/larek-tech/diploma/data/internal/domain/source"
 	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
@@ -20,29 +21,49 @@ type Service struct {
 	sitemapParser sitemapParser
 	sourceStorage sourceStorage
 	fileStorage   fileStorage
+	pageStorage   pageStorage
+	objectStorage objectStoreStorage
 	pub           publisher
 	trManager     transactionalManager
 	tracer        trace.Tracer
+	archiveLimits archive.Limits
 }
 
-func New(sourceStorage sourceStorage, fileStorage fileStorage, sitemapParser sitemapParser, pub publisher, trManager transactionalManager, tracer trace.Tracer) *Service {
+func New(sourceStorage sourceStorage, fileStorage fileStorage, pageStorage pageStorage, objectStorage objectStoreStorage, sitemapParser sitemapParser, pub publisher, trManager transactionalManager, tracer trace.Tracer, archiveLimits archive.Limits) *Service {
 	return &Service{
 		sitemapParser: sitemapParser,
 		sourceStorage: sourceStorage,
 		fileStorage:   fileStorage,
+		pageStorage:   pageStorage,
+		objectStorage: objectStorage,
 		pub:           pub,
 		trManager:     trManager,
 		tracer:        tracer,
+		archiveLimits: archiveLimits,
 	}
 }
 
 func (s Service) CreateSource(ctx context.Context, msg source.DataMessage) (*source.Source, error) {
 	src := &source.Source{
-		ID:        uuid.NewString(),
-		Title:     msg.Title,
-		Type:      msg.Type,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		ID:           uuid.NewString(),
+		Title:        msg.Title,
+		Type:         msg.Type,
+		ExternalKey:  string(msg.ExternalKey),
+		UpdateParams: msg.UpdateParams,
+		Chunking:     msg.Chunking,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	if src.Chunking != nil {
+		if err := src.Chunking.Normalize(); err != nil {
+			return nil, err
+		}
+	}
+	if src.Type == source.Web {
+		src.Content = msg.Content
+	}
+	if next, ok := src.UpdateParams.Next(time.Now()); ok {
+		src.NextRefreshAt = &next
 	}
 	ctx, span := s.tracer.Start(ctx, "sourceService.CreateSource", trace.WithAttributes(
 		attribute.String("sourceID", src.ID),
@@ -56,86 +77,11 @@ func (s Service) CreateSource(ctx context.Context, msg source.DataMessage) (*sou
 		if err != nil {
 			return err
 		}
-		switch src.Type {
-		case source.Web:
-			var webSource *site.Site
-			webSource, err = s.createSite(src, msg)
-			if err != nil {
-				return fmt.Errorf("failed to create site: %w", err)
-			}
-			publishOptions := []qaas.PublishOption{
-				qaas.WithQueue(qaas.ParseSiteQueue),
-			}
-
-			_, err = s.pub.Publish(ctx, []any{qaas.SiteJob{
-				Payload: webSource,
-				Delay:   0,
-				Metadata: map[string]any{
-					"siteJobID":   uuid.NewString(),
-					"externalKey": string(msg.ExternalKey),
-				},
-			}}, publishOptions...)
-			if err != nil {
-				return fmt.Errorf("failed to publish site job: %w", err)
-			}
-		case source.S3WithCredentials:
-			slog.Info("creating s3 source", "source", src)
-		case source.SingleFile:
-			var file *file.File
-			file, err := s.createFile(src, msg)
-			if err != nil {
-				return fmt.Errorf("failed to create file: %w", err)
-			}
-			err = s.fileStorage.Save(ctx, file)
-			if err != nil {
-				return fmt.Errorf("failed to save file: %w", err)
-			}
-			publishOptions := []qaas.PublishOption{
-				qaas.WithQueue(qaas.ParseFileQueue),
-				qaas.WithSourceQueue(qaas.ParseFileQueue),
-			}
-			_, err = s.pub.Publish(ctx, []any{qaas.FileJob{
-				Payload: file,
-				Delay:   0,
-				Metadata: map[string]any{
-					"externalKey": string(msg.ExternalKey),
-				},
-			}}, publishOptions...)
-			if err != nil {
-				return fmt.Errorf("failed to publish file job: %w", err)
-			}
-		case source.ArchivedFiles:
-			files, err := s.createArchive(src, msg)
-			if err != nil {
-				return fmt.Errorf("failed to create archive: %w", err)
-			}
-			var jobs []any
-			for _, f := range files {
-				err = s.fileStorage.Save(ctx, f)
-				if err != nil {
-					return fmt.Errorf("failed to save file: %w", err)
-				}
-				jobs = append(jobs, qaas.FileJob{
-					Payload: f,
-					Delay:   0,
-					Metadata: map[string]any{
-						"externalKey": string(msg.ExternalKey),
-					},
-				})
-
-			}
-			publishOptions := []qaas.PublishOption{
-				qaas.WithQueue(qaas.ParseFileQueue),
-				qaas.WithSourceQueue(qaas.ParseFileQueue),
-			}
-			_, err = s.pub.Publish(ctx, jobs, publishOptions...)
-			if err != nil {
-				return fmt.Errorf("failed to publish file job: %w", err)
-			}
-		default:
-			return fmt.Errorf("unsupported source type: %v", src.Type)
+		// новый источник записывается в начальное поколение
+		if err = s.ingest(ctx, src, msg, 0); err != nil {
+			return err
 		}
-		return nil
+		return s.scheduleRefresh(ctx, src)
 
 	})
 	if err != nil {
@@ -145,3 +91,127 @@ func (s Service) CreateSource(ctx context.Context, msg source.DataMessage) (*sou
 	metric.IncrementSourcesCreated(string(src.Type), src.ID, err)
 	return src, nil
 }
+
+// ingest сохраняет объекты источника из сообщения и ставит в очередь задачи их обработки.
+// Задачи помечаются поколением generation, результаты задач замененного построения отбрасываются.
+func (s Service) ingest(ctx context.Context, src *source.Source, msg source.DataMessage, generation int) error {
+	var err error
+	switch src.Type {
+	case source.Web:
+		var webSource *site.Site
+		webSource, err = s.createSite(src, msg)
+		if err != nil {
+			return fmt.Errorf("failed to create site: %w", err)
+		}
+		_, err = s.publishSiteJob(ctx, webSource, string(msg.ExternalKey), generation)
+		if err != nil {
+			return err
+		}
+	case source.S3WithCredentials:
+		var store *object_store.ObjectStore
+		store, err = s.createObjectStore(src, msg)
+		if err != nil {
+			return fmt.Errorf("failed to create object storage: %w", err)
+		}
+		err = s.objectStorage.SaveStore(ctx, store)
+		if err != nil {
+			return fmt.Errorf("failed to save object storage: %w", err)
+		}
+		err = s.publishS3Job(ctx, store, string(msg.ExternalKey), uuid.NewString(), generation)
+		if err != nil {
+			return err
+		}
+	case source.SingleFile:
+		var f *file.File
+		f, err := s.createFile(src, msg)
+		if err != nil {
+			return fmt.Errorf("failed to create file: %w", err)
+		}
+		err = s.fileStorage.Save(ctx, f)
+		if err != nil {
+			return fmt.Errorf("failed to save file: %w", err)
+		}
+		err = s.publishFileJobs(ctx, []*file.File{f}, string(msg.ExternalKey), generation)
+		if err != nil {
+			return err
+		}
+	case source.ArchivedFiles:
+		files, err := s.createArchive(ctx, src, msg)
+		if err != nil {
+			return fmt.Errorf("failed to create archive: %w", err)
+		}
+		err = s.publishFileJobs(ctx, files, string(msg.ExternalKey), generation)
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported source type: %v", src.Type)
+	}
+	return nil
+}
+
+func (s Service) publishSiteJob(ctx context.Context, webSource *site.Site, externalKey string, generation int) (string, error) {
+	siteJobID := uuid.NewString()
+	publishOptions := []qaas.PublishOption{
+		qaas.WithQueue(qaas.ParseSiteQueue),
+	}
+	_, err := s.pub.Publish(ctx, []any{qaas.SiteJob{
+		Payload: webSource,
+		Delay:   0,
+		Metadata: map[string]any{
+			"siteJobID":        siteJobID,
+			"externalKey":      externalKey,
+			qaas.GenerationKey: generation,
+		},
+	}}, publishOptions...)
+	if err != nil {
+		return "", fmt.Errorf("failed to publish site job: %w", err)
+	}
+	return siteJobID, nil
+}
+
+// publishS3Job ставит в очередь синхронизацию бакета, учетные данные в задачу не попадают.
+func (s Service) publishS3Job(ctx context.Context, store *object_store.ObjectStore, externalKey, jobID string, generation int) error {
+	_, err := s.pub.Publish(ctx, []any{qaas.ParseS3Job{
+		Payload: &object_store.ObjectStore{
+			ID:       store.ID,
+			SourceID: store.SourceID,
+		},
+		Delay: 0,
+		Metadata: map[string]any{
+			"externalKey":      externalKey,
+			"jobID":            jobID,
+			qaas.GenerationKey: generation,
+		},
+	}}, qaas.WithQueue(qaas.ParseS3Queue))
+	if err != nil {
+		return fmt.Errorf("failed to publish s3 job: %w", err)
+	}
+	return nil
+}
+
+func (s Service) publishFileJobs(ctx context.Context, files []*file.File, externalKey string, generation int) error {
+	if len(files) == 0 {
+		return nil
+	}
+	jobs := make([]any, 0, len(files))
+	for _, f := range files {
+		jobs = append(jobs, qaas.FileJob{
+			Payload: f,
+			Delay:   0,
+			Metadata: map[string]any{
+				"externalKey":      externalKey,
+				qaas.GenerationKey: generation,
+			},
+		})
+	}
+	publishOptions := []qaas.PublishOption{
+		qaas.WithQueue(qaas.ParseFileQueue),
+		qaas.WithSourceQueue(qaas.ParseFileQueue),
+	}
+	_, err := s.pub.Publish(ctx, jobs, publishOptions...)
+	if err != nil {
+		return fmt.Errorf("failed to publish file job: %w", err)
+	}
+	return nil
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"fmt"
+	"log/slog"
 	"net/url"
+	"time"
 
 	"github.com/larek-tech/diploma/data/internal/domain/site"
 	"github.com/larek-tech/diploma/data/internal/domain/sitemap"
@@ -20,15 +22,26 @@ func (s Service) createSite(src *source.Source, msg source.DataMessage) (*site.S
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse url for web source: %w", err)
 	}
-	site, err := site.NewSite(src.ID, siteURL.String())
+	newSite, err := site.NewSite(src.ID, siteURL.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create site: %w", err)
 	}
 	availableURLs, err := s.sitemapParser.GetAndParseSitemap(*siteURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse sitemap: %w", err)
+	if err != nil || len(availableURLs) == 0 {
+		// без sitemap обходим сайт по ссылкам начиная с корневой страницы
+		slog.Info("sitemap is not available, falling back to link crawl", "url", siteURL.String(), "error", err)
+		newSite.CrawlMode = site.CrawlLinks
+		newSite.AvailablePages = []string{siteURL.String()}
+		return newSite, nil
+	}
+	newSite.CrawlMode = site.CrawlSitemap
+	newSite.AvailablePages = lo.Map(availableURLs, func(v sitemap.URLResult, _ int) string { return v.URL })
+	newSite.PageLastMod = make(map[string]time.Time)
+	for _, v := range availableURLs {
+		if lastMod, ok := v.LastModTime(); ok {
+			newSite.PageLastMod[v.URL] = lastMod
+		}
 	}
-	site.AvailablePages = lo.Map(availableURLs, func(v sitemap.URLResult, _ int) string { return v.URL })
 
-	return site, nil
+	return newSite, nil
 }
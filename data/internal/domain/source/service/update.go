@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/larek-tech/diploma/data/internal/domain/file"
+	"github.com/larek-tech/diploma/data/internal/domain/source"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ErrSourceNotFound источник с указанным идентификатором не существует
+var ErrSourceNotFound = errors.New("source not found")
+
+// UpdateSource загружает новое содержимое источника в отдельное поколение индекса.
+// Поиск продолжает использовать текущее поколение, пока новое не будет построено
+// и переключено через CompleteGeneration. Если msg.SettingsOnly, сохраняются только название
+// и расписание обновления без построения нового поколения.
+func (s Service) UpdateSource(ctx context.Context, msg source.UpdateMessage) (*source.Source, error) {
+	ctx, span := s.tracer.Start(ctx, "sourceService.UpdateSource", trace.WithAttributes(
+		attribute.String("sourceID", msg.SourceID),
+		attribute.String("sourceExternalKey", string(msg.ExternalKey)),
+	))
+	defer span.End()
+
+	src, err := s.sourceStorage.GetByID(ctx, msg.SourceID)
+	if err != nil {
+		err = fmt.Errorf("failed to get source: %w", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	if src == nil {
+		span.RecordError(ErrSourceNotFound)
+		return nil, ErrSourceNotFound
+	}
+	if msg.Title != "" {
+		src.Title = msg.Title
+	}
+	if msg.Chunking != nil {
+		if err = msg.Chunking.Normalize(); err != nil {
+			return nil, err
+		}
+		src.Chunking = msg.Chunking
+	}
+	if msg.UpdateParams != nil {
+		src.UpdateParams = msg.UpdateParams
+		src.NextRefreshAt = nil
+		if next, ok := src.UpdateParams.Next(time.Now()); ok {
+			src.NextRefreshAt = &next
+		}
+	}
+	if src.Type == source.Web && len(msg.Content) > 0 && !msg.SettingsOnly {
+		src.Content = msg.Content
+	}
+	// тип источника не меняется, сообщение дополняется сохраненными значениями
+	msg.Type = src.Type
+	msg.ExternalKey = []byte(src.ExternalKey)
+	if msg.Title == "" {
+		msg.Title = src.Title
+	}
+
+	if msg.SettingsOnly {
+		err = s.trManager.Do(ctx, func(ctx context.Context) error {
+			if err := s.sourceStorage.Save(ctx, src); err != nil {
+				return err
+			}
+			if msg.UpdateParams != nil {
+				return s.scheduleRefresh(ctx, src)
+			}
+			return nil
+		})
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		return src, nil
+	}
+
+	err = s.trManager.Do(ctx, func(ctx context.Context) error {
+		generation, err := s.sourceStorage.BeginGeneration(ctx, src.ID)
+		if err != nil {
+			return fmt.Errorf("failed to begin generation: %w", err)
+		}
+		span.SetAttributes(attribute.Int("generation", generation))
+		if err = s.sourceStorage.Save(ctx, src); err != nil {
+			return err
+		}
+		if err = s.ingest(ctx, src, msg.DataMessage, generation); err != nil {
+			return err
+		}
+		if msg.UpdateParams != nil {
+			return s.scheduleRefresh(ctx, src)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return src, nil
+}
+
+// CompleteGeneration переключает поиск на построенное поколение generation источника и удаляет данные предыдущих поколений.
+// Возвращает false, если переключать нечего: обновление не запускалось, уже завершено, заменено более новым
+// или не дало документов. Содержимое устаревших страниц и файлов удаляется из S3 после коммита.
+func (s Service) CompleteGeneration(ctx context.Context, sourceID string, generation int) (bool, error) {
+	ctx, span := s.tracer.Start(ctx, "sourceService.CompleteGeneration", trace.WithAttributes(
+		attribute.String("sourceID", sourceID),
+		attribute.Int("generation", generation),
+	))
+	defer span.End()
+
+	var (
+		swapped bool
+		pageIDs []string
+		files   []*file.File
+	)
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		generation, ok, err := s.sourceStorage.SwapGeneration(ctx, sourceID, generation)
+		if err != nil {
+			return fmt.Errorf("failed to swap generation: %w", err)
+		}
+		if !ok {
+			return nil
+		}
+		swapped = true
+		span.SetAttributes(attribute.Int("generation", generation))
+		pageIDs, err = s.pageStorage.GetStaleIDs(ctx, sourceID, generation)
+		if err != nil {
+			return err
+		}
+		files, err = s.fileStorage.GetStaleBySourceID(ctx, sourceID, generation)
+		if err != nil {
+			return fmt.Errorf("failed to get stale files: %w", err)
+		}
+		if err = s.sourceStorage.DeleteStaleGenerations(ctx, sourceID, generation); err != nil {
+			return fmt.Errorf("failed to delete stale generations: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return false, err
+	}
+	if !swapped {
+		return false, nil
+	}
+	slog.Info("source generation swapped", "sourceID", sourceID, "stalePages", len(pageIDs), "staleFiles", len(files))
+	s.pageStorage.DeleteObjects(ctx, pageIDs)
+	s.fileStorage.DeleteObjects(ctx, files)
+	return true, nil
+}
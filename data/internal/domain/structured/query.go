@@ -0,0 +1,183 @@
+package structured
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	DefaultQueryLimit = 100             // количество строк результата по умолчанию
+	MaxQueryLimit     = 1000            // максимальное количество строк результата
+	QueryTimeout      = 5 * time.Second // ограничение времени выполнения запроса
+	MaxAggregations   = 10              // максимальное количество агрегатов в запросе
+	MaxGroupBy        = 5               // максимальное количество столбцов группировки
+	MaxFilters        = 20              // максимальное количество фильтров
+	MaxFilterValues   = 100             // максимальное количество значений в фильтре in
+)
+
+type AggregateFunc string
+
+const (
+	AggregateCount AggregateFunc = "count"
+	AggregateSum   AggregateFunc = "sum"
+	AggregateAvg   AggregateFunc = "avg"
+	AggregateMin   AggregateFunc = "min"
+	AggregateMax   AggregateFunc = "max"
+)
+
+type FilterOp string
+
+const (
+	FilterEq  FilterOp = "eq"
+	FilterNe  FilterOp = "ne"
+	FilterGt  FilterOp = "gt"
+	FilterGte FilterOp = "gte"
+	FilterLt  FilterOp = "lt"
+	FilterLte FilterOp = "lte"
+	FilterIn  FilterOp = "in"
+)
+
+// Aggregation агрегат по столбцу, для count столбец можно не указывать
+type Aggregation struct {
+	Func   AggregateFunc
+	Column string
+}
+
+// Filter условие на значение столбца
+type Filter struct {
+	Column string
+	Op     FilterOp
+	Values []string
+}
+
+// Query параметризованный агрегирующий запрос к таблице
+type Query struct {
+	TableID      string
+	Aggregations []Aggregation
+	GroupBy      []string
+	Filters      []Filter
+	Limit        int
+}
+
+// Result результат запроса, значения приведены к строкам, NULL - пустая строка
+type Result struct {
+	Columns   []string
+	Rows      [][]string
+	Truncated bool // в результате есть строки сверх лимита
+}
+
+// Plan запрос, проверенный по списку столбцов таблицы
+type Plan struct {
+	Table        *Table
+	Aggregations []PlannedAggregation
+	GroupBy      []Column
+	Filters      []PlannedFilter
+	Limit        int
+}
+
+type PlannedAggregation struct {
+	Func   AggregateFunc
+	Column *Column // nil для count(*)
+}
+
+type PlannedFilter struct {
+	Column Column
+	Op     FilterOp
+	Values []any // значения, приведенные к типу столбца
+}
+
+// Plan проверяет запрос: допускаются только известные функции и операторы, столбцы таблицы
+// и значения фильтров, соответствующие типу столбца
+func (q Query) Plan(table *Table) (*Plan, error) {
+	if len(q.Aggregations) > MaxAggregations || len(q.GroupBy) > MaxGroupBy || len(q.Filters) > MaxFilters {
+		return nil, fmt.Errorf("%w: too many aggregations, group by columns or filters", ErrInvalidQuery)
+	}
+	plan := &Plan{Table: table, Limit: q.Limit}
+	if plan.Limit <= 0 {
+		plan.Limit = DefaultQueryLimit
+	}
+	plan.Limit = min(plan.Limit, MaxQueryLimit)
+
+	for _, name := range q.GroupBy {
+		c, ok := table.Column(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown column %q", ErrInvalidQuery, name)
+		}
+		plan.GroupBy = append(plan.GroupBy, c)
+	}
+
+	aggregations := q.Aggregations
+	if len(aggregations) == 0 {
+		aggregations = []Aggregation{{Func: AggregateCount}}
+	}
+	for _, a := range aggregations {
+		planned, err := planAggregation(table, a)
+		if err != nil {
+			return nil, err
+		}
+		plan.Aggregations = append(plan.Aggregations, planned)
+	}
+
+	for _, f := range q.Filters {
+		planned, err := planFilter(table, f)
+		if err != nil {
+			return nil, err
+		}
+		plan.Filters = append(plan.Filters, planned)
+	}
+	return plan, nil
+}
+
+func planAggregation(table *Table, a Aggregation) (PlannedAggregation, error) {
+	if a.Func == AggregateCount && a.Column == "" {
+		return PlannedAggregation{Func: AggregateCount}, nil
+	}
+	c, ok := table.Column(a.Column)
+	if !ok {
+		return PlannedAggregation{}, fmt.Errorf("%w: unknown column %q", ErrInvalidQuery, a.Column)
+	}
+	numeric := c.Type == TypeInteger || c.Type == TypeNumeric
+	ordered := numeric || c.Type == TypeDate || c.Type == TypeTimestamp
+	switch {
+	case a.Func == AggregateCount:
+	case (a.Func == AggregateSum || a.Func == AggregateAvg) && numeric:
+	case (a.Func == AggregateMin || a.Func == AggregateMax) && ordered:
+	default:
+		return PlannedAggregation{}, fmt.Errorf("%w: %q is not applicable to %s column %q", ErrInvalidQuery, a.Func, c.Type, c.Name)
+	}
+	return PlannedAggregation{Func: a.Func, Column: &c}, nil
+}
+
+func planFilter(table *Table, f Filter) (PlannedFilter, error) {
+	c, ok := table.Column(f.Column)
+	if !ok {
+		return PlannedFilter{}, fmt.Errorf("%w: unknown column %q", ErrInvalidQuery, f.Column)
+	}
+	switch f.Op {
+	case FilterEq, FilterNe, FilterGt, FilterGte, FilterLt, FilterLte:
+		if len(f.Values) != 1 {
+			return PlannedFilter{}, fmt.Errorf("%w: %q filter requires exactly one value", ErrInvalidQuery, f.Op)
+		}
+		if c.Type == TypeBoolean && f.Op != FilterEq && f.Op != FilterNe {
+			return PlannedFilter{}, fmt.Errorf("%w: %q is not applicable to boolean column %q", ErrInvalidQuery, f.Op, c.Name)
+		}
+	case FilterIn:
+		if len(f.Values) == 0 || len(f.Values) > MaxFilterValues {
+			return PlannedFilter{}, fmt.Errorf("%w: in filter requires from 1 to %d values", ErrInvalidQuery, MaxFilterValues)
+		}
+	default:
+		return PlannedFilter{}, fmt.Errorf("%w: unknown filter operator %q", ErrInvalidQuery, f.Op)
+	}
+	values := make([]any, 0, len(f.Values))
+	for _, raw := range f.Values {
+		v, err := c.Parse(raw)
+		if err != nil {
+			return PlannedFilter{}, fmt.Errorf("%w: column %q: %w", ErrInvalidQuery, c.Name, err)
+		}
+		if v == nil {
+			return PlannedFilter{}, fmt.Errorf("%w: empty filter value for column %q", ErrInvalidQuery, c.Name)
+		}
+		values = append(values, v)
+	}
+	return PlannedFilter{Column: c, Op: f.Op, Values: values}, nil
+}
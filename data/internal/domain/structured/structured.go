@@ -0,0 +1,241 @@
+package structured
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+)
+
+var (
+	ErrTableNotFound = errors.New("structured table not found") // ошибка, когда таблица не найдена
+	ErrInvalidQuery  = errors.New("invalid aggregate query")    // ошибка некорректного запроса к таблице
+	ErrInvalidValue  = errors.New("invalid column value")       // значение не соответствует типу столбца
+)
+
+const (
+	MaxColumns = 1000 // максимальное количество столбцов загружаемого листа
+)
+
+type ColumnType string
+
+const (
+	TypeInteger   ColumnType = "integer"   // целые числа
+	TypeNumeric   ColumnType = "numeric"   // десятичные числа, арифметика без потери точности
+	TypeDate      ColumnType = "date"      // даты
+	TypeTimestamp ColumnType = "timestamp" // дата и время
+	TypeBoolean   ColumnType = "boolean"   // логические значения
+	TypeText      ColumnType = "text"      // строки
+)
+
+// inferenceOrder типы в порядке проверки при определении типа столбца, text подходит всегда
+var inferenceOrder = []ColumnType{TypeInteger, TypeNumeric, TypeDate, TypeTimestamp, TypeBoolean}
+
+var (
+	integerRe = regexp.MustCompile(`^[+-]?(0|[1-9]\d*)$`)
+	numericRe = regexp.MustCompile(`^[+-]?(0|[1-9]\d*)(\.\d+)?$`)
+)
+
+var (
+	dateLayouts      = []string{time.DateOnly, "02.01.2006", "2006/01/02"}
+	timestampLayouts = []string{time.DateTime, "2006-01-02T15:04:05", "2006-01-02T15:04:05Z07:00", "02.01.2006 15:04:05", "02.01.2006 15:04"}
+)
+
+// SQL возвращает тип столбца postgres
+func (t ColumnType) SQL() string {
+	switch t {
+	case TypeInteger:
+		return "BIGINT"
+	case TypeNumeric:
+		return "NUMERIC"
+	case TypeDate:
+		return "DATE"
+	case TypeTimestamp:
+		return "TIMESTAMP"
+	case TypeBoolean:
+		return "BOOLEAN"
+	default:
+		return "TEXT"
+	}
+}
+
+// Column столбец структурированной таблицы
+type Column struct {
+	Name  string     `json:"name"`  // заголовок столбца в исходном файле
+	Field string     `json:"field"` // имя столбца в таблице postgres
+	Type  ColumnType `json:"type"`  // тип значений столбца
+}
+
+// Table таблица, загруженная из листа табличного документа
+type Table struct {
+	ID         string    `db:"id"`          // идентификатор таблицы
+	SourceID   string    `db:"source_id"`   // идентификатор источника
+	DocumentID string    `db:"document_id"` // идентификатор документа, из которого загружена таблица
+	Sheet      string    `db:"sheet"`       // название листа
+	TableName  string    `db:"table_name"`  // имя таблицы postgres в схеме structured_data
+	Columns    []Column  `db:"columns"`     // столбцы таблицы
+	RowCount   int       `db:"row_count"`   // количество строк
+	CreatedAt  time.Time `db:"created_at"`  // дата загрузки
+}
+
+// Column возвращает столбец по заголовку или имени в таблице postgres
+func (t Table) Column(name string) (Column, bool) {
+	name = strings.TrimSpace(name)
+	for _, c := range t.Columns {
+		if c.Field == name || strings.EqualFold(c.Name, name) {
+			return c, true
+		}
+	}
+	return Column{}, false
+}
+
+// FromSheet определяет типы столбцов листа и приводит к ним значения строк.
+// Первым значением каждой строки идет ее номер в исходном файле.
+func FromSheet(sourceID, documentID string, sheet document.Sheet) (*Table, [][]any, error) {
+	if len(sheet.Columns) > MaxColumns {
+		return nil, nil, fmt.Errorf("sheet %q has %d columns, max %d", sheet.Name, len(sheet.Columns), MaxColumns)
+	}
+	id := uuid.NewString()
+	table := &Table{
+		ID:         id,
+		SourceID:   sourceID,
+		DocumentID: documentID,
+		Sheet:      sheet.Name,
+		TableName:  "t_" + strings.ReplaceAll(id, "-", ""),
+		Columns:    make([]Column, len(sheet.Columns)),
+		RowCount:   len(sheet.Rows),
+	}
+	for i, name := range sheet.Columns {
+		table.Columns[i] = Column{
+			Name:  name,
+			Field: "c" + strconv.Itoa(i+1),
+			Type:  inferType(sheet.Rows, i),
+		}
+	}
+
+	rows := make([][]any, 0, len(sheet.Rows))
+	for _, row := range sheet.Rows {
+		values := make([]any, 0, len(table.Columns)+1)
+		values = append(values, row.Number)
+		for i, c := range table.Columns {
+			var cell string
+			if i < len(row.Cells) {
+				cell = row.Cells[i]
+			}
+			v, err := c.Parse(cell)
+			if err != nil {
+				return nil, nil, fmt.Errorf("row %d: %w", row.Number, err)
+			}
+			values = append(values, v)
+		}
+		rows = append(rows, values)
+	}
+	return table, rows, nil
+}
+
+// inferType выбирает самый строгий тип, которому соответствуют все непустые значения столбца
+func inferType(rows []document.Row, col int) ColumnType {
+	candidates := make(map[ColumnType]bool, len(inferenceOrder))
+	for _, t := range inferenceOrder {
+		candidates[t] = true
+	}
+	empty := true
+	for _, row := range rows {
+		if col >= len(row.Cells) || strings.TrimSpace(row.Cells[col]) == "" {
+			continue
+		}
+		empty = false
+		for t := range candidates {
+			if _, err := (Column{Type: t}).Parse(row.Cells[col]); err != nil {
+				delete(candidates, t)
+			}
+		}
+	}
+	if empty {
+		return TypeText
+	}
+	for _, t := range inferenceOrder {
+		if candidates[t] {
+			return t
+		}
+	}
+	return TypeText
+}
+
+// Parse приводит значение ячейки к типу столбца, пустая ячейка становится NULL
+func (c Column) Parse(value string) (any, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	switch c.Type {
+	case TypeInteger:
+		number := normalizeNumber(value)
+		if !integerRe.MatchString(number) {
+			return nil, fmt.Errorf("%w: %q is not an integer", ErrInvalidValue, value)
+		}
+		v, err := strconv.ParseInt(number, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q is not an integer", ErrInvalidValue, value)
+		}
+		return v, nil
+	case TypeNumeric:
+		number := normalizeNumber(value)
+		if !numericRe.MatchString(number) {
+			return nil, fmt.Errorf("%w: %q is not a number", ErrInvalidValue, value)
+		}
+		// строка передается как есть, чтобы postgres сохранил число без потери точности
+		return number, nil
+	case TypeDate:
+		return parseTime(value, dateLayouts)
+	case TypeTimestamp:
+		return parseTime(value, timestampLayouts)
+	case TypeBoolean:
+		switch strings.ToLower(value) {
+		case "true", "false":
+			return strings.EqualFold(value, "true"), nil
+		}
+		return nil, fmt.Errorf("%w: %q is not a boolean", ErrInvalidValue, value)
+	default:
+		return value, nil
+	}
+}
+
+// normalizeNumber убирает разделители разрядов и приводит десятичный разделитель к точке:
+// одна запятая считается десятичным разделителем, как в русской локали
+func normalizeNumber(value string) string {
+	value = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\u00a0', '\u202f', '\'':
+			return -1
+		}
+		return r
+	}, value)
+	comma, dot := strings.LastIndex(value, ","), strings.LastIndex(value, ".")
+	switch {
+	case comma >= 0 && dot >= 0 && comma > dot:
+		value = strings.ReplaceAll(value, ".", "")
+		value = strings.Replace(value, ",", ".", 1)
+	case comma >= 0 && dot >= 0:
+		value = strings.ReplaceAll(value, ",", "")
+	case strings.Count(value, ",") == 1:
+		value = strings.Replace(value, ",", ".", 1)
+	case comma >= 0:
+		value = strings.ReplaceAll(value, ",", "")
+	}
+	return value
+}
+
+func parseTime(value string, layouts []string) (any, error) {
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q is not a date", ErrInvalidValue, value)
+}
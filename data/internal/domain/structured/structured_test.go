@@ -0,0 +1,90 @@
+package structured
+
+import (
+	"testing"
+	"time"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromSheet(t *testing.T) {
+	t.Parallel()
+
+	table, rows, err := FromSheet("source", "document", document.Sheet{
+		Name:    "Q3",
+		Columns: []string{"region", "total", "count", "date", "code", "flag", "empty"},
+		Rows: []document.Row{
+			{Number: 2, Cells: []string{"North", "1 234,50", "10", "2025-07-01", "007", "TRUE", ""}},
+			{Number: 3, Cells: []string{"South", "7", "-3", "01.08.2025", "12", "false", ""}},
+			{Number: 5, Cells: []string{"West", "", "", "", "", "", ""}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []Column{
+		{Name: "region", Field: "c1", Type: TypeText},
+		{Name: "total", Field: "c2", Type: TypeNumeric},
+		{Name: "count", Field: "c3", Type: TypeInteger},
+		{Name: "date", Field: "c4", Type: TypeDate},
+		{Name: "code", Field: "c5", Type: TypeText},
+		{Name: "flag", Field: "c6", Type: TypeBoolean},
+		{Name: "empty", Field: "c7", Type: TypeText},
+	}, table.Columns)
+	assert.Equal(t, 3, table.RowCount)
+	assert.Equal(t, []any{2, "North", "1234.50", int64(10), time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), "007", true, nil}, rows[0])
+	assert.Equal(t, []any{5, "West", nil, nil, nil, nil, nil, nil}, rows[2])
+}
+
+func TestNormalizeNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"1 234,5":   "1234.5",
+		"1,234.5":   "1234.5",
+		"1.234,5":   "1234.5",
+		"1,234,567": "1234567",
+		"0,25":      "0.25",
+		"-12":       "-12",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, normalizeNumber(in), in)
+	}
+}
+
+func TestPlan(t *testing.T) {
+	t.Parallel()
+
+	table := &Table{Columns: []Column{
+		{Name: "region", Field: "c1", Type: TypeText},
+		{Name: "total", Field: "c2", Type: TypeNumeric},
+		{Name: "quarter", Field: "c3", Type: TypeText},
+	}}
+
+	plan, err := Query{
+		Aggregations: []Aggregation{{Func: AggregateSum, Column: "Total"}},
+		GroupBy:      []string{"region"},
+		Filters:      []Filter{{Column: "quarter", Op: FilterEq, Values: []string{"Q3"}}},
+		Limit:        5000,
+	}.Plan(table)
+	assert.NoError(t, err)
+	assert.Equal(t, MaxQueryLimit, plan.Limit)
+	assert.Equal(t, "c2", plan.Aggregations[0].Column.Field)
+	assert.Equal(t, []any{"Q3"}, plan.Filters[0].Values)
+
+	plan, err = Query{}.Plan(table)
+	assert.NoError(t, err)
+	assert.Equal(t, []PlannedAggregation{{Func: AggregateCount}}, plan.Aggregations)
+
+	invalid := []Query{
+		{Aggregations: []Aggregation{{Func: AggregateSum, Column: "region"}}},
+		{Aggregations: []Aggregation{{Func: "stddev", Column: "total"}}},
+		{GroupBy: []string{"unknown"}},
+		{Filters: []Filter{{Column: "total", Op: FilterGt, Values: []string{"1; DROP TABLE x"}}}},
+		{Filters: []Filter{{Column: "total", Op: "like", Values: []string{"1"}}}},
+		{Filters: []Filter{{Column: "total", Op: FilterEq, Values: []string{"1", "2"}}}},
+	}
+	for _, q := range invalid {
+		_, err = q.Plan(table)
+		assert.ErrorIs(t, err, ErrInvalidQuery)
+	}
+}
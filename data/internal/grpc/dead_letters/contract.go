@@ -0,0 +1,15 @@
+package dead_letters
+
+import (
+	"context"
+
+	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
+)
+
+type (
+	deadLetterStore interface {
+		List(ctx context.Context, queue qaas.Queue, page, size int) (int, []*qaas.DeadLetter, error)
+		Get(ctx context.Context, queue qaas.Queue, id string) (*qaas.DeadLetter, error)
+		Replay(ctx context.Context, queue qaas.Queue, ids []string) (int, error)
+	}
+)
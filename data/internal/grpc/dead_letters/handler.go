@@ -0,0 +1,152 @@
+package dead_letters
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/larek-tech/diploma/data/internal/data/pb"
+	grpcSpan "github.com/larek-tech/diploma/data/internal/infrastructure/grpc/span"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const maxPageSize = 50
+
+type Handler struct {
+	deadLetterStore deadLetterStore
+	tracer          trace.Tracer
+}
+
+func New(deadLetterStore deadLetterStore, tracer trace.Tracer) *Handler {
+	return &Handler{
+		deadLetterStore: deadLetterStore,
+		tracer:          tracer,
+	}
+}
+
+func (h Handler) ListDeadLetters(ctx context.Context, in *pb.ListDeadLettersRequest) (*pb.ListDeadLettersResponse, error) {
+	ctx, err := grpcSpan.GetTraceCtx(ctx)
+	if err != nil {
+		slog.Error("failed to get trace context", "error", err)
+	}
+	ctx, span := h.tracer.Start(ctx, "ListDeadLetters", trace.WithAttributes(
+		attribute.String("queue", in.Queue),
+		attribute.Int64("page", int64(in.Page)),
+		attribute.Int64("size", int64(in.Size)),
+	))
+	defer span.End()
+
+	queue, err := parseQueue(in.Queue)
+	if err != nil {
+		return nil, err
+	}
+	if in.Size > maxPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid size value must not be greater than %d", maxPageSize)
+	}
+	total, jobs, err := h.deadLetterStore.List(ctx, queue, int(in.Page), int(in.Size))
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Errorf(codes.Internal, "failed to list dead letters: %v", err)
+	}
+	res := &pb.ListDeadLettersResponse{
+		Size:        in.Size,
+		Page:        in.Page,
+		Total:       uint32(total),
+		DeadLetters: make([]*pb.DeadLetter, 0, len(jobs)),
+	}
+	for _, job := range jobs {
+		res.DeadLetters = append(res.DeadLetters, toPb(job))
+	}
+	return res, nil
+}
+
+func (h Handler) GetDeadLetter(ctx context.Context, in *pb.GetDeadLetterRequest) (*pb.DeadLetter, error) {
+	ctx, err := grpcSpan.GetTraceCtx(ctx)
+	if err != nil {
+		slog.Error("failed to get trace context", "error", err)
+	}
+	ctx, span := h.tracer.Start(ctx, "GetDeadLetter", trace.WithAttributes(
+		attribute.String("queue", in.Queue),
+		attribute.String("id", in.Id),
+	))
+	defer span.End()
+
+	queue, err := parseQueue(in.Queue)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = uuid.Parse(in.Id); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	job, err := h.deadLetterStore.Get(ctx, queue, in.Id)
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Errorf(codes.Internal, "failed to get dead letter: %v", err)
+	}
+	if job == nil {
+		return nil, status.Error(codes.NotFound, "dead letter not found")
+	}
+	return toPb(job), nil
+}
+
+func (h Handler) ReplayDeadLetters(ctx context.Context, in *pb.ReplayDeadLettersRequest) (*pb.ReplayDeadLettersResponse, error) {
+	ctx, err := grpcSpan.GetTraceCtx(ctx)
+	if err != nil {
+		slog.Error("failed to get trace context", "error", err)
+	}
+	ctx, span := h.tracer.Start(ctx, "ReplayDeadLetters", trace.WithAttributes(
+		attribute.String("queue", in.Queue),
+		attribute.String("ids", strings.Join(in.Ids, ",")),
+	))
+	defer span.End()
+
+	queue, err := parseQueue(in.Queue)
+	if err != nil {
+		return nil, err
+	}
+	if len(in.Ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty ids")
+	}
+	for _, id := range in.Ids {
+		if _, err = uuid.Parse(id); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+		}
+	}
+	replayed, err := h.deadLetterStore.Replay(ctx, queue, in.Ids)
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Errorf(codes.Internal, "failed to replay dead letters: %v", err)
+	}
+	slog.Info("dead letters replayed", "queue", queue, "requested", len(in.Ids), "replayed", replayed)
+	return &pb.ReplayDeadLettersResponse{Replayed: uint32(replayed)}, nil
+}
+
+func parseQueue(name string) (qaas.Queue, error) {
+	queue, ok := qaas.ParseQueue(name)
+	if !ok {
+		return "", status.Errorf(codes.InvalidArgument, "unknown queue: %q", name)
+	}
+	return queue, nil
+}
+
+func toPb(job *qaas.DeadLetter) *pb.DeadLetter {
+	res := &pb.DeadLetter{
+		Id:        job.ID,
+		Queue:     string(job.Queue),
+		Payload:   string(job.Payload),
+		Metadata:  job.Metadata,
+		Error:     job.Error,
+		Attempts:  uint32(job.Attempts),
+		CreatedAt: timestamppb.New(job.CreatedAt),
+	}
+	if job.ReplayedAt != nil {
+		res.ReplayedAt = timestamppb.New(*job.ReplayedAt)
+	}
+	return res
+}
@@ -0,0 +1,24 @@
+package embedding_models
+
+import (
+	"context"
+
+	"github.com/larek-tech/diploma/data/internal/domain/embedding"
+	embeddingService "github.com/larek-tech/diploma/data/internal/domain/embedding/service"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
+)
+
+type (
+	modelStorage interface {
+		List(ctx context.Context) ([]*embedding.Model, error)
+		GetByID(ctx context.Context, id int) (*embedding.Model, error)
+		Activate(ctx context.Context, id int) error
+		Coverage(ctx context.Context, model *embedding.Model, sourceID string) (embedding.Coverage, error)
+	}
+	embedders interface {
+		Embedder(modelID int) (embeddingService.Embedder, bool)
+	}
+	publisher interface {
+		Publish(ctx context.Context, rawMsg []any, opts ...qaas.PublishOption) ([]string, error)
+	}
+)
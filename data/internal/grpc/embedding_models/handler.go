@@ -0,0 +1,171 @@
+package embedding_models
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/larek-tech/diploma/data/internal/data/pb"
+	"github.com/larek-tech/diploma/data/internal/domain/embedding"
+	grpcSpan "github.com/larek-tech/diploma/data/internal/infrastructure/grpc/span"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Handler struct {
+	models    modelStorage
+	embedders embedders
+	publisher publisher
+	tracer    trace.Tracer
+}
+
+func New(models modelStorage, embedders embedders, publisher publisher, tracer trace.Tracer) *Handler {
+	return &Handler{
+		models:    models,
+		embedders: embedders,
+		publisher: publisher,
+		tracer:    tracer,
+	}
+}
+
+func (h Handler) ListEmbeddingModels(ctx context.Context, _ *pb.ListEmbeddingModelsRequest) (*pb.ListEmbeddingModelsResponse, error) {
+	ctx, err := grpcSpan.GetTraceCtx(ctx)
+	if err != nil {
+		slog.Error("failed to get trace context", "error", err)
+	}
+	ctx, span := h.tracer.Start(ctx, "ListEmbeddingModels")
+	defer span.End()
+
+	models, err := h.models.List(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Errorf(codes.Internal, "failed to list embedding models: %v", err)
+	}
+	res := &pb.ListEmbeddingModelsResponse{Models: make([]*pb.EmbeddingModel, 0, len(models))}
+	for _, model := range models {
+		coverage, err := h.models.Coverage(ctx, model, "")
+		if err != nil {
+			span.RecordError(err)
+			return nil, status.Errorf(codes.Internal, "failed to get embedding model coverage: %v", err)
+		}
+		res.Models = append(res.Models, h.toPb(model, coverage))
+	}
+	return res, nil
+}
+
+func (h Handler) StartReembedding(ctx context.Context, in *pb.StartReembeddingRequest) (*pb.StartReembeddingResponse, error) {
+	ctx, err := grpcSpan.GetTraceCtx(ctx)
+	if err != nil {
+		slog.Error("failed to get trace context", "error", err)
+	}
+	ctx, span := h.tracer.Start(ctx, "StartReembedding", trace.WithAttributes(
+		attribute.Int64("modelID", in.ModelId),
+		attribute.String("sourceID", in.GetSourceId()),
+		attribute.Bool("cutOver", in.CutOver),
+	))
+	defer span.End()
+
+	if in.SourceId != nil {
+		if _, err = uuid.Parse(in.GetSourceId()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid source id: %v", err)
+		}
+	}
+	model, err := h.getModel(ctx, in.ModelId)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	ids, err := h.publisher.Publish(ctx, []any{qaas.ReembedJob{
+		ModelID:  model.ID,
+		SourceID: in.GetSourceId(),
+		CutOver:  in.CutOver,
+	}}, qaas.WithQueue(qaas.ReembedQueue))
+	if err != nil || len(ids) == 0 {
+		span.RecordError(err)
+		return nil, status.Errorf(codes.Internal, "failed to publish reembed job: %v", err)
+	}
+	slog.Info("reembedding started", "model", model.Key(), "sourceID", in.GetSourceId(), "jobID", ids[0])
+	return &pb.StartReembeddingResponse{JobId: ids[0]}, nil
+}
+
+func (h Handler) ActivateEmbeddingModel(ctx context.Context, in *pb.ActivateEmbeddingModelRequest) (*pb.EmbeddingModel, error) {
+	ctx, err := grpcSpan.GetTraceCtx(ctx)
+	if err != nil {
+		slog.Error("failed to get trace context", "error", err)
+	}
+	ctx, span := h.tracer.Start(ctx, "ActivateEmbeddingModel", trace.WithAttributes(
+		attribute.Int64("modelID", in.ModelId),
+		attribute.Bool("force", in.Force),
+	))
+	defer span.End()
+
+	model, err := h.getModel(ctx, in.ModelId)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	// поиск выполняется этим сервисом, без эмбеддера модели векторы запросов посчитать нечем
+	if _, ok := h.embedders.Embedder(model.ID); !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v: %s", embedding.ErrModelNotConfigured, model.Key())
+	}
+	coverage, err := h.models.Coverage(ctx, model, "")
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Errorf(codes.Internal, "failed to get embedding model coverage: %v", err)
+	}
+	if !coverage.Complete() && !in.Force {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"%v: %d of %d chunks, %d of %d questions", embedding.ErrCoverageIncomplete,
+			coverage.EmbeddedChunks, coverage.Chunks, coverage.EmbeddedQuestions, coverage.Questions,
+		)
+	}
+	if err = h.models.Activate(ctx, model.ID); err != nil {
+		span.RecordError(err)
+		return nil, status.Errorf(codes.Internal, "failed to activate embedding model: %v", err)
+	}
+	slog.Info("vector search switched to embedding model", "model", model.Key(), "force", in.Force)
+
+	if model, err = h.models.GetByID(ctx, model.ID); err != nil {
+		span.RecordError(err)
+		return nil, status.Errorf(codes.Internal, "failed to get embedding model: %v", err)
+	}
+	return h.toPb(model, coverage), nil
+}
+
+func (h Handler) getModel(ctx context.Context, id int64) (*embedding.Model, error) {
+	model, err := h.models.GetByID(ctx, int(id))
+	if err != nil {
+		if errors.Is(err, embedding.ErrModelNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get embedding model: %v", err)
+	}
+	return model, nil
+}
+
+func (h Handler) toPb(model *embedding.Model, coverage embedding.Coverage) *pb.EmbeddingModel {
+	_, configured := h.embedders.Embedder(model.ID)
+	res := &pb.EmbeddingModel{
+		Id:                int64(model.ID),
+		Name:              model.Name,
+		Version:           model.Version,
+		Dimensions:        uint32(model.Dimensions),
+		IndexType:         string(model.IndexType),
+		Active:            model.Active,
+		Configured:        configured,
+		Chunks:            uint64(coverage.Chunks),
+		EmbeddedChunks:    uint64(coverage.EmbeddedChunks),
+		Questions:         uint64(coverage.Questions),
+		EmbeddedQuestions: uint64(coverage.EmbeddedQuestions),
+		CreatedAt:         timestamppb.New(model.CreatedAt),
+	}
+	if model.ActivatedAt != nil {
+		res.ActivatedAt = timestamppb.New(*model.ActivatedAt)
+	}
+	return res
+}
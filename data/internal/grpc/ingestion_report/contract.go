@@ -0,0 +1,16 @@
+package ingestion_report
+
+import (
+	"context"
+
+	"github.com/larek-tech/diploma/data/internal/domain/source"
+)
+
+type (
+	reportStore interface {
+		GetReport(ctx context.Context, filter source.ReportFilter) (*source.IngestionReport, error)
+	}
+	sourceStore interface {
+		GetByID(ctx context.Context, id string) (*source.Source, error)
+	}
+)
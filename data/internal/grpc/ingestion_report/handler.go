@@ -0,0 +1,157 @@
+package ingestion_report
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/larek-tech/diploma/data/internal/data/pb"
+	"github.com/larek-tech/diploma/data/internal/domain/source"
+	grpcSpan "github.com/larek-tech/diploma/data/internal/infrastructure/grpc/span"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const maxPageSize = 50
+
+var states = map[pb.IngestionState]source.ObjectState{
+	pb.IngestionState_INGESTION_QUEUED:   source.StateQueued,
+	pb.IngestionState_INGESTION_FETCHED:  source.StateFetched,
+	pb.IngestionState_INGESTION_PARSED:   source.StateParsed,
+	pb.IngestionState_INGESTION_EMBEDDED: source.StateEmbedded,
+	pb.IngestionState_INGESTION_SKIPPED:  source.StateSkipped,
+	pb.IngestionState_INGESTION_FAILED:   source.StateFailed,
+}
+
+var objectTypes = map[pb.IngestionObjectType]source.ObjectType{
+	pb.IngestionObjectType_OBJECT_PAGE: source.ObjectPage,
+	pb.IngestionObjectType_OBJECT_FILE: source.ObjectFile,
+}
+
+type Handler struct {
+	reportStore reportStore
+	sourceStore sourceStore
+	tracer      trace.Tracer
+}
+
+func New(reportStore reportStore, sourceStore sourceStore, tracer trace.Tracer) *Handler {
+	return &Handler{
+		reportStore: reportStore,
+		sourceStore: sourceStore,
+		tracer:      tracer,
+	}
+}
+
+func (h Handler) GetIngestionReport(ctx context.Context, in *pb.GetIngestionReportRequest) (*pb.GetIngestionReportResponse, error) {
+	ctx, err := grpcSpan.GetTraceCtx(ctx)
+	if err != nil {
+		slog.Error("failed to get trace context", "error", err)
+	}
+	ctx, span := h.tracer.Start(ctx, "GetIngestionReport", trace.WithAttributes(
+		attribute.String("sourceId", in.SourceId),
+		attribute.Int64("page", int64(in.Page)),
+		attribute.Int64("size", int64(in.Size)),
+	))
+	defer span.End()
+
+	filter, err := parseFilter(in)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	src, err := h.sourceStore.GetByID(ctx, in.SourceId)
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Errorf(codes.Internal, "failed to get source: %v", err)
+	}
+	if src == nil {
+		return nil, status.Error(codes.NotFound, "source not found")
+	}
+	report, err := h.reportStore.GetReport(ctx, filter)
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Errorf(codes.Internal, "failed to get ingestion report: %v", err)
+	}
+
+	res := &pb.GetIngestionReportResponse{
+		SourceId: in.SourceId,
+		Size:     in.Size,
+		Page:     in.Page,
+		Total:    uint32(report.Total),
+		Counts:   make([]*pb.IngestionStateCount, 0, len(report.Counts)),
+		Objects:  make([]*pb.IngestionObject, 0, len(report.Objects)),
+	}
+	for _, c := range report.Counts {
+		res.Counts = append(res.Counts, &pb.IngestionStateCount{
+			State: toPbState(c.State),
+			Count: uint32(c.Count),
+		})
+	}
+	for _, o := range report.Objects {
+		res.Objects = append(res.Objects, &pb.IngestionObject{
+			Id:         o.ID,
+			Type:       toPbType(o.Type),
+			Name:       o.Name,
+			State:      toPbState(o.State),
+			Error:      o.Error,
+			ChunkCount: uint32(o.ChunkCount),
+			CreatedAt:  timestamppb.New(o.CreatedAt),
+			UpdatedAt:  timestamppb.New(o.UpdatedAt),
+		})
+	}
+	return res, nil
+}
+
+func parseFilter(in *pb.GetIngestionReportRequest) (source.ReportFilter, error) {
+	if in.SourceId == "" {
+		return source.ReportFilter{}, status.Error(codes.InvalidArgument, "empty source id")
+	}
+	if _, err := uuid.Parse(in.SourceId); err != nil {
+		return source.ReportFilter{}, status.Errorf(codes.InvalidArgument, "invalid source id: %v", err)
+	}
+	if in.Size > maxPageSize {
+		return source.ReportFilter{}, status.Errorf(codes.InvalidArgument, "invalid size value must not be greater than %d", maxPageSize)
+	}
+	filter := source.ReportFilter{
+		SourceID: in.SourceId,
+		States:   make([]source.ObjectState, 0, len(in.States)),
+		Page:     int(in.Page),
+		Size:     int(in.Size),
+	}
+	for _, s := range in.States {
+		state, ok := states[s]
+		if !ok {
+			return source.ReportFilter{}, status.Errorf(codes.InvalidArgument, "unsupported state: %s", s)
+		}
+		filter.States = append(filter.States, state)
+	}
+	if in.Type != pb.IngestionObjectType_OBJECT_UNDEFINED {
+		objectType, ok := objectTypes[in.Type]
+		if !ok {
+			return source.ReportFilter{}, status.Errorf(codes.InvalidArgument, "unsupported object type: %s", in.Type)
+		}
+		filter.Type = objectType
+	}
+	return filter, nil
+}
+
+func toPbState(state source.ObjectState) pb.IngestionState {
+	for k, v := range states {
+		if v == state {
+			return k
+		}
+	}
+	return pb.IngestionState_INGESTION_UNDEFINED
+}
+
+func toPbType(objectType source.ObjectType) pb.IngestionObjectType {
+	for k, v := range objectTypes {
+		if v == objectType {
+			return k
+		}
+	}
+	return pb.IngestionObjectType_OBJECT_UNDEFINED
+}
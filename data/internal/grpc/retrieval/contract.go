@@ -0,0 +1,21 @@
+package retrieval
+
+import (
+	"context"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+)
+
+type (
+	documentStore interface {
+		GetByID(ctx context.Context, id string, sourceIDs []string) (*document.Document, error)
+	}
+	chunkStore interface {
+		GetByIDs(ctx context.Context, ids, sourceIDs []string) ([]*document.Chunk, error)
+		Neighbors(ctx context.Context, ranges []document.ChunkRange) ([]*document.Chunk, error)
+	}
+	// originalStore открывает исходный объект документа: файл или снимок страницы
+	originalStore interface {
+		OpenOriginal(ctx context.Context, id string) (*document.Original, error)
+	}
+)
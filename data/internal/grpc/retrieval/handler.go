@@ -0,0 +1,270 @@
+package retrieval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/larek-tech/diploma/data/internal/data/pb"
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+	grpcSpan "github.com/larek-tech/diploma/data/internal/infrastructure/grpc/span"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxChunkIDs = 50
+	// downloadChunkSize размер части исходного объекта в одном сообщении потока
+	downloadChunkSize = 64 * 1024
+)
+
+type Handler struct {
+	documentStore documentStore
+	chunkStore    chunkStore
+	fileStore     originalStore
+	pageStore     originalStore
+	tracer        trace.Tracer
+}
+
+func New(documentStore documentStore, chunkStore chunkStore, fileStore, pageStore originalStore, tracer trace.Tracer) *Handler {
+	return &Handler{
+		documentStore: documentStore,
+		chunkStore:    chunkStore,
+		fileStore:     fileStore,
+		pageStore:     pageStore,
+		tracer:        tracer,
+	}
+}
+
+func (h Handler) GetDocument(ctx context.Context, in *pb.GetDocumentRequest) (*pb.Document, error) {
+	ctx, err := grpcSpan.GetTraceCtx(ctx)
+	if err != nil {
+		slog.Error("failed to get trace context", "error", err)
+	}
+	ctx, span := h.tracer.Start(ctx, "GetDocument", trace.WithAttributes(
+		attribute.String("id", in.Id),
+		attribute.String("sourceIds", strings.Join(in.SourceIds, ",")),
+	))
+	defer span.End()
+
+	if err = validateIDs("document id", []string{in.Id}); err != nil {
+		return nil, err
+	}
+	if err = validateSourceIDs(in.SourceIds); err != nil {
+		return nil, err
+	}
+	doc, err := h.getDocument(ctx, in.Id, in.SourceIds)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return toPbDocument(doc), nil
+}
+
+func (h Handler) GetChunks(ctx context.Context, in *pb.GetChunksRequest) (*pb.GetChunksResponse, error) {
+	ctx, err := grpcSpan.GetTraceCtx(ctx)
+	if err != nil {
+		slog.Error("failed to get trace context", "error", err)
+	}
+	ctx, span := h.tracer.Start(ctx, "GetChunks", trace.WithAttributes(
+		attribute.StringSlice("ids", in.Ids),
+		attribute.String("sourceIds", strings.Join(in.SourceIds, ",")),
+		attribute.Int64("window", int64(in.Window)),
+	))
+	defer span.End()
+
+	if len(in.Ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty chunk ids")
+	}
+	if len(in.Ids) > maxChunkIDs {
+		return nil, status.Errorf(codes.InvalidArgument, "too many chunk ids, must not be greater than %d", maxChunkIDs)
+	}
+	if err = validateIDs("chunk id", in.Ids); err != nil {
+		return nil, err
+	}
+	if err = validateSourceIDs(in.SourceIds); err != nil {
+		return nil, err
+	}
+	if in.Window > document.MaxNeighborWindow {
+		return nil, status.Errorf(codes.InvalidArgument, "window must not be greater than %d", document.MaxNeighborWindow)
+	}
+
+	chunks, err := h.chunkStore.GetByIDs(ctx, in.Ids, in.SourceIds)
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Errorf(codes.Internal, "failed to get chunks: %v", err)
+	}
+	if len(chunks) == 0 {
+		return nil, status.Error(codes.NotFound, "chunks not found")
+	}
+	found := make(map[string]struct{}, len(chunks))
+	for _, c := range chunks {
+		found[c.ID] = struct{}{}
+	}
+	res := &pb.GetChunksResponse{}
+	for _, id := range in.Ids {
+		if _, ok := found[id]; !ok {
+			res.MissingIds = append(res.MissingIds, id)
+		}
+	}
+
+	// соседние чанки запрашиваются и без расширения, чтобы вернуть чанки в порядке документа без повторов
+	chunks, err = h.chunkStore.Neighbors(ctx, document.ChunkRanges(chunks, int(in.Window)))
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Errorf(codes.Internal, "failed to get neighbor chunks: %v", err)
+	}
+	res.Chunks = make([]*pb.DocumentChunk, 0, len(chunks))
+	for _, c := range chunks {
+		res.Chunks = append(res.Chunks, &pb.DocumentChunk{
+			Id:         c.ID,
+			Index:      int64(c.Index),
+			Content:    c.Content,
+			Metadata:   c.Metadata,
+			DocumentId: c.DocumentID,
+			// каждый чанк ответа - отрывок из одного чанка, поэтому endIndex равен index, как в поиске без расширения
+			EndIndex: int64(c.Index),
+		})
+	}
+	return res, nil
+}
+
+// DownloadOriginal передает исходный файл или html снимок страницы документа частями по downloadChunkSize,
+// первое сообщение потока содержит имя, тип и размер объекта
+func (h Handler) DownloadOriginal(in *pb.DownloadOriginalRequest, stream grpc.ServerStreamingServer[pb.DownloadOriginalResponse]) error {
+	ctx, err := grpcSpan.GetTraceCtx(stream.Context())
+	if err != nil {
+		slog.Error("failed to get trace context", "error", err)
+	}
+	ctx, span := h.tracer.Start(ctx, "DownloadOriginal", trace.WithAttributes(
+		attribute.String("documentId", in.DocumentId),
+		attribute.String("sourceIds", strings.Join(in.SourceIds, ",")),
+	))
+	defer span.End()
+
+	if err = validateIDs("document id", []string{in.DocumentId}); err != nil {
+		return err
+	}
+	if err = validateSourceIDs(in.SourceIds); err != nil {
+		return err
+	}
+	doc, err := h.getDocument(ctx, in.DocumentId, in.SourceIds)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	var (
+		original   *document.Original
+		objectType string
+	)
+	switch doc.ObjectType {
+	case document.TypeFile:
+		objectType = "file"
+		original, err = h.fileStore.OpenOriginal(ctx, doc.ObjectID)
+	case document.TypePage:
+		objectType = "page"
+		original, err = h.pageStore.OpenOriginal(ctx, doc.ObjectID)
+	default:
+		return status.Errorf(codes.Internal, "unknown document object type: %s", doc.ObjectType)
+	}
+	if err != nil {
+		span.RecordError(err)
+		if errors.Is(err, document.ErrOriginalNotFound) {
+			return status.Error(codes.NotFound, err.Error())
+		}
+		return status.Errorf(codes.Internal, "failed to open original: %v", err)
+	}
+	defer original.Body.Close()
+	span.SetAttributes(attribute.Int64("size", original.Size))
+
+	err = stream.Send(&pb.DownloadOriginalResponse{Payload: &pb.DownloadOriginalResponse_Info{Info: &pb.OriginalInfo{
+		Filename:    original.Filename,
+		ContentType: original.ContentType,
+		Size:        original.Size,
+		ObjectType:  objectType,
+	}}})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	for {
+		// сообщение нельзя изменять после Send, поэтому у каждой части свой буфер
+		buf := make([]byte, downloadChunkSize)
+		n, readErr := io.ReadFull(original.Body, buf)
+		if n > 0 {
+			if err = stream.Send(&pb.DownloadOriginalResponse{Payload: &pb.DownloadOriginalResponse_Data{Data: buf[:n]}}); err != nil {
+				span.RecordError(err)
+				return err
+			}
+		}
+		if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if readErr != nil {
+			span.RecordError(readErr)
+			return status.Errorf(codes.Internal, "failed to read original: %v", readErr)
+		}
+	}
+}
+
+func (h Handler) getDocument(ctx context.Context, id string, sourceIDs []string) (*document.Document, error) {
+	doc, err := h.documentStore.GetByID(ctx, id, sourceIDs)
+	if err != nil {
+		if errors.Is(err, document.ErrDocumentNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get document: %v", err)
+	}
+	return doc, nil
+}
+
+func validateSourceIDs(sourceIDs []string) error {
+	if len(sourceIDs) == 0 {
+		return status.Error(codes.InvalidArgument, "empty source ids")
+	}
+	return validateIDs("source id", sourceIDs)
+}
+
+func validateIDs(name string, ids []string) error {
+	for _, id := range ids {
+		if _, err := uuid.Parse(id); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+func toPbDocument(doc *document.Document) *pb.Document {
+	var metadata string
+	if len(doc.Metadata) > 0 {
+		raw, err := json.Marshal(doc.Metadata)
+		if err != nil {
+			slog.Error("failed to marshal document metadata", "id", doc.ID, "error", err)
+		}
+		metadata = string(raw)
+	}
+	return &pb.Document{
+		Id:         doc.ID,
+		SourceId:   doc.SourceID,
+		Name:       doc.Name,
+		Content:    doc.Content,
+		Metadata:   metadata,
+		ObjectId:   doc.ObjectID,
+		ObjectType: string(doc.ObjectType),
+		Extension:  doc.Extension,
+		Url:        doc.URL,
+		Language:   doc.Language,
+		Date:       timestamppb.New(doc.Date),
+		CreatedAt:  timestamppb.New(doc.CreatedAt),
+		UpdatedAt:  timestamppb.New(doc.UpdatedAt),
+	}
+}
@@ -0,0 +1,15 @@
+package structured_tables
+
+import (
+	"context"
+
+	"github.com/larek-tech/diploma/data/internal/domain/structured"
+)
+
+type (
+	tableStore interface {
+		GetByID(ctx context.Context, id string, sourceIDs []string) (*structured.Table, error)
+		ListBySourceIDs(ctx context.Context, sourceIDs []string) ([]*structured.Table, error)
+		Aggregate(ctx context.Context, plan *structured.Plan) (*structured.Result, error)
+	}
+)
@@ -0,0 +1,173 @@
+package structured_tables
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/larek-tech/diploma/data/internal/data/pb"
+	"github.com/larek-tech/diploma/data/internal/domain/structured"
+	grpcSpan "github.com/larek-tech/diploma/data/internal/infrastructure/grpc/span"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var aggregateFuncs = map[pb.AggregateFunction]structured.AggregateFunc{
+	pb.AggregateFunction_AGGREGATE_COUNT: structured.AggregateCount,
+	pb.AggregateFunction_AGGREGATE_SUM:   structured.AggregateSum,
+	pb.AggregateFunction_AGGREGATE_AVG:   structured.AggregateAvg,
+	pb.AggregateFunction_AGGREGATE_MIN:   structured.AggregateMin,
+	pb.AggregateFunction_AGGREGATE_MAX:   structured.AggregateMax,
+}
+
+var filterOps = map[pb.FilterOperator]structured.FilterOp{
+	pb.FilterOperator_FILTER_EQ:  structured.FilterEq,
+	pb.FilterOperator_FILTER_NE:  structured.FilterNe,
+	pb.FilterOperator_FILTER_GT:  structured.FilterGt,
+	pb.FilterOperator_FILTER_GTE: structured.FilterGte,
+	pb.FilterOperator_FILTER_LT:  structured.FilterLt,
+	pb.FilterOperator_FILTER_LTE: structured.FilterLte,
+	pb.FilterOperator_FILTER_IN:  structured.FilterIn,
+}
+
+type Handler struct {
+	tableStore tableStore
+	tracer     trace.Tracer
+}
+
+func New(tableStore tableStore, tracer trace.Tracer) *Handler {
+	return &Handler{
+		tableStore: tableStore,
+		tracer:     tracer,
+	}
+}
+
+func (h Handler) ListTables(ctx context.Context, in *pb.ListTablesRequest) (*pb.ListTablesResponse, error) {
+	ctx, err := grpcSpan.GetTraceCtx(ctx)
+	if err != nil {
+		slog.Error("failed to get trace context", "error", err)
+	}
+	ctx, span := h.tracer.Start(ctx, "ListTables", trace.WithAttributes(
+		attribute.String("sourceIds", strings.Join(in.SourceIds, ",")),
+	))
+	defer span.End()
+
+	if err = validateSourceIDs(in.SourceIds); err != nil {
+		return nil, err
+	}
+	tables, err := h.tableStore.ListBySourceIDs(ctx, in.SourceIds)
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Errorf(codes.Internal, "failed to list tables: %v", err)
+	}
+
+	res := &pb.ListTablesResponse{Tables: make([]*pb.StructuredTable, 0, len(tables))}
+	for _, t := range tables {
+		columns := make([]*pb.TableColumn, 0, len(t.Columns))
+		for _, c := range t.Columns {
+			columns = append(columns, &pb.TableColumn{Name: c.Name, Type: string(c.Type)})
+		}
+		res.Tables = append(res.Tables, &pb.StructuredTable{
+			Id:         t.ID,
+			SourceId:   t.SourceID,
+			DocumentId: t.DocumentID,
+			Sheet:      t.Sheet,
+			Columns:    columns,
+			RowCount:   uint64(t.RowCount),
+		})
+	}
+	return res, nil
+}
+
+func (h Handler) AggregateTable(ctx context.Context, in *pb.AggregateTableRequest) (*pb.AggregateTableResponse, error) {
+	ctx, err := grpcSpan.GetTraceCtx(ctx)
+	if err != nil {
+		slog.Error("failed to get trace context", "error", err)
+	}
+	ctx, span := h.tracer.Start(ctx, "AggregateTable", trace.WithAttributes(
+		attribute.String("tableId", in.TableId),
+		attribute.Int("aggregations", len(in.Aggregations)),
+		attribute.String("groupBy", strings.Join(in.GroupBy, ",")),
+		attribute.Int("filters", len(in.Filters)),
+		attribute.String("sourceIds", strings.Join(in.SourceIds, ",")),
+	))
+	defer span.End()
+
+	if _, err = uuid.Parse(in.TableId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid table id: %v", err)
+	}
+	if err = validateSourceIDs(in.SourceIds); err != nil {
+		return nil, err
+	}
+	query, err := queryFromProto(in)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// таблицы других источников и замененных поколений не находятся, как и документы в поиске
+	table, err := h.tableStore.GetByID(ctx, in.TableId, in.SourceIds)
+	if err != nil {
+		span.RecordError(err)
+		if errors.Is(err, structured.ErrTableNotFound) {
+			return nil, status.Error(codes.NotFound, "table not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get table: %v", err)
+	}
+	plan, err := query.Plan(table)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	result, err := h.tableStore.Aggregate(ctx, plan)
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Errorf(codes.Internal, "failed to aggregate table: %v", err)
+	}
+
+	rows := make([]*pb.AggregateRow, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		rows = append(rows, &pb.AggregateRow{Values: row})
+	}
+	return &pb.AggregateTableResponse{
+		Columns:   result.Columns,
+		Rows:      rows,
+		Truncated: result.Truncated,
+	}, nil
+}
+
+func validateSourceIDs(sourceIDs []string) error {
+	if len(sourceIDs) == 0 {
+		return status.Error(codes.InvalidArgument, "empty source ids")
+	}
+	for _, id := range sourceIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid source id: %v", err)
+		}
+	}
+	return nil
+}
+
+func queryFromProto(in *pb.AggregateTableRequest) (structured.Query, error) {
+	query := structured.Query{
+		TableID: in.TableId,
+		GroupBy: in.GroupBy,
+		Limit:   int(in.Limit),
+	}
+	for _, a := range in.Aggregations {
+		fn, ok := aggregateFuncs[a.Function]
+		if !ok {
+			return query, errors.New("unknown aggregate function")
+		}
+		query.Aggregations = append(query.Aggregations, structured.Aggregation{Func: fn, Column: a.Column})
+	}
+	for _, f := range in.Filters {
+		op, ok := filterOps[f.Operator]
+		if !ok {
+			return query, errors.New("unknown filter operator")
+		}
+		query.Filters = append(query.Filters, structured.Filter{Column: f.Column, Op: op, Values: f.Values})
+	}
+	return query, nil
+}
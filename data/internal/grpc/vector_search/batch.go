@@ -0,0 +1,120 @@
+package vector_search
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/larek-tech/diploma/data/internal/data/pb"
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+	grpcSpan "github.com/larek-tech/diploma/data/internal/infrastructure/grpc/span"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBatchQueries максимальное количество запросов в пакетном поиске
+const maxBatchQueries = 10
+
+// BatchVectorSearch векторизует запросы одним обращением к модели, выполняет поиск по ним параллельно
+// и объединяет выдачи через RRF. MMR и расширение соседями применяются к объединенной выдаче.
+func (h Handler) BatchVectorSearch(ctx context.Context, in *pb.BatchVectorSearchRequest) (*pb.BatchVectorSearchResponse, error) {
+	ctx, err := grpcSpan.GetTraceCtx(ctx)
+	if err != nil {
+		slog.Error("failed to get trace context", "error", err)
+	}
+	ctx, span := h.tracer.Start(ctx, "BatchVectorSearch", trace.WithAttributes(
+		attribute.StringSlice("queries", in.Queries),
+		attribute.String("sourceIds", strings.Join(in.SourceIds, ",")),
+		attribute.Float64("threshold", float64(in.Threshold)),
+		attribute.Int64("topK", int64(in.TopK)),
+		attribute.Bool("useQuestions", in.UseQuestions),
+		attribute.Bool("hybrid", in.Hybrid != nil),
+		attribute.Bool("filter", in.Filter != nil),
+		attribute.Bool("diversify", in.Diversify != nil),
+		attribute.Int64("neighborWindow", int64(in.GetExpand().GetWindow())),
+	))
+	defer span.End()
+
+	if len(in.Queries) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty queries")
+	}
+	if len(in.Queries) > maxBatchQueries {
+		return nil, status.Errorf(codes.InvalidArgument, "too many queries, must not be greater than %d", maxBatchQueries)
+	}
+	for _, q := range in.Queries {
+		if strings.TrimSpace(q) == "" {
+			return nil, status.Error(codes.InvalidArgument, "empty query")
+		}
+	}
+	params, err := toSearchParams(in.SourceIds, in.Filter, in.Threshold, in.UseQuestions, in.Hybrid, in.Diversify, in.Expand, int(in.TopK))
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if err = h.validateFilter(ctx, params.filter); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	model, embedder, err := h.activeModel(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("embeddingModel", model.Key()))
+
+	queries, err := embedder.CreateEmbedding(ctx, in.Queries)
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Errorf(codes.Internal, "embedding error: %v", err)
+	}
+	if len(queries) != len(in.Queries) {
+		return nil, status.Errorf(codes.Internal, "embedding error: got %d embeddings for %d queries", len(queries), len(in.Queries))
+	}
+
+	rankings := make([][]*document.SearchResult, len(in.Queries))
+	g, gCtx := errgroup.WithContext(ctx)
+	for i := range in.Queries {
+		g.Go(func() error {
+			res, searchErr := h.search(gCtx, model, in.Queries[i], queries[i], params)
+			if searchErr != nil {
+				return searchErr
+			}
+			rankings[i] = res
+			return nil
+		})
+	}
+	if err = g.Wait(); err != nil {
+		span.RecordError(err)
+		return nil, searchError(err)
+	}
+
+	k := int(in.RrfK)
+	if k == 0 {
+		k = document.DefaultRRFK
+	}
+	res := document.FuseQueries(rankings, k, params.candidates())
+	if res, err = h.rerank(ctx, model, res, params); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	results := make([]*pb.BatchSearchChunk, 0, len(res))
+	for _, r := range res {
+		chunk := &pb.BatchSearchChunk{
+			Chunk: toPbChunk(r),
+			Hits:  make([]*pb.QueryHit, 0, len(r.Hits)),
+		}
+		for _, hit := range r.Hits {
+			chunk.Hits = append(chunk.Hits, &pb.QueryHit{
+				Query:      uint32(hit.Query),
+				Rank:       uint32(hit.Rank),
+				Similarity: hit.CosineSimilarity,
+			})
+		}
+		results = append(results, chunk)
+	}
+	return &pb.BatchVectorSearchResponse{Chunks: results}, nil
+}
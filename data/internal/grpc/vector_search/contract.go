@@ -4,13 +4,20 @@ import (
 	"context"
 
 	"github.com/larek-tech/diploma/data/internal/domain/document"
+	"github.com/larek-tech/diploma/data/internal/domain/embedding"
+	embeddingService "github.com/larek-tech/diploma/data/internal/domain/embedding/service"
 )
 
 type (
 	chunkStorage interface {
-		Search(ctx context.Context, query []float32, sourceIDs []string, threshold float32, limit int, useQuestions bool) ([]*document.SearchResult, error)
+		Search(ctx context.Context, model *embedding.Model, query []float32, sourceIDs []string, filter *document.SearchFilter, threshold float32, limit int, useQuestions bool) ([]*document.SearchResult, error)
+		LexicalSearch(ctx context.Context, model *embedding.Model, query string, queryEmbedding []float32, sourceIDs []string, filter *document.SearchFilter, limit int) ([]*document.SearchResult, error)
+		Neighbors(ctx context.Context, ranges []document.ChunkRange) ([]*document.Chunk, error)
+		Vectors(ctx context.Context, model *embedding.Model, chunkIDs []string) (map[string][]float32, error)
+		ValidateFilter(ctx context.Context, filter *document.SearchFilter) error
 	}
-	embedder interface {
-		CreateEmbedding(ctx context.Context, inputTexts []string) ([][]float32, error)
+	// embedders возвращает активную модель эмбеддингов, запрос векторизуется той же моделью, что и индекс
+	embedders interface {
+		Active(ctx context.Context) (*embedding.Model, embeddingService.Embedder, error)
 	}
 )
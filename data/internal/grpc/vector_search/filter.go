@@ -0,0 +1,45 @@
+package vector_search
+
+import (
+	"fmt"
+
+	"github.com/larek-tech/diploma/data/internal/data/pb"
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+)
+
+// toSearchFilter преобразует фильтр запроса в фильтр поиска, nil означает поиск без ограничений
+func toSearchFilter(in *pb.SearchFilter) (*document.SearchFilter, error) {
+	if in == nil {
+		return nil, nil
+	}
+	filter := &document.SearchFilter{
+		Extensions:         in.Extensions,
+		URLPrefixes:        in.UrlPrefixes,
+		Languages:          in.Languages,
+		DocumentPredicates: in.DocumentPredicates,
+		ChunkPredicates:    in.ChunkPredicates,
+	}
+	for _, t := range in.Types {
+		switch document.Type(t) {
+		case document.TypePage, document.TypeFile:
+			filter.Types = append(filter.Types, document.Type(t))
+		default:
+			return nil, fmt.Errorf("unknown document type: %s", t)
+		}
+	}
+	if in.DateFrom != nil {
+		from := in.DateFrom.AsTime()
+		filter.DateFrom = &from
+	}
+	if in.DateTo != nil {
+		to := in.DateTo.AsTime()
+		filter.DateTo = &to
+	}
+	if filter.DateFrom != nil && filter.DateTo != nil && filter.DateFrom.After(*filter.DateTo) {
+		return nil, fmt.Errorf("dateFrom is after dateTo")
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
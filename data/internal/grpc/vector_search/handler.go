@@ -2,10 +2,14 @@ package vector_search
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"strings"
 
 	"github.com/larek-tech/diploma/data/internal/data/pb"
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+	"github.com/larek-tech/diploma/data/internal/domain/embedding"
+	embeddingService "github.com/larek-tech/diploma/data/internal/domain/embedding/service"
 	grpcSpan "github.com/larek-tech/diploma/data/internal/infrastructure/grpc/span"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -15,12 +19,32 @@ import (
 
 type Handler struct {
 	chunkStore chunkStorage
-	embedder   embedder
+	embedders  embedders
 	tracer     trace.Tracer
 }
 
-func New(chunkStore chunkStorage, embedder embedder, tracer trace.Tracer) *Handler {
-	return &Handler{chunkStore: chunkStore, embedder: embedder, tracer: tracer}
+func New(chunkStore chunkStorage, embedders embedders, tracer trace.Tracer) *Handler {
+	return &Handler{chunkStore: chunkStore, embedders: embedders, tracer: tracer}
+}
+
+// searchParams общие параметры одиночного и пакетного поиска
+type searchParams struct {
+	sourceIDs    []string
+	filter       *document.SearchFilter
+	threshold    float32
+	useQuestions bool
+	hybrid       *pb.HybridSearch
+	diversity    *document.DiversityParams
+	window       int
+	topK         int
+}
+
+// candidates количество результатов поиска до переранжирования MMR
+func (p searchParams) candidates() int {
+	if p.diversity != nil {
+		return p.diversity.Candidates
+	}
+	return p.topK
 }
 
 func (h Handler) VectorSearch(ctx context.Context, in *pb.VectorSearchRequest) (*pb.VectorSearchResponse, error) {
@@ -34,31 +58,184 @@ func (h Handler) VectorSearch(ctx context.Context, in *pb.VectorSearchRequest) (
 		attribute.Float64("threshold", float64(in.Threshold)),
 		attribute.Int64("topK", int64(in.TopK)),
 		attribute.Bool("useQuestions", in.UseQuestions),
+		attribute.Bool("hybrid", in.Hybrid != nil),
+		attribute.Bool("filter", in.Filter != nil),
+		attribute.Bool("diversify", in.Diversify != nil),
+		attribute.Int64("neighborWindow", int64(in.GetExpand().GetWindow())),
 	))
 	defer span.End()
 
-	query, err := h.embedder.CreateEmbedding(ctx, []string{in.Query})
+	params, err := toSearchParams(in.SourceIds, in.Filter, in.Threshold, in.UseQuestions, in.Hybrid, in.Diversify, in.Expand, int(in.TopK))
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if err = h.validateFilter(ctx, params.filter); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	model, embedder, err := h.activeModel(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("embeddingModel", model.Key()))
+
+	query, err := embedder.CreateEmbedding(ctx, []string{in.Query})
 	if err != nil {
 		span.RecordError(err)
 		return nil, status.Errorf(codes.Internal, "embedding error: %v", err)
 	}
 
-	res, err := h.chunkStore.Search(ctx, query[0], in.SourceIds, in.Threshold, int(in.TopK), in.UseQuestions)
+	res, err := h.search(ctx, model, in.Query, query[0], params)
 	if err != nil {
 		span.RecordError(err)
-		return nil, status.Errorf(codes.Internal, "search error: %v", err)
+		return nil, searchError(err)
+	}
+	if res, err = h.rerank(ctx, model, res, params); err != nil {
+		span.RecordError(err)
+		return nil, err
 	}
 
 	var results []*pb.DocumentChunk
 	for _, r := range res {
-		results = append(results, &pb.DocumentChunk{
-			Id:         r.ID,
-			Index:      int64(r.Index),
-			Content:    r.Content,
-			Metadata:   r.Metadata,
-			Similarity: r.CosineSimilarity,
-		})
+		results = append(results, toPbChunk(r))
 	}
 
 	return &pb.VectorSearchResponse{Chunks: results}, status.New(codes.OK, "ok").Err()
 }
+
+// toSearchParams проверяет параметры запроса поиска
+func toSearchParams(
+	sourceIDs []string,
+	pbFilter *pb.SearchFilter,
+	threshold float32,
+	useQuestions bool,
+	hybrid *pb.HybridSearch,
+	diversify *pb.Diversification,
+	expand *pb.NeighborExpansion,
+	topK int,
+) (searchParams, error) {
+	filter, err := toSearchFilter(pbFilter)
+	if err != nil {
+		return searchParams{}, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	diversity, err := toDiversityParams(diversify, topK)
+	if err != nil {
+		return searchParams{}, status.Errorf(codes.InvalidArgument, "invalid diversification: %v", err)
+	}
+	window, err := neighborWindow(expand)
+	if err != nil {
+		return searchParams{}, status.Errorf(codes.InvalidArgument, "invalid neighbor expansion: %v", err)
+	}
+	return searchParams{
+		sourceIDs:    sourceIDs,
+		filter:       filter,
+		threshold:    threshold,
+		useQuestions: useQuestions,
+		hybrid:       hybrid,
+		diversity:    diversity,
+		window:       window,
+		topK:         topK,
+	}, nil
+}
+
+// validateFilter проверяет jsonpath предикаты фильтра в хранилище, чтобы ошибка синтаксиса
+// возвращалась клиенту как InvalidArgument, а не как ошибка поиска
+func (h Handler) validateFilter(ctx context.Context, filter *document.SearchFilter) error {
+	err := h.chunkStore.ValidateFilter(ctx, filter)
+	if errors.Is(err, document.ErrInvalidFilter) {
+		return status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "filter validation error: %v", err)
+	}
+	return nil
+}
+
+// searchError преобразует ошибку поиска в статус gRPC
+func searchError(err error) error {
+	if errors.Is(err, document.ErrSearchTimeout) {
+		return status.Errorf(codes.DeadlineExceeded, "search error: %v", err)
+	}
+	return status.Errorf(codes.Internal, "search error: %v", err)
+}
+
+// activeModel возвращает модель, на которую переключен поиск, и ее эмбеддер
+func (h Handler) activeModel(ctx context.Context) (*embedding.Model, embeddingService.Embedder, error) {
+	model, embedder, err := h.embedders.Active(ctx)
+	if err != nil {
+		if errors.Is(err, embedding.ErrNoActiveModel) || errors.Is(err, embedding.ErrModelNotConfigured) {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, "embedding model error: %v", err)
+		}
+		return nil, nil, status.Errorf(codes.Internal, "embedding model error: %v", err)
+	}
+	return model, embedder, nil
+}
+
+// search возвращает кандидатов векторного или гибридного поиска одного запроса
+func (h Handler) search(ctx context.Context, model *embedding.Model, query string, queryEmbedding []float32, params searchParams) ([]*document.SearchResult, error) {
+	if params.hybrid != nil {
+		return h.hybridSearch(ctx, model, query, queryEmbedding, params)
+	}
+	return h.chunkStore.Search(ctx, model, queryEmbedding, params.sourceIDs, params.filter, params.threshold, params.candidates(), params.useQuestions)
+}
+
+// rerank переранжирует кандидатов MMR и расширяет результаты соседними чанками, если это задано в запросе
+func (h Handler) rerank(ctx context.Context, model *embedding.Model, res []*document.SearchResult, params searchParams) ([]*document.SearchResult, error) {
+	var err error
+	if params.diversity != nil {
+		if res, err = h.diversify(ctx, model, res, *params.diversity, params.topK); err != nil {
+			return nil, status.Errorf(codes.Internal, "diversification error: %v", err)
+		}
+	}
+	if params.window > 0 {
+		if res, err = h.expand(ctx, res, params.window); err != nil {
+			return nil, status.Errorf(codes.Internal, "neighbor expansion error: %v", err)
+		}
+	}
+	return res, nil
+}
+
+// hybridSearch объединяет векторный и полнотекстовый поиск через RRF,
+// векторные кандидаты ищутся по чанкам или гипотетическим вопросам в зависимости от useQuestions
+func (h Handler) hybridSearch(ctx context.Context, model *embedding.Model, query string, queryEmbedding []float32, params searchParams) ([]*document.SearchResult, error) {
+	limit := params.candidates()
+	hybrid := document.HybridParams{
+		VectorWeight:  params.hybrid.VectorWeight,
+		LexicalWeight: params.hybrid.LexicalWeight,
+		K:             int(params.hybrid.RrfK),
+		Candidates:    int(params.hybrid.Candidates),
+	}
+	hybrid.Normalize(limit)
+
+	vector, err := h.chunkStore.Search(ctx, model, queryEmbedding, params.sourceIDs, params.filter, params.threshold, hybrid.Candidates, params.useQuestions)
+	if err != nil {
+		return nil, err
+	}
+	lexical, err := h.chunkStore.LexicalSearch(ctx, model, query, queryEmbedding, params.sourceIDs, params.filter, hybrid.Candidates)
+	if err != nil {
+		return nil, err
+	}
+	return document.FuseRRF(vector, lexical, hybrid, limit), nil
+}
+
+func toPbChunk(r *document.SearchResult) *pb.DocumentChunk {
+	chunk := &pb.DocumentChunk{
+		Id:           r.ID,
+		Index:        int64(r.Index),
+		Content:      r.Content,
+		Metadata:     r.Metadata,
+		Similarity:   r.CosineSimilarity,
+		Score:        r.Score,
+		DocumentId:   r.DocumentID,
+		EndIndex:     int64(r.Index),
+		ChunkIds:     r.ChunkIDs,
+		DocumentName: r.DocumentName,
+		DocumentUrl:  r.DocumentURL,
+	}
+	if len(r.ChunkIDs) > 0 {
+		chunk.EndIndex = int64(r.EndIndex)
+	}
+	return chunk
+}
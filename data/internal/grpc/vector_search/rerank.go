@@ -0,0 +1,63 @@
+package vector_search
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/larek-tech/diploma/data/internal/data/pb"
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+	"github.com/larek-tech/diploma/data/internal/domain/embedding"
+)
+
+// toDiversityParams преобразует параметры MMR запроса, nil означает порядок по релевантности
+func toDiversityParams(in *pb.Diversification, limit int) (*document.DiversityParams, error) {
+	if in == nil {
+		return nil, nil
+	}
+	params := &document.DiversityParams{
+		Lambda:         document.DefaultMMRLambda,
+		MaxPerDocument: int(in.MaxPerDocument),
+		Candidates:     int(in.Candidates),
+	}
+	if in.Lambda != nil {
+		if in.GetLambda() < 0 || in.GetLambda() > 1 {
+			return nil, fmt.Errorf("lambda must be in [0, 1], got %v", in.GetLambda())
+		}
+		params.Lambda = in.GetLambda()
+	}
+	params.Normalize(limit)
+	return params, nil
+}
+
+// neighborWindow возвращает количество соседних чанков с каждой стороны результата, 0 - без расширения
+func neighborWindow(in *pb.NeighborExpansion) (int, error) {
+	if in == nil {
+		return 0, nil
+	}
+	if in.Window > document.MaxNeighborWindow {
+		return 0, fmt.Errorf("window must not be greater than %d", document.MaxNeighborWindow)
+	}
+	return int(in.Window), nil
+}
+
+// diversify переранжирует кандидатов MMR по векторам чанков активной модели
+func (h Handler) diversify(ctx context.Context, model *embedding.Model, candidates []*document.SearchResult, params document.DiversityParams, limit int) ([]*document.SearchResult, error) {
+	ids := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.ID)
+	}
+	vectors, err := h.chunkStore.Vectors(ctx, model, ids)
+	if err != nil {
+		return nil, err
+	}
+	return document.MMR(candidates, vectors, params, limit), nil
+}
+
+// expand заменяет результаты отрывками с window соседними чанками с каждой стороны
+func (h Handler) expand(ctx context.Context, results []*document.SearchResult, window int) ([]*document.SearchResult, error) {
+	neighbors, err := h.chunkStore.Neighbors(ctx, document.NeighborRanges(results, window))
+	if err != nil {
+		return nil, err
+	}
+	return document.ExpandPassages(results, neighbors, window), nil
+}
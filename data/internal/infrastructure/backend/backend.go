@@ -0,0 +1,84 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/larek-tech/diploma/data/internal/infrastructure/fake"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/ollama"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/openai"
+)
+
+// Backend протокол сервера моделей
+type Backend string
+
+const (
+	Ollama Backend = "ollama" // Ollama API
+	OpenAI Backend = "openai" // OpenAI-совместимый API (vLLM, TEI и т.п.)
+	Fake   Backend = "fake"   // детерминированная заглушка для тестов
+)
+
+type (
+	Embedder interface {
+		CreateEmbedding(ctx context.Context, inputTexts []string) ([][]float32, error)
+		EmbeddingsModel() string
+	}
+	LLM interface {
+		Call(ctx context.Context, prompt string) (string, error)
+	}
+)
+
+// Config параметры подключения к серверу моделей
+type Config struct {
+	Backend     Backend
+	Endpoint    string
+	APIKey      string
+	Model       string
+	ContextSize int // размер контекста модели (num_ctx для Ollama)
+	Dimensions  int // размерность эмбеддингов для OpenAI-совместимых и fake backend
+	BatchSize   int // количество текстов в одном запросе на эмбеддинг
+	Concurrency int // количество одновременных запросов на эмбеддинг
+}
+
+func NewEmbedder(cfg Config) (Embedder, error) {
+	switch cfg.Backend {
+	case Ollama, "":
+		return ollama.New(cfg.Endpoint, &ollama.Config{
+			EmbeddingSize:    cfg.ContextSize,
+			EmbeddingsModel:  cfg.Model,
+			EmbedBatchSize:   cfg.BatchSize,
+			EmbedConcurrency: cfg.Concurrency,
+		})
+	case OpenAI:
+		return openai.New(cfg.Endpoint, &openai.Config{
+			APIKey:           cfg.APIKey,
+			EmbeddingsModel:  cfg.Model,
+			Dimensions:       cfg.Dimensions,
+			EmbedBatchSize:   cfg.BatchSize,
+			EmbedConcurrency: cfg.Concurrency,
+		})
+	case Fake:
+		return fake.New(cfg.Dimensions), nil
+	default:
+		return nil, fmt.Errorf("unsupported embedder backend: %q", cfg.Backend)
+	}
+}
+
+func NewLLM(cfg Config) (LLM, error) {
+	switch cfg.Backend {
+	case Ollama, "":
+		return ollama.New(cfg.Endpoint, &ollama.Config{
+			LLMModel:       cfg.Model,
+			LLMContextSize: cfg.ContextSize,
+		})
+	case OpenAI:
+		return openai.New(cfg.Endpoint, &openai.Config{
+			APIKey:   cfg.APIKey,
+			LLMModel: cfg.Model,
+		})
+	case Fake:
+		return fake.New(cfg.Dimensions), nil
+	default:
+		return nil, fmt.Errorf("unsupported llm backend: %q", cfg.Backend)
+	}
+}
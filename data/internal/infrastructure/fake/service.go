@@ -0,0 +1,78 @@
+package fake
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+const (
+	Model      = "fake"
+	Dimensions = 1024
+)
+
+// Service детерминированный эмбеддер и llm без внешних зависимостей для тестов и локального запуска:
+// одинаковый текст всегда получает одинаковый нормированный вектор.
+type Service struct {
+	dimensions int
+}
+
+func New(dimensions int) *Service {
+	if dimensions <= 0 {
+		dimensions = Dimensions
+	}
+	return &Service{
+		dimensions: dimensions,
+	}
+}
+
+// EmbeddingsModel возвращает название модели эмбеддингов.
+func (s Service) EmbeddingsModel() string {
+	return fmt.Sprintf("%s-%d", Model, s.dimensions)
+}
+
+func (s Service) CreateEmbedding(_ context.Context, inputTexts []string) ([][]float32, error) {
+	embeddings := make([][]float32, len(inputTexts))
+	for i, text := range inputTexts {
+		embeddings[i] = s.embed(text)
+	}
+	return embeddings, nil
+}
+
+// Call возвращает конец запроса (текст чанка после промпта), чтобы ответ зависел только от входных данных.
+func (s Service) Call(_ context.Context, prompt string) (string, error) {
+	runes := []rune(prompt)
+	if len(runes) > 64 {
+		runes = runes[len(runes)-64:]
+	}
+	return "fake: " + string(runes), nil
+}
+
+func (s Service) embed(text string) []float32 {
+	res := make([]float32, s.dimensions)
+	seed := sha256.Sum256([]byte(text))
+	// каждые 8 координат берутся из хэша seed и номера блока
+	for i := 0; i < len(res); i += 8 {
+		var block [36]byte
+		copy(block[:], seed[:])
+		binary.BigEndian.PutUint32(block[32:], uint32(i/8))
+		sum := sha256.Sum256(block[:])
+		for j := 0; j < 8 && i+j < len(res); j++ {
+			res[i+j] = float32(binary.BigEndian.Uint32(sum[j*4:]))/math.MaxUint32*2 - 1
+		}
+	}
+	var norm float64
+	for _, v := range res {
+		norm += float64(v) * float64(v)
+	}
+	norm = math.Sqrt(norm)
+	if norm == 0 {
+		return res
+	}
+	for i := range res {
+		res[i] = float32(float64(res[i]) / norm)
+	}
+	return res
+}
@@ -0,0 +1,26 @@
+package fake
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateEmbedding(t *testing.T) {
+	t.Parallel()
+
+	s := New(10)
+	res, err := s.CreateEmbedding(context.Background(), []string{"text", "other", "text"})
+	assert.NoError(t, err)
+	assert.Len(t, res[0], 10)
+	assert.Equal(t, res[0], res[2])
+	assert.NotEqual(t, res[0], res[1])
+
+	var norm float64
+	for _, v := range res[0] {
+		norm += float64(v) * float64(v)
+	}
+	assert.InDelta(t, 1, math.Sqrt(norm), 1e-5)
+}
@@ -4,13 +4,37 @@ import (
 	"context"
 
 	"github.com/larek-tech/diploma/data/internal/data/pb"
+	"google.golang.org/grpc"
 )
 
 type (
 	VectorSearchHandler interface {
 		VectorSearch(ctx context.Context, in *pb.VectorSearchRequest) (*pb.VectorSearchResponse, error)
+		BatchVectorSearch(ctx context.Context, in *pb.BatchVectorSearchRequest) (*pb.BatchVectorSearchResponse, error)
 	}
 	GetDocumentsHandler interface {
 		GetDocuments(context.Context, *pb.GetDocumentsIn) (*pb.GetDocumentsOut, error)
 	}
+	RetrievalHandler interface {
+		GetDocument(context.Context, *pb.GetDocumentRequest) (*pb.Document, error)
+		GetChunks(context.Context, *pb.GetChunksRequest) (*pb.GetChunksResponse, error)
+		DownloadOriginal(*pb.DownloadOriginalRequest, grpc.ServerStreamingServer[pb.DownloadOriginalResponse]) error
+	}
+	StructuredTablesHandler interface {
+		ListTables(context.Context, *pb.ListTablesRequest) (*pb.ListTablesResponse, error)
+		AggregateTable(context.Context, *pb.AggregateTableRequest) (*pb.AggregateTableResponse, error)
+	}
+	IngestionReportHandler interface {
+		GetIngestionReport(context.Context, *pb.GetIngestionReportRequest) (*pb.GetIngestionReportResponse, error)
+	}
+	DeadLettersHandler interface {
+		ListDeadLetters(context.Context, *pb.ListDeadLettersRequest) (*pb.ListDeadLettersResponse, error)
+		GetDeadLetter(context.Context, *pb.GetDeadLetterRequest) (*pb.DeadLetter, error)
+		ReplayDeadLetters(context.Context, *pb.ReplayDeadLettersRequest) (*pb.ReplayDeadLettersResponse, error)
+	}
+	EmbeddingModelsHandler interface {
+		ListEmbeddingModels(context.Context, *pb.ListEmbeddingModelsRequest) (*pb.ListEmbeddingModelsResponse, error)
+		StartReembedding(context.Context, *pb.StartReembeddingRequest) (*pb.StartReembeddingResponse, error)
+		ActivateEmbeddingModel(context.Context, *pb.ActivateEmbeddingModelRequest) (*pb.EmbeddingModel, error)
+	}
 )
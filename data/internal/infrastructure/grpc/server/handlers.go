@@ -4,19 +4,38 @@ import (
 	"context"
 
 	"github.com/larek-tech/diploma/data/internal/data/pb"
+	"google.golang.org/grpc"
 )
 
 type Handlers struct {
 	pb.UnimplementedDataServiceServer
 	vh  VectorSearchHandler
 	gdh GetDocumentsHandler
+	rth RetrievalHandler
+	sth StructuredTablesHandler
+	irh IngestionReportHandler
+	dlh DeadLettersHandler
+	emh EmbeddingModelsHandler
 }
 
-func NewHandlers(vectorSearchHandler VectorSearchHandler, getDocumentsHandler GetDocumentsHandler) *Handlers {
+func NewHandlers(
+	vectorSearchHandler VectorSearchHandler,
+	getDocumentsHandler GetDocumentsHandler,
+	retrievalHandler RetrievalHandler,
+	structuredTablesHandler StructuredTablesHandler,
+	ingestionReportHandler IngestionReportHandler,
+	deadLettersHandler DeadLettersHandler,
+	embeddingModelsHandler EmbeddingModelsHandler,
+) *Handlers {
 	return &Handlers{
 		UnimplementedDataServiceServer: pb.UnimplementedDataServiceServer{},
 		vh:                             vectorSearchHandler,
 		gdh:                            getDocumentsHandler,
+		rth:                            retrievalHandler,
+		sth:                            structuredTablesHandler,
+		irh:                            ingestionReportHandler,
+		dlh:                            deadLettersHandler,
+		emh:                            embeddingModelsHandler,
 	}
 }
 
@@ -24,6 +43,58 @@ func (h Handlers) VectorSearch(ctx context.Context, in *pb.VectorSearchRequest)
 	return h.vh.VectorSearch(ctx, in)
 }
 
+func (h Handlers) BatchVectorSearch(ctx context.Context, in *pb.BatchVectorSearchRequest) (*pb.BatchVectorSearchResponse, error) {
+	return h.vh.BatchVectorSearch(ctx, in)
+}
+
 func (h Handlers) GetDocuments(ctx context.Context, in *pb.GetDocumentsIn) (*pb.GetDocumentsOut, error) {
 	return h.gdh.GetDocuments(ctx, in)
 }
+
+func (h Handlers) GetDocument(ctx context.Context, in *pb.GetDocumentRequest) (*pb.Document, error) {
+	return h.rth.GetDocument(ctx, in)
+}
+
+func (h Handlers) GetChunks(ctx context.Context, in *pb.GetChunksRequest) (*pb.GetChunksResponse, error) {
+	return h.rth.GetChunks(ctx, in)
+}
+
+func (h Handlers) DownloadOriginal(in *pb.DownloadOriginalRequest, stream grpc.ServerStreamingServer[pb.DownloadOriginalResponse]) error {
+	return h.rth.DownloadOriginal(in, stream)
+}
+
+func (h Handlers) ListTables(ctx context.Context, in *pb.ListTablesRequest) (*pb.ListTablesResponse, error) {
+	return h.sth.ListTables(ctx, in)
+}
+
+func (h Handlers) AggregateTable(ctx context.Context, in *pb.AggregateTableRequest) (*pb.AggregateTableResponse, error) {
+	return h.sth.AggregateTable(ctx, in)
+}
+
+func (h Handlers) GetIngestionReport(ctx context.Context, in *pb.GetIngestionReportRequest) (*pb.GetIngestionReportResponse, error) {
+	return h.irh.GetIngestionReport(ctx, in)
+}
+
+func (h Handlers) ListDeadLetters(ctx context.Context, in *pb.ListDeadLettersRequest) (*pb.ListDeadLettersResponse, error) {
+	return h.dlh.ListDeadLetters(ctx, in)
+}
+
+func (h Handlers) GetDeadLetter(ctx context.Context, in *pb.GetDeadLetterRequest) (*pb.DeadLetter, error) {
+	return h.dlh.GetDeadLetter(ctx, in)
+}
+
+func (h Handlers) ReplayDeadLetters(ctx context.Context, in *pb.ReplayDeadLettersRequest) (*pb.ReplayDeadLettersResponse, error) {
+	return h.dlh.ReplayDeadLetters(ctx, in)
+}
+
+func (h Handlers) ListEmbeddingModels(ctx context.Context, in *pb.ListEmbeddingModelsRequest) (*pb.ListEmbeddingModelsResponse, error) {
+	return h.emh.ListEmbeddingModels(ctx, in)
+}
+
+func (h Handlers) StartReembedding(ctx context.Context, in *pb.StartReembeddingRequest) (*pb.StartReembeddingResponse, error) {
+	return h.emh.StartReembedding(ctx, in)
+}
+
+func (h Handlers) ActivateEmbeddingModel(ctx context.Context, in *pb.ActivateEmbeddingModelRequest) (*pb.EmbeddingModel, error) {
+	return h.emh.ActivateEmbeddingModel(ctx, in)
+}
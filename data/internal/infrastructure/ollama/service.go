@@ -3,13 +3,13 @@ package ollama
 import (
 	"context"
 	"encoding/json"
-
+	"fmt"
 	"net/http"
 	"net/url"
 	"time"
 
 	"github.com/ollama/ollama/api"
-	"github.com/samber/lo"
+	"golang.org/x/sync/errgroup"
 )
 
 type Service struct {
@@ -18,25 +18,31 @@ type Service struct {
 }
 
 type Config struct {
-	EmbeddingSize   int
-	EmbeddingsModel string
-	LLMModel        string
-	LLMContextSize  int
+	EmbeddingSize    int
+	EmbeddingsModel  string
+	EmbedBatchSize   int // количество текстов в одном запросе к /api/embed
+	EmbedConcurrency int // количество одновременных запросов на эмбеддинг
+	LLMModel         string
+	LLMContextSize   int
 }
 
 const (
-	EmbeddingSize   = 8192
-	EmbeddingsModel = "bge-m3"
-	LLMModel        = "llama3.1:latest"
-	LLMContextSize  = 32000
+	EmbeddingSize    = 8192
+	EmbeddingsModel  = "bge-m3"
+	EmbedBatchSize   = 32
+	EmbedConcurrency = 4
+	LLMModel         = "llama3.1:latest"
+	LLMContextSize   = 32000
 )
 
 func NewDefaultConfig() *Config {
 	return &Config{
-		EmbeddingSize:   EmbeddingSize,
-		EmbeddingsModel: EmbeddingsModel,
-		LLMModel:        LLMModel,
-		LLMContextSize:  LLMContextSize,
+		EmbeddingSize:    EmbeddingSize,
+		EmbeddingsModel:  EmbeddingsModel,
+		EmbedBatchSize:   EmbedBatchSize,
+		EmbedConcurrency: EmbedConcurrency,
+		LLMModel:         LLMModel,
+		LLMContextSize:   LLMContextSize,
 	}
 }
 
@@ -56,22 +62,48 @@ func New(host string, cfg ...*Config) (*Service, error) {
 	return &Service{client: client, cfg: cfg[0]}, nil
 }
 
+// EmbeddingsModel возвращает название модели эмбеддингов.
+func (s Service) EmbeddingsModel() string {
+	return s.cfg.EmbeddingsModel
+}
+
+// CreateEmbedding возвращает эмбеддинги текстов в исходном порядке, тексты отправляются пачками
+// по EmbedBatchSize, одновременно выполняется не больше EmbedConcurrency запросов.
 func (s Service) CreateEmbedding(ctx context.Context, inputTexts []string) ([][]float32, error) {
 	embeddings := make([][]float32, len(inputTexts))
-	for i, t := range inputTexts {
-		req := &api.EmbeddingRequest{
-			Model:     s.cfg.EmbeddingsModel,
-			Prompt:    t,
-			KeepAlive: &api.Duration{Duration: keepAlive},
-			Options:   map[string]any{"num_ctx": s.cfg.EmbeddingSize},
-		}
-		res, err := s.client.Embeddings(ctx, req)
-		if err != nil {
-			return nil, err
-		}
-		embeddings[i] = lo.Map(res.Embedding, func(e float64, _ int) float32 { return float32(e) })
+	batchSize := s.cfg.EmbedBatchSize
+	if batchSize <= 0 {
+		batchSize = EmbedBatchSize
+	}
+	concurrency := s.cfg.EmbedConcurrency
+	if concurrency <= 0 {
+		concurrency = EmbedConcurrency
 	}
 
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+	for start := 0; start < len(inputTexts); start += batchSize {
+		end := min(start+batchSize, len(inputTexts))
+		g.Go(func() error {
+			res, err := s.client.Embed(gCtx, &api.EmbedRequest{
+				Model:     s.cfg.EmbeddingsModel,
+				Input:     inputTexts[start:end],
+				KeepAlive: &api.Duration{Duration: keepAlive},
+				Options:   map[string]any{"num_ctx": s.cfg.EmbeddingSize},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to embed batch: %w", err)
+			}
+			if len(res.Embeddings) != end-start {
+				return fmt.Errorf("got %d embeddings for %d texts", len(res.Embeddings), end-start)
+			}
+			copy(embeddings[start:end], res.Embeddings)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
 	return embeddings, nil
 }
 
@@ -0,0 +1,181 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Service клиент серверов, совместимых с OpenAI API (vLLM, TEI и т.п.): /v1/embeddings и /v1/chat/completions
+type Service struct {
+	client   *http.Client
+	endpoint string
+	cfg      *Config
+}
+
+type Config struct {
+	APIKey           string
+	EmbeddingsModel  string
+	Dimensions       int // размерность эмбеддингов, 0 - размерность модели по умолчанию
+	EmbedBatchSize   int // количество текстов в одном запросе к /v1/embeddings
+	EmbedConcurrency int // количество одновременных запросов на эмбеддинг
+	LLMModel         string
+	Timeout          time.Duration
+}
+
+const (
+	EmbedBatchSize   = 32
+	EmbedConcurrency = 4
+	Timeout          = time.Minute * 5
+)
+
+func New(endpoint string, cfg *Config) (*Service, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("openai endpoint is empty")
+	}
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = Timeout
+	}
+	return &Service{
+		client:   &http.Client{Timeout: timeout},
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		cfg:      cfg,
+	}, nil
+}
+
+type embeddingsRequest struct {
+	Model      string   `json:"model"`
+	Input      []string `json:"input"`
+	Dimensions int      `json:"dimensions,omitempty"`
+}
+
+type embeddingsResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatRequest struct {
+	Model    string        `json:"model"`
+	Messages []chatMessage `json:"messages"`
+	Stream   bool          `json:"stream"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+}
+
+// EmbeddingsModel возвращает название модели эмбеддингов.
+func (s Service) EmbeddingsModel() string {
+	return s.cfg.EmbeddingsModel
+}
+
+// CreateEmbedding возвращает эмбеддинги текстов в исходном порядке, тексты отправляются пачками
+// по EmbedBatchSize, одновременно выполняется не больше EmbedConcurrency запросов.
+func (s Service) CreateEmbedding(ctx context.Context, inputTexts []string) ([][]float32, error) {
+	embeddings := make([][]float32, len(inputTexts))
+	batchSize := s.cfg.EmbedBatchSize
+	if batchSize <= 0 {
+		batchSize = EmbedBatchSize
+	}
+	concurrency := s.cfg.EmbedConcurrency
+	if concurrency <= 0 {
+		concurrency = EmbedConcurrency
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+	for start := 0; start < len(inputTexts); start += batchSize {
+		end := min(start+batchSize, len(inputTexts))
+		g.Go(func() error {
+			var res embeddingsResponse
+			err := s.post(gCtx, "/v1/embeddings", embeddingsRequest{
+				Model:      s.cfg.EmbeddingsModel,
+				Input:      inputTexts[start:end],
+				Dimensions: s.cfg.Dimensions,
+			}, &res)
+			if err != nil {
+				return fmt.Errorf("failed to embed batch: %w", err)
+			}
+			if len(res.Data) != end-start {
+				return fmt.Errorf("got %d embeddings for %d texts", len(res.Data), end-start)
+			}
+			sort.Slice(res.Data, func(i, j int) bool {
+				return res.Data[i].Index < res.Data[j].Index
+			})
+			for i, d := range res.Data {
+				embeddings[start+i] = d.Embedding
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return embeddings, nil
+}
+
+func (s Service) Call(ctx context.Context, prompt string) (string, error) {
+	var res chatResponse
+	err := s.post(ctx, "/v1/chat/completions", chatRequest{
+		Model: s.cfg.LLMModel,
+		Messages: []chatMessage{
+			{Role: "user", Content: prompt},
+		},
+	}, &res)
+	if err != nil {
+		return "", err
+	}
+	if len(res.Choices) == 0 {
+		return "", fmt.Errorf("got empty completion")
+	}
+	return res.Choices[0].Message.Content, nil
+}
+
+func (s Service) post(ctx context.Context, path string, body any, dst any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint+path, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.cfg.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.cfg.APIKey)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	if err = json.NewDecoder(resp.Body).Decode(dst); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateEmbedding(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/embeddings", r.URL.Path)
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		var req embeddingsRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		var res embeddingsResponse
+		// сервер может вернуть эмбеддинги не по порядку
+		for i := len(req.Input) - 1; i >= 0; i-- {
+			res.Data = append(res.Data, struct {
+				Index     int       `json:"index"`
+				Embedding []float32 `json:"embedding"`
+			}{Index: i, Embedding: []float32{float32(len(req.Input[i]))}})
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+	defer srv.Close()
+
+	s, err := New(srv.URL, &Config{APIKey: "key", EmbeddingsModel: "model", EmbedBatchSize: 2})
+	assert.NoError(t, err)
+	res, err := s.CreateEmbedding(context.Background(), []string{"a", "bb", "ccc"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]float32{{1}, {2}, {3}}, res)
+}
+
+func TestCall(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"ответ"}}]}`))
+	}))
+	defer srv.Close()
+
+	s, err := New(srv.URL, &Config{LLMModel: "model"})
+	assert.NoError(t, err)
+	res, err := s.Call(context.Background(), "вопрос")
+	assert.NoError(t, err)
+	assert.Equal(t, "ответ", res)
+}
@@ -3,7 +3,12 @@ package qaas
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
+	"maps"
+	"strconv"
 	"time"
 
 	"go.dataddo.com/pgq"
@@ -15,27 +20,56 @@ const (
 )
 
 type Consumer struct {
-	db *sql.DB
+	db     *sql.DB
+	pub    pgq.Publisher
+	policy RetryPolicy
+}
+
+type ConsumerOption func(*Consumer)
+
+// WithRetryPolicy задает ограничения повторной обработки задач
+func WithRetryPolicy(policy RetryPolicy) ConsumerOption {
+	return func(c *Consumer) {
+		c.policy = policy
+	}
 }
 
 func NewConsumer(
 	db *sql.DB,
+	opts ...ConsumerOption,
 ) *Consumer {
-	return &Consumer{
-		db: db,
+	c := &Consumer{
+		db:     db,
+		pub:    pgq.NewPublisher(db),
+		policy: DefaultRetryPolicy(),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 type adapter struct {
-	h handler
+	h     handler
+	queue Queue
+	c     *Consumer
 }
 
 func (m adapter) HandleMessage(ctx context.Context, msg *pgq.MessageIncoming) (processed bool, err error) {
-	return m.h.Handle(ctx, msg)
+	processed, err = m.h.Handle(withRetryPolicy(ctx, m.c.policy), msg)
+	if err == nil {
+		return processed, nil
+	}
+	// время обработки сообщения могло истечь, повтор все равно должен быть сохранен
+	if failErr := m.c.fail(context.WithoutCancel(ctx), m.queue, msg, err); failErr != nil {
+		return false, errors.Join(err, failErr)
+	}
+	// ошибка сохраняется в error_detail обработанного сообщения
+	return true, err
 }
 
 func (c *Consumer) Run(ctx context.Context, queue Queue, h handler) error {
-	consumer, err := pgq.NewConsumer(c.db, string(queue), adapter{h: h},
+	consumer, err := pgq.NewConsumer(c.db, string(queue), adapter{h: h, queue: queue, c: c},
 		pgq.WithMaxParallelMessages(parallelMessages),
 		pgq.WithPollingInterval(pollingInterval),
 	)
@@ -44,3 +78,55 @@ func (c *Consumer) Run(ctx context.Context, queue Queue, h handler) error {
 	}
 	return consumer.Run(ctx)
 }
+
+// fail ставит задачу в очередь повторно с экспоненциальной задержкой,
+// после исчерпания попыток или неисправимой ошибки задача переносится в dead letter таблицу очереди.
+func (c *Consumer) fail(ctx context.Context, queue Queue, msg *pgq.MessageIncoming, handleErr error) error {
+	attempt := Attempt(msg)
+	if !IsPermanent(handleErr) && attempt < c.policy.MaxAttempts {
+		scheduledFor := time.Now().Add(c.policy.Backoff(attempt))
+		metadata := maps.Clone(msg.Metadata)
+		if metadata == nil {
+			metadata = pgq.Metadata{}
+		}
+		metadata[AttemptKey] = strconv.Itoa(attempt + 1)
+		metadata[LastErrorKey] = handleErr.Error()
+		_, err := c.pub.Publish(ctx, string(queue), &pgq.MessageOutgoing{
+			ScheduledFor: &scheduledFor,
+			Payload:      msg.Payload,
+			Metadata:     metadata,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to schedule retry: %w", err)
+		}
+		slog.Warn("job failed, retry scheduled",
+			"queue", queue,
+			"jobID", msg.ID(),
+			"attempt", attempt,
+			"scheduledFor", scheduledFor,
+			"error", handleErr,
+		)
+		return nil
+	}
+
+	metadata, err := json.Marshal(msg.Metadata)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job metadata: %w", err)
+	}
+	// повторная доставка того же сообщения не создает дубликат
+	_, err = c.db.ExecContext(ctx, `
+INSERT INTO `+DeadLetterTable(queue)+` (id, payload, metadata, error, attempts)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO NOTHING;
+`, msg.ID(), []byte(msg.Payload), metadata, handleErr.Error(), attempt)
+	if err != nil {
+		return fmt.Errorf("failed to move job to dead letter table: %w", err)
+	}
+	slog.Error("job moved to dead letter table",
+		"queue", queue,
+		"jobID", msg.ID(),
+		"attempts", attempt,
+		"error", handleErr,
+	)
+	return nil
+}
@@ -10,4 +10,7 @@ type (
 	handler interface {
 		Handle(context.Context, *pgq.MessageIncoming) (bool, error)
 	}
+	generationStore interface {
+		TargetGeneration(ctx context.Context, sourceID string) (int, error)
+	}
 )
@@ -0,0 +1,63 @@
+package qaas
+
+import (
+	"fmt"
+	"time"
+)
+
+// queues все очереди data сервиса, для каждой создается dead letter таблица
+var queues = []Queue{
+	ParseSiteQueue,
+	ParsePageQueue,
+	ParsePageResultQueue,
+	ParseFileQueue,
+	ParseFileResult,
+	ParseS3Queue,
+	ParseS3ResultQueue,
+	ParseSiteStatusQueue,
+	EmbedResultQueue,
+	RefreshSourceQueue,
+	ReembedQueue,
+}
+
+// ParseQueue проверяет, что name является известной очередью.
+// Имена очередей подставляются в запросы как имена таблиц, поэтому пользовательский ввод нужно проверять.
+func ParseQueue(name string) (Queue, bool) {
+	for _, q := range queues {
+		if string(q) == name {
+			return q, true
+		}
+	}
+	return "", false
+}
+
+// DeadLetterTable имя таблицы с задачами очереди, которые не удалось обработать
+func DeadLetterTable(queue Queue) string {
+	return string(queue) + "_dead_letter"
+}
+
+func deadLetterTableQuery(queue Queue) string {
+	return fmt.Sprintf(`
+CREATE TABLE IF NOT EXISTS %s (
+	id UUID PRIMARY KEY,
+	payload JSONB NOT NULL,
+	metadata JSONB NOT NULL,
+	error TEXT NOT NULL,
+	attempts INT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	replayed_at TIMESTAMPTZ
+);
+`, DeadLetterTable(queue))
+}
+
+// DeadLetter задача, перенесенная в dead letter таблицу после исчерпания попыток обработки
+type DeadLetter struct {
+	ID         string            `db:"id"`
+	Queue      Queue             `db:"-"`
+	Payload    []byte            `db:"payload"`
+	Metadata   map[string]string `db:"metadata"`
+	Error      string            `db:"error"`
+	Attempts   int               `db:"attempts"`
+	CreatedAt  time.Time         `db:"created_at"`
+	ReplayedAt *time.Time        `db:"replayed_at"`
+}
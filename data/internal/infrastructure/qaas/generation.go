@@ -0,0 +1,49 @@
+package qaas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/larek-tech/diploma/data/internal/domain/source"
+)
+
+const (
+	// GenerationKey ключ метаданных задачи с поколением индекса источника, для которого задача создана
+	GenerationKey = "generation"
+	// SourceIDKey ключ метаданных задачи с uuid источника
+	SourceIDKey = "sourceID"
+	// UnknownGeneration поколение задач, поставленных в очередь до появления метки поколения,
+	// такие задачи записывают данные в текущее поколение источника
+	UnknownGeneration = -1
+)
+
+// Generation возвращает поколение источника из метаданных задачи
+func Generation(metadata map[string]any) int {
+	// числа в метаданных после json.Unmarshal приходят как float64
+	switch v := metadata[GenerationKey].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	default:
+		return UnknownGeneration
+	}
+}
+
+// IsStale проверяет, что задача создана для поколения источника, построение которого уже заменено
+// более новым обновлением, или для удаленного источника. Результаты таких задач отбрасываются,
+// чтобы не смешивать данные двух обходов и не повторять задачи удаленного источника.
+func IsStale(ctx context.Context, store generationStore, sourceID string, generation int) (bool, error) {
+	if sourceID == "" {
+		return false, nil
+	}
+	target, err := store.TargetGeneration(ctx, sourceID)
+	if errors.Is(err, source.ErrSourceNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get source generation: %w", err)
+	}
+	return generation != UnknownGeneration && target != generation, nil
+}
@@ -0,0 +1,54 @@
+package qaas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/larek-tech/diploma/data/internal/domain/source"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeGenerationStore int
+
+func (f fakeGenerationStore) TargetGeneration(context.Context, string) (int, error) {
+	return int(f), nil
+}
+
+type deletedSourceStore struct{}
+
+func (deletedSourceStore) TargetGeneration(context.Context, string) (int, error) {
+	return 0, source.ErrSourceNotFound
+}
+
+func TestGeneration(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 3, Generation(map[string]any{GenerationKey: float64(3)}))
+	assert.Equal(t, 2, Generation(map[string]any{GenerationKey: 2}))
+	assert.Equal(t, UnknownGeneration, Generation(map[string]any{}))
+	assert.Equal(t, UnknownGeneration, Generation(nil))
+}
+
+func TestIsStale(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := fakeGenerationStore(2)
+
+	stale, err := IsStale(ctx, store, "src", 2)
+	assert.NoError(t, err)
+	assert.False(t, stale)
+
+	stale, err = IsStale(ctx, store, "src", 1)
+	assert.NoError(t, err)
+	assert.True(t, stale)
+
+	stale, err = IsStale(ctx, store, "src", UnknownGeneration)
+	assert.NoError(t, err)
+	assert.False(t, stale)
+
+	// задачи удаленного источника отбрасываются без повторов
+	stale, err = IsStale(ctx, deletedSourceStore{}, "src", 2)
+	assert.NoError(t, err)
+	assert.True(t, stale)
+}
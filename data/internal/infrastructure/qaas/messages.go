@@ -38,6 +38,21 @@ type ParseStatusJob struct {
 	SiteID           string
 	ParsePageJobsIDs []string
 	Delay            time.Duration
+	Generation       *int // поколение источника, nil у задач, поставленных до появления метки поколения
+}
+
+// RefreshSourceJob запланированное обновление источника
+type RefreshSourceJob struct {
+	SourceID     string    // uuid ID источника
+	ScheduledFor time.Time // время, на которое было запланировано обновление
+}
+
+// ReembedJob пересчет векторов чанков и вопросов моделью эмбеддингов, задача публикует себя повторно,
+// пока не останется чанков и вопросов без векторов модели
+type ReembedJob struct {
+	ModelID  int    // идентификатор модели в реестре
+	SourceID string // uuid ID источника, пустой - весь корпус
+	CutOver  bool   // переключить поиск на модель после полного покрытия корпуса
 }
 
 type ResultMessage struct {
@@ -75,7 +75,7 @@ func (p *Publisher) Publish(ctx context.Context, rawMsg []any, opts ...PublishOp
 	msgs := make([]*pgq.MessageOutgoing, len(rawMsg))
 	for i := 0; i < len(rawMsg); i++ {
 		switch v := rawMsg[i].(type) {
-		case SiteJob, PageJob, EmbedJob, FileJob:
+		case SiteJob, PageJob, EmbedJob, FileJob, ParseS3Job:
 			payload, err := json.Marshal(rawMsg[i])
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal message: %w", err)
@@ -106,6 +106,32 @@ func (p *Publisher) Publish(ctx context.Context, rawMsg []any, opts ...PublishOp
 					"objType": reflect.TypeOf(v).Name(),
 				},
 			}
+		case ReembedJob:
+			payload, err := json.Marshal(rawMsg[i])
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal message: %w", err)
+			}
+			msgs[i] = &pgq.MessageOutgoing{
+				ScheduledFor: options.ScheduledFor,
+				Payload:      payload,
+				Metadata: pgq.Metadata{
+					"queue":   string(options.Queue),
+					"objType": reflect.TypeOf(v).Name(),
+				},
+			}
+		case RefreshSourceJob:
+			payload, err := json.Marshal(rawMsg[i])
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal message: %w", err)
+			}
+			msgs[i] = &pgq.MessageOutgoing{
+				ScheduledFor: &v.ScheduledFor,
+				Payload:      payload,
+				Metadata: pgq.Metadata{
+					"queue":   string(options.Queue),
+					"objType": reflect.TypeOf(v).Name(),
+				},
+			}
 		default:
 			return nil, fmt.Errorf("unsupported message type: %T", rawMsg[i])
 		}
@@ -125,6 +151,9 @@ func (p Publisher) CreateAllTables(queues []Queue) error {
 		if _, err := p.db.Exec(query); err != nil {
 			return fmt.Errorf("failed to create table for queue %s: %w", queue, err)
 		}
+		if _, err := p.db.Exec(deadLetterTableQuery(queue)); err != nil {
+			return fmt.Errorf("failed to create dead letter table for queue %s: %w", queue, err)
+		}
 	}
 	return nil
 }
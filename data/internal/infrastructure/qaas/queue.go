@@ -17,4 +17,8 @@ const (
 
 	ParseSiteStatusQueue Queue = "web_parse_site_status" // job for collecting parsing status
 	EmbedResultQueue     Queue = "document_embed_result"
+
+	RefreshSourceQueue Queue = "source_refresh" // job for scheduled source re-ingestion
+
+	ReembedQueue Queue = "embedding_reembed" // job for filling vectors of a new embedding model
 )
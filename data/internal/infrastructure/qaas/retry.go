@@ -0,0 +1,100 @@
+package qaas
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"go.dataddo.com/pgq"
+)
+
+const (
+	// AttemptKey номер попытки обработки задачи в метаданных сообщения, первая попытка - 1
+	AttemptKey = "attempt"
+	// LastErrorKey ошибка предыдущей попытки обработки в метаданных сообщения
+	LastErrorKey = "lastError"
+)
+
+// RetryPolicy ограничение повторной обработки задач, завершившихся ошибкой
+type RetryPolicy struct {
+	// MaxAttempts количество попыток, после которого задача переносится в dead letter таблицу очереди
+	MaxAttempts int
+	// BaseDelay задержка перед второй попыткой, каждая следующая задержка удваивается
+	BaseDelay time.Duration
+	// MaxDelay максимальная задержка между попытками
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy ограничения по умолчанию
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   10 * time.Second,
+		MaxDelay:    time.Hour,
+	}
+}
+
+// Backoff задержка перед следующей попыткой после неудачной попытки attempt
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= p.MaxDelay {
+			return p.MaxDelay
+		}
+	}
+	return min(delay, p.MaxDelay)
+}
+
+// errPermanent ошибка, которую не исправит повторная обработка
+type errPermanent struct {
+	err error
+}
+
+func (e errPermanent) Error() string {
+	return e.err.Error()
+}
+
+func (e errPermanent) Unwrap() error {
+	return e.err
+}
+
+// Permanent помечает ошибку как неисправимую, задача сразу переносится в dead letter таблицу без повторов
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return errPermanent{err: err}
+}
+
+// IsPermanent проверяет, помечена ли ошибка через Permanent
+func IsPermanent(err error) bool {
+	var permanent errPermanent
+	return errors.As(err, &permanent)
+}
+
+// Attempt возвращает номер текущей попытки обработки сообщения
+func Attempt(msg *pgq.MessageIncoming) int {
+	attempt, err := strconv.Atoi(msg.Metadata[AttemptKey])
+	if err != nil || attempt < 1 {
+		return 1
+	}
+	return attempt
+}
+
+type retryPolicyKey struct{}
+
+func withRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// LastAttempt проверяет, что ошибка обработки сообщения не будет повторена и задача попадет в dead letter таблицу.
+// Обработчики используют это, чтобы сообщать об ошибке источника только после исчерпания попыток.
+func LastAttempt(ctx context.Context, msg *pgq.MessageIncoming) bool {
+	policy, ok := ctx.Value(retryPolicyKey{}).(RetryPolicy)
+	if !ok {
+		return true
+	}
+	return Attempt(msg) >= policy.MaxAttempts
+}
@@ -0,0 +1,54 @@
+package qaas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.dataddo.com/pgq"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	t.Parallel()
+
+	p := RetryPolicy{MaxAttempts: 5, BaseDelay: 10 * time.Second, MaxDelay: time.Minute}
+	assert.Equal(t, 10*time.Second, p.Backoff(1))
+	assert.Equal(t, 20*time.Second, p.Backoff(2))
+	assert.Equal(t, 40*time.Second, p.Backoff(3))
+	assert.Equal(t, time.Minute, p.Backoff(4))
+	assert.Equal(t, time.Minute, p.Backoff(100))
+}
+
+func TestPermanent(t *testing.T) {
+	t.Parallel()
+
+	base := errors.New("bad payload")
+	err := fmt.Errorf("handle: %w", Permanent(base))
+	assert.True(t, IsPermanent(err))
+	assert.ErrorIs(t, err, base)
+	assert.False(t, IsPermanent(base))
+	assert.NoError(t, Permanent(nil))
+}
+
+func TestAttempt(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 1, Attempt(&pgq.MessageIncoming{}))
+	assert.Equal(t, 1, Attempt(&pgq.MessageIncoming{Metadata: pgq.Metadata{AttemptKey: "x"}}))
+	assert.Equal(t, 3, Attempt(&pgq.MessageIncoming{Metadata: pgq.Metadata{AttemptKey: "3"}}))
+}
+
+func TestLastAttempt(t *testing.T) {
+	t.Parallel()
+
+	policy := RetryPolicy{MaxAttempts: 3}
+	first := &pgq.MessageIncoming{Metadata: pgq.Metadata{}}
+	last := &pgq.MessageIncoming{Metadata: pgq.Metadata{AttemptKey: "3"}}
+
+	assert.False(t, LastAttempt(withRetryPolicy(context.Background(), policy), first))
+	assert.True(t, LastAttempt(withRetryPolicy(context.Background(), policy), last))
+	assert.True(t, LastAttempt(context.Background(), first))
+}
@@ -6,11 +6,11 @@ import (
 
 // отправляем в source_topic
 type DataMessage struct {
-	Title        string              `json:"title"`
-	Content      []byte              `json:"content"` // byte-строка с url или считанный файл
-	Type         source.Type         `json:"type"`
-	Credentials  []byte              `json:"credentials"`
-	UpdateParams source.UpdateParams `json:"update_params"`
+	Title        string               `json:"title"`
+	Content      []byte               `json:"content"` // byte-строка с url или считанный файл
+	Type         source.Type          `json:"type"`
+	Credentials  []byte               `json:"credentials"`
+	UpdateParams *source.UpdateParams `json:"update_params"`
 }
 
 // отправялем в source_topic
@@ -33,9 +33,34 @@ const (
 
 // отправялем в status_topic
 type ParsingStatus struct {
-	SourceID  string       `json:"source"` // uuid ID источника
-	Status    SourceStatus `json:"status"` //
-	JobID     string       // uuid ID процесса парсинга
-	Processed int          // количество элементов обработанных за текущий проход
-	Total     int          // количество элементов полученное при первом обходе ресурса
+	SourceID  string       `json:"source"`    // uuid ID источника
+	Status    SourceStatus `json:"status"`    //
+	JobID     string       `json:"job_id"`    // uuid ID процесса парсинга
+	Processed int          `json:"processed"` // количество элементов обработанных за текущий проход
+	Total     int          `json:"total"`     // количество элементов полученное при первом обходе ресурса
+}
+
+// DeleteMessage получаем из source_delete_topic
+type DeleteMessage struct {
+	SourceID string `json:"source"` // uuid ID источника
+}
+
+type DeletionStatus uint8
+
+const (
+	// DeletionUndefined undefined deletion status.
+	DeletionUndefined DeletionStatus = iota
+	// DeletionPending source deletion is requested.
+	DeletionPending
+	// DeletionDone source data is purged.
+	DeletionDone
+	// DeletionFailed source data purge failed.
+	DeletionFailed
+)
+
+// отправляем в source_deleted_topic
+type DeletionResult struct {
+	SourceID string         `json:"source"`          // uuid ID источника
+	Status   DeletionStatus `json:"status"`          //
+	Error    string         `json:"error,omitempty"` // причина ошибки удаления
 }
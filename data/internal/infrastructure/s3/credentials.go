@@ -10,7 +10,7 @@ type Credentials struct {
 func NewCredentials(endpoint, accessKey, secretAccessKey string, useSSL ...bool) Credentials {
 	ssl := false
 	if len(useSSL) > 0 {
-		ssl = true
+		ssl = useSSL[0]
 	}
 	return Credentials{
 		endpoint:        endpoint,
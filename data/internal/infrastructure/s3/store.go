@@ -3,7 +3,11 @@ package s3
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"strings"
+	"time"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -95,5 +99,71 @@ func (s Store) Download(ctx context.Context, bucketName, key string) (*Object, e
 	}, nil
 }
 
-// TODO: add listObjects method
+// Open returns a reader of the object content without loading it into memory.
+// The caller must close the reader. ErrObjectNotFound is returned if there is no such key.
+func (s Store) Open(ctx context.Context, bucketName, key string) (io.ReadCloser, ObjectInfo, error) {
+	obj, err := s.s3.GetObject(ctx, bucketName, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, ObjectInfo{}, fmt.Errorf("failed to get object: %w", err)
+	}
+	info, err := obj.Stat()
+	if err != nil {
+		obj.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ObjectInfo{}, errors.Join(ErrObjectNotFound, err)
+		}
+		return nil, ObjectInfo{}, fmt.Errorf("failed to stat object: %w", err)
+	}
+	return obj, ObjectInfo{
+		Key:          info.Key,
+		ETag:         info.ETag,
+		Size:         info.Size,
+		ContentType:  info.ContentType,
+		LastModified: info.LastModified,
+	}, nil
+}
+
+// Delete removes an object from S3.
+func (s Store) Delete(ctx context.Context, bucketName, key string) error {
+	err := s.s3.RemoveObject(ctx, bucketName, key, minio.RemoveObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to remove object: %w", err)
+	}
+	return nil
+}
+
+// ObjectInfo describes an object returned by List.
+type ObjectInfo struct {
+	Key          string
+	ETag         string
+	Size         int64
+	ContentType  string
+	LastModified time.Time
+}
+
+// List returns all objects in the bucket under the prefix, including nested ones.
+func (s Store) List(ctx context.Context, bucketName, prefix string) ([]ObjectInfo, error) {
+	res := make([]ObjectInfo, 0)
+	for obj := range s.s3.ListObjects(ctx, bucketName, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	}) {
+		if obj.Err != nil {
+			return nil, fmt.Errorf("failed to list objects: %w", obj.Err)
+		}
+		// пропускаем "директории"
+		if strings.HasSuffix(obj.Key, "/") {
+			continue
+		}
+		res = append(res, ObjectInfo{
+			Key:          obj.Key,
+			ETag:         obj.ETag,
+			Size:         obj.Size,
+			ContentType:  obj.ContentType,
+			LastModified: obj.LastModified,
+		})
+	}
+	return res, nil
+}
+
 // TODO: add upload multiple method
@@ -0,0 +1,73 @@
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// prefix помечает зашифрованные значения, значения без него считаются сохраненными до шифрования
+const prefix = "enc:v1:"
+
+// ErrInvalidKey ключ шифрования не является 32 байтами в base64
+var ErrInvalidKey = errors.New("encryption key must be 32 bytes encoded in base64")
+
+// Cipher шифрует секреты, сохраняемые в базе, алгоритмом AES-256-GCM
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// New создает Cipher из ключа в base64
+func New(key string) (*Cipher, error) {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || len(raw) != 32 {
+		return nil, ErrInvalidKey
+	}
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gcm: %w", err)
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+// Encrypt шифрует значение, пустое значение не шифруется
+func (c Cipher) Encrypt(value string) (string, error) {
+	if value == "" || strings.HasPrefix(value, prefix) {
+		return value, nil
+	}
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(value), nil)
+	return prefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt расшифровывает значение, значения без метки шифрования возвращаются как есть
+func (c Cipher) Decrypt(value string) (string, error) {
+	encoded, ok := strings.CutPrefix(value, prefix)
+	if !ok {
+		return value, nil
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode secret: %w", err)
+	}
+	size := c.aead.NonceSize()
+	if len(sealed) < size {
+		return "", errors.New("failed to decrypt secret: value too short")
+	}
+	plain, err := c.aead.Open(nil, sealed[:size], sealed[size:], nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt secret: %w", err)
+	}
+	return string(plain), nil
+}
@@ -0,0 +1,46 @@
+package secret
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newKey(b byte) string {
+	return base64.StdEncoding.EncodeToString([]byte(strings.Repeat(string(b), 32)))
+}
+
+func TestCipher(t *testing.T) {
+	t.Parallel()
+
+	c, err := New(newKey('a'))
+	assert.NoError(t, err)
+
+	encrypted, err := c.Encrypt("minio-secret")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(encrypted, prefix))
+	assert.NotContains(t, encrypted, "minio-secret")
+
+	again, err := c.Encrypt(encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, encrypted, again)
+
+	decrypted, err := c.Decrypt(encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, "minio-secret", decrypted)
+
+	// значения, сохраненные до шифрования, читаются как есть
+	plain, err := c.Decrypt("legacy")
+	assert.NoError(t, err)
+	assert.Equal(t, "legacy", plain)
+
+	other, err := New(newKey('b'))
+	assert.NoError(t, err)
+	_, err = other.Decrypt(encrypted)
+	assert.Error(t, err)
+
+	_, err = New("short")
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
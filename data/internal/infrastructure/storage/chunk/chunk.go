@@ -6,7 +6,10 @@ import (
 	"fmt"
 	"unicode/utf8"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/larek-tech/diploma/data/internal/domain/document"
+	"github.com/larek-tech/diploma/data/internal/domain/embedding"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/storage/embedding_model"
 )
 
 type Storage struct {
@@ -58,9 +61,9 @@ func (s Storage) Update(ctx context.Context, documentID string, chunks []*docume
 
 			if err := s.db.Exec(
 				txCtx,
-				`INSERT INTO chunks (id, index, source_id, document_id, content, embeddings)
-     VALUES ($1, $2, $3, $4, $5, $6)`,
-				chunk.ID, chunk.Index, chunk.SourceID, documentID, sanitizeUTF8(chunk.Content), prepareVector(chunk.Embeddings),
+				`INSERT INTO chunks (id, index, source_id, document_id, content, metadata, embedding_model)
+     VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+				chunk.ID, chunk.Index, chunk.SourceID, documentID, sanitizeUTF8(chunk.Content), chunk.Metadata, chunk.EmbeddingModel,
 			); err != nil {
 				return fmt.Errorf("failed to insert chunk: %w", err)
 			}
@@ -73,15 +76,23 @@ func (s Storage) Delete(ctx context.Context, documentID string) error {
 	return s.db.Exec(ctx, "DELETE FROM chunks WHERE document_id = $1", documentID)
 }
 
-func (s Storage) Search(ctx context.Context, query []float32, sourceIDs []string, threshold float32, limit int, useQuestions bool) ([]*document.SearchResult, error) {
+// Search ищет чанки по векторам модели model, запрос должен быть получен той же моделью.
+func (s Storage) Search(ctx context.Context, model *embedding.Model, query []float32, sourceIDs []string, filter *document.SearchFilter, threshold float32, limit int, useQuestions bool) ([]*document.SearchResult, error) {
 	if len(query) == 0 {
 		return nil, fmt.Errorf("query is empty")
 	}
 	if len(sourceIDs) == 0 {
 		return nil, fmt.Errorf("sourceIDs is empty")
 	}
-	var sql string
+	if model == nil {
+		return nil, fmt.Errorf("embedding model is empty")
+	}
+	var (
+		sql   string
+		table string
+	)
 	if useQuestions {
+		table = pgx.Identifier{embedding_model.Schema, model.QuestionTable()}.Sanitize()
 		sql = `
 SELECT
 	c.id,
@@ -89,19 +100,25 @@ SELECT
 	c.source_id,
 	c.document_id,
 	c.content,
-	1 - (q.embeddings <=> $1) AS cosine_similarity,
+	1 - (e.embeddings <=> $1) AS cosine_similarity,
 	d.name as document_name,
+	d.url as document_url,
 	d.metadata
 FROM chunks c
 JOIN
 	chunk_questions q on c.id = q.chunk_id
+JOIN
+	%s e on e.question_id = q.id
 JOIN
 	documents d on c.document_id = d.id
-WHERE c.source_id = ANY($2) AND 1 - (q.embeddings <=> $1) > $3
-ORDER BY  1 - (q.embeddings <=> $1) desc
+JOIN
+	sources s on s.id = d.source_id AND d.generation = s.generation
+WHERE c.source_id = ANY($2) AND 1 - (e.embeddings <=> $1) > $3%s
+ORDER BY 1 - (e.embeddings <=> $1) desc
 LIMIT $4;
 `
 	} else {
+		table = pgx.Identifier{embedding_model.Schema, model.ChunkTable()}.Sanitize()
 		sql = `
 SELECT
 	c.id,
@@ -109,20 +126,27 @@ SELECT
 	c.source_id,
 	c.document_id,
 	c.content,
-	1 - (c.embeddings <=> $1) AS cosine_similarity,
+	1 - (e.embeddings <=> $1) AS cosine_similarity,
 	d.name as document_name,
+	d.url as document_url,
 	d.metadata
 FROM chunks c
+JOIN
+	%s e on e.chunk_id = c.id
 JOIN
 	documents d on c.document_id = d.id
-WHERE c.source_id = ANY($2) AND 1 - (c.embeddings <=> $1) > $3
-ORDER BY 1 - (c.embeddings <=> $1) desc
+JOIN
+	sources s on s.id = d.source_id AND d.generation = s.generation
+WHERE c.source_id = ANY($2) AND 1 - (e.embeddings <=> $1) > $3%s
+ORDER BY 1 - (e.embeddings <=> $1) desc
 LIMIT $4;
 `
 	}
 
+	// фильтры применяются в том же запросе, чтобы не сокращать выдачу после LIMIT
+	conditions, args := filterConditions(filter, []any{prepareVector(query), sourceIDs, threshold, limit})
 	var res []*document.SearchResult
-	err := s.db.QueryStructs(ctx, &res, sql, prepareVector(query), sourceIDs, threshold, limit)
+	err := s.queryFiltered(ctx, filter, &res, fmt.Sprintf(sql, table, conditions), args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query chunks: %w", err)
 	}
@@ -140,3 +164,146 @@ LIMIT $4;
 	//}
 	return res, nil
 }
+
+// LexicalSearch ищет чанки по полнотекстовому индексу, ранжируя по ts_rank_cd с нормализацией по длине.
+// Слова запроса объединяются через ИЛИ, чтобы длинные вопросы находили чанки с частью терминов.
+// Косинусная близость к queryEmbedding по векторам модели model вычисляется для найденных чанков, порог к ней не применяется.
+func (s Storage) LexicalSearch(ctx context.Context, model *embedding.Model, query string, queryEmbedding []float32, sourceIDs []string, filter *document.SearchFilter, limit int) ([]*document.SearchResult, error) {
+	if query == "" {
+		return nil, fmt.Errorf("query is empty")
+	}
+	if model == nil {
+		return nil, fmt.Errorf("embedding model is empty")
+	}
+	if len(sourceIDs) == 0 {
+		return nil, fmt.Errorf("sourceIDs is empty")
+	}
+	conditions, args := filterConditions(filter, []any{prepareVector(queryEmbedding), query, sourceIDs, limit})
+	var res []*document.SearchResult
+	err := s.queryFiltered(ctx, filter, &res, fmt.Sprintf(`
+WITH q AS (
+	SELECT
+		replace(plainto_tsquery('russian', $2)::text, '&', '|')::tsquery ||
+		replace(plainto_tsquery('english', $2)::text, '&', '|')::tsquery AS query
+)
+SELECT
+	c.id,
+	c.index,
+	c.source_id,
+	c.document_id,
+	c.content,
+	COALESCE(1 - (e.embeddings <=> $1), 0) AS cosine_similarity,
+	d.name as document_name,
+	d.url as document_url,
+	d.metadata
+FROM chunks c
+CROSS JOIN q
+LEFT JOIN
+	%s e on e.chunk_id = c.id
+JOIN
+	documents d on c.document_id = d.id
+JOIN
+	sources s on s.id = d.source_id AND d.generation = s.generation
+WHERE c.source_id = ANY($3) AND c.content_tsv @@ q.query%s
+ORDER BY ts_rank_cd(c.content_tsv, q.query, 1) desc
+LIMIT $4;
+`, pgx.Identifier{embedding_model.Schema, model.ChunkTable()}.Sanitize(), conditions), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query chunks by text: %w", err)
+	}
+	return res, nil
+}
+
+// GetByIDs возвращает чанки активного поколения источников sourceIDs, отсутствующие идентификаторы пропускаются
+func (s Storage) GetByIDs(ctx context.Context, ids, sourceIDs []string) ([]*document.Chunk, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var res []*document.Chunk
+	err := s.db.QueryStructs(ctx, &res, `
+SELECT
+	c.id,
+	c.index,
+	c.source_id,
+	c.document_id,
+	c.content,
+	c.metadata
+FROM chunks c
+JOIN
+	documents d on c.document_id = d.id
+JOIN
+	sources s on s.id = d.source_id AND d.generation = s.generation
+WHERE c.id = ANY($1::uuid[]) AND c.source_id = ANY($2::uuid[]);
+`, ids, sourceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query chunks: %w", err)
+	}
+	return res, nil
+}
+
+// Neighbors возвращает чанки из диапазонов индексов ranges, упорядоченные по документу и индексу
+func (s Storage) Neighbors(ctx context.Context, ranges []document.ChunkRange) ([]*document.Chunk, error) {
+	if len(ranges) == 0 {
+		return nil, nil
+	}
+	documentIDs := make([]string, 0, len(ranges))
+	from := make([]int, 0, len(ranges))
+	to := make([]int, 0, len(ranges))
+	for _, r := range ranges {
+		documentIDs = append(documentIDs, r.DocumentID)
+		from = append(from, r.From)
+		to = append(to, r.To)
+	}
+	var res []*document.Chunk
+	err := s.db.QueryStructs(ctx, &res, `
+SELECT
+	c.id,
+	c.index,
+	c.source_id,
+	c.document_id,
+	c.content,
+	c.metadata
+FROM chunks c
+JOIN
+	unnest($1::uuid[], $2::int[], $3::int[]) AS r(document_id, index_from, index_to)
+	ON c.document_id = r.document_id AND c.index BETWEEN r.index_from AND r.index_to
+ORDER BY c.document_id, c.index;
+`, documentIDs, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query neighbor chunks: %w", err)
+	}
+	return res, nil
+}
+
+type vectorRow struct {
+	ChunkID    string `db:"chunk_id"`
+	Embeddings string `db:"embeddings"`
+}
+
+// Vectors возвращает векторы чанков моделью model, чанки без векторов модели пропускаются
+func (s Storage) Vectors(ctx context.Context, model *embedding.Model, chunkIDs []string) (map[string][]float32, error) {
+	if model == nil {
+		return nil, fmt.Errorf("embedding model is empty")
+	}
+	if len(chunkIDs) == 0 {
+		return nil, nil
+	}
+	var rows []vectorRow
+	err := s.db.QueryStructs(ctx, &rows, fmt.Sprintf(
+		"SELECT chunk_id, embeddings::text AS embeddings FROM %s WHERE chunk_id = ANY($1)",
+		pgx.Identifier{embedding_model.Schema, model.ChunkTable()}.Sanitize(),
+	), chunkIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query chunk vectors: %w", err)
+	}
+	res := make(map[string][]float32, len(rows))
+	for _, row := range rows {
+		var v []float32
+		// текстовое представление pgvector совпадает с json массивом
+		if err = json.Unmarshal([]byte(row.Embeddings), &v); err != nil {
+			return nil, fmt.Errorf("failed to decode chunk vector: %w", err)
+		}
+		res[row.ChunkID] = v
+	}
+	return res, nil
+}
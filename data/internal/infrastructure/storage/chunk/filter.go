@@ -0,0 +1,103 @@
+package chunk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+	postgres "github.com/larek-tech/diploma/data/internal/infrastructure/storage"
+)
+
+// filteredSearchTimeout ограничение времени поиска с фильтром: jsonpath предикаты не используют индексы
+// и на больших источниках могут выполняться долго
+const filteredSearchTimeout = 5 * time.Second
+
+// ValidateFilter проверяет синтаксис jsonpath предикатов фильтра до выполнения поиска,
+// некорректный предикат возвращает document.ErrInvalidFilter.
+func (s Storage) ValidateFilter(ctx context.Context, filter *document.SearchFilter) error {
+	for _, predicate := range filter.Predicates() {
+		var valid bool
+		err := s.db.QueryStruct(ctx, &valid, `SELECT $1::jsonpath IS NOT NULL;`, predicate)
+		if postgres.IsInvalidInputError(err) {
+			return fmt.Errorf("%w: invalid jsonpath %q: %w", document.ErrInvalidFilter, predicate, err)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to validate jsonpath: %w", err)
+		}
+	}
+	return nil
+}
+
+// queryFiltered выполняет поисковый запрос, для запросов с фильтром ограничивает время выполнения.
+func (s Storage) queryFiltered(ctx context.Context, filter *document.SearchFilter, dst any, sql string, args ...any) error {
+	if filter.IsEmpty() {
+		return s.db.QueryStructs(ctx, dst, sql, args...)
+	}
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		// SET LOCAL действует до конца транзакции
+		err := s.db.Exec(ctx, fmt.Sprintf("SET LOCAL statement_timeout = %d;", filteredSearchTimeout.Milliseconds()))
+		if err != nil {
+			return err
+		}
+		return s.db.QueryStructs(ctx, dst, sql, args...)
+	})
+	if postgres.IsQueryCanceledError(err) && ctx.Err() == nil {
+		return fmt.Errorf("%w: %w", document.ErrSearchTimeout, err)
+	}
+	return err
+}
+
+// filterConditions возвращает дополнительные условия WHERE для фильтра поиска
+// и аргументы запроса, номера параметров продолжают нумерацию args.
+// В запросе документ доступен как d, чанк как c.
+func filterConditions(filter *document.SearchFilter, args []any) (string, []any) {
+	if filter.IsEmpty() {
+		return "", args
+	}
+	var sb strings.Builder
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		sb.WriteString("\n\tAND ")
+		sb.WriteString(fmt.Sprintf(condition, len(args)))
+	}
+
+	if len(filter.Types) > 0 {
+		types := make([]string, 0, len(filter.Types))
+		for _, t := range filter.Types {
+			types = append(types, string(t))
+		}
+		add("d.object_type = ANY($%d)", types)
+	}
+	if len(filter.Extensions) > 0 {
+		extensions := make([]string, 0, len(filter.Extensions))
+		for _, ext := range filter.Extensions {
+			extensions = append(extensions, document.NormalizeExtension(ext))
+		}
+		add("d.extension = ANY($%d)", extensions)
+	}
+	if len(filter.URLPrefixes) > 0 {
+		add("EXISTS (SELECT 1 FROM unnest($%d::text[]) AS p(prefix) WHERE starts_with(d.url, p.prefix))", filter.URLPrefixes)
+	}
+	if filter.DateFrom != nil {
+		add("d.document_date >= $%d", *filter.DateFrom)
+	}
+	if filter.DateTo != nil {
+		add("d.document_date <= $%d", *filter.DateTo)
+	}
+	if len(filter.Languages) > 0 {
+		languages := make([]string, 0, len(filter.Languages))
+		for _, lang := range filter.Languages {
+			languages = append(languages, strings.ToLower(lang))
+		}
+		add("d.language = ANY($%d)", languages)
+	}
+	for _, predicate := range filter.DocumentPredicates {
+		add("d.metadata @@ $%d::jsonpath", predicate)
+	}
+	for _, predicate := range filter.ChunkPredicates {
+		add("c.metadata @@ $%d::jsonpath", predicate)
+	}
+	return sb.String(), args
+}
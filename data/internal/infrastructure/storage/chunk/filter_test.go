@@ -0,0 +1,40 @@
+package chunk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterConditions(t *testing.T) {
+	conditions, args := filterConditions(nil, []any{"q"})
+	assert.Empty(t, conditions)
+	assert.Equal(t, []any{"q"}, args)
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	conditions, args = filterConditions(&document.SearchFilter{
+		Types:           []document.Type{document.TypeFile},
+		Extensions:      []string{"PDF", ".docx"},
+		DateFrom:        &from,
+		Languages:       []string{"RU"},
+		ChunkPredicates: []string{`$.page > 2`},
+	}, []any{"q", "sources"})
+
+	assert.Equal(t, `
+	AND d.object_type = ANY($3)
+	AND d.extension = ANY($4)
+	AND d.document_date >= $5
+	AND d.language = ANY($6)
+	AND c.metadata @@ $7::jsonpath`, conditions)
+	assert.Equal(t, []any{
+		"q",
+		"sources",
+		[]string{"file"},
+		[]string{".pdf", ".docx"},
+		from,
+		[]string{"ru"},
+		`$.page > 2`,
+	}, args)
+}
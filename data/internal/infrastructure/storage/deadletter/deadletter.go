@@ -0,0 +1,97 @@
+package deadletter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
+	storage "github.com/larek-tech/diploma/data/internal/infrastructure/storage"
+)
+
+const maxPageSize = 50
+
+type Storage struct {
+	db db
+}
+
+func New(db db) *Storage {
+	return &Storage{
+		db: db,
+	}
+}
+
+// List возвращает общее количество задач в dead letter таблице очереди и страницу задач, начиная с последних
+func (s Storage) List(ctx context.Context, queue qaas.Queue, page, size int) (int, []*qaas.DeadLetter, error) {
+	if size <= 0 || size > maxPageSize {
+		size = maxPageSize
+	}
+	if page < 1 {
+		page = 1
+	}
+	offset := (page - 1) * size
+
+	var jobs []*qaas.DeadLetter
+	err := s.db.QueryStructs(ctx, &jobs, `
+SELECT id, payload, metadata, error, attempts, created_at, replayed_at
+FROM `+qaas.DeadLetterTable(queue)+`
+ORDER BY created_at DESC
+LIMIT $1 OFFSET $2;
+`, size, offset)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to query dead letters: %w", err)
+	}
+	for _, job := range jobs {
+		job.Queue = queue
+	}
+
+	var total int
+	err = s.db.QueryStruct(ctx, &total, `
+SELECT COUNT(*) FROM `+qaas.DeadLetterTable(queue)+`;
+`)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to count dead letters: %w", err)
+	}
+	return total, jobs, nil
+}
+
+// Get возвращает задачу из dead letter таблицы очереди или nil, если задачи нет
+func (s Storage) Get(ctx context.Context, queue qaas.Queue, id string) (*qaas.DeadLetter, error) {
+	var job qaas.DeadLetter
+	err := s.db.QueryStruct(ctx, &job, `
+SELECT id, payload, metadata, error, attempts, created_at, replayed_at
+FROM `+qaas.DeadLetterTable(queue)+`
+WHERE id = $1;
+`, id)
+	if err != nil {
+		if storage.IsNoRowsError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	job.Queue = queue
+	return &job, nil
+}
+
+// Replay возвращает задачи в очередь со сброшенным счетчиком попыток и возвращает количество переотправленных задач.
+// Уже переотправленные задачи пропускаются.
+func (s Storage) Replay(ctx context.Context, queue qaas.Queue, ids []string) (int, error) {
+	var count int
+	err := s.db.QueryStruct(ctx, &count, `
+WITH replayed AS (
+	UPDATE `+qaas.DeadLetterTable(queue)+`
+	SET replayed_at = NOW()
+	WHERE id = ANY($1::uuid[]) AND replayed_at IS NULL
+	RETURNING payload, metadata
+), inserted AS (
+	INSERT INTO `+string(queue)+` (payload, metadata)
+	SELECT payload, metadata - '`+qaas.AttemptKey+`' - '`+qaas.LastErrorKey+`'
+	FROM replayed
+	RETURNING id
+)
+SELECT COUNT(*) FROM inserted;
+`, ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to replay dead letters: %w", err)
+	}
+	return count, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/larek-tech/diploma/data/internal/domain/document"
@@ -35,9 +36,9 @@ WHERE id = $1;
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			sql = `
-INSERT INTO documents (id, object_id, object_type, source_id, name, content, metadata, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);`
-			err = s.db.Exec(ctx, sql, doc.ID, doc.ObjectID, doc.ObjectType, doc.SourceID, doc.Name, doc.Content, doc.Metadata, doc.CreatedAt, doc.UpdatedAt)
+INSERT INTO documents (id, object_id, object_type, source_id, name, content, metadata, created_at, updated_at, extension, url, language, document_date, generation)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, source_target_generation($4));`
+			err = s.db.Exec(ctx, sql, doc.ID, doc.ObjectID, doc.ObjectType, doc.SourceID, doc.Name, doc.Content, doc.Metadata, doc.CreatedAt, doc.UpdatedAt, doc.Extension, doc.URL, doc.Language, documentDate(doc))
 			if err != nil {
 				return fmt.Errorf("failed to create document: %w", err)
 			}
@@ -55,15 +56,64 @@ SET
     name = $4,
     content = $5,
     metadata = $6,
-    updated_at = $7
+    updated_at = $7,
+    extension = $9,
+    url = $10,
+    language = $11,
+    document_date = $12
 WHERE id = $8`
-	err = s.db.Exec(ctx, sql, doc.SourceID, doc.ObjectID, doc.ObjectType, doc.Name, doc.Content, doc.Metadata, doc.UpdatedAt, doc.ID)
+	err = s.db.Exec(ctx, sql, doc.SourceID, doc.ObjectID, doc.ObjectType, doc.Name, doc.Content, doc.Metadata, doc.UpdatedAt, doc.ID, doc.Extension, doc.URL, doc.Language, documentDate(doc))
 	if err != nil {
 		return fmt.Errorf("failed to update document: %w", err)
 	}
 	return nil
 }
 
+// documentDate возвращает дату документа, если она не определена при обработке - время обновления
+func documentDate(doc *document.Document) time.Time {
+	if !doc.Date.IsZero() {
+		return doc.Date
+	}
+	if !doc.UpdatedAt.IsZero() {
+		return doc.UpdatedAt
+	}
+	return time.Now()
+}
+
+// DeleteByObjectID удаляет документы объекта (страницы или файла), чанки удаляются каскадно.
+// Затрагивается только поколение, в которое сейчас записываются данные источника,
+// документы активного поколения остаются доступными для поиска до переключения.
+func (s Storage) DeleteByObjectID(ctx context.Context, objectID string) error {
+	err := s.db.Exec(ctx, `
+DELETE FROM documents
+WHERE object_id = $1 AND generation = source_target_generation(source_id);
+`, objectID)
+	if err != nil {
+		return fmt.Errorf("failed to delete documents: %w", err)
+	}
+	return nil
+}
+
+// GetByID возвращает документ активного поколения одного из источников sourceIDs, ErrDocumentNotFound - если его нет
+func (s Storage) GetByID(ctx context.Context, id string, sourceIDs []string) (*document.Document, error) {
+	var doc document.Document
+	err := s.db.QueryStruct(ctx, &doc, `
+SELECT
+	d.id, d.source_id, d.object_id, d.object_type, d.name, d.content, d.metadata, d.created_at, d.updated_at,
+	d.extension, d.url, d.language, d.document_date
+FROM documents d
+JOIN sources s ON s.id = d.source_id AND d.generation = s.generation
+WHERE d.id = $1 AND d.source_id = ANY($2);
+`, id, sourceIDs)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, document.ErrDocumentNotFound
+		}
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+	return &doc, nil
+}
+
 func (s Storage) GetMany(ctx context.Context, sourceID string, page, size int) (int, []*document.Document, error) {
 	// enforce maximum page size of 50
 	if size > 50 {
@@ -77,10 +127,11 @@ func (s Storage) GetMany(ctx context.Context, sourceID string, page, size int) (
 
 	sqlQuery := `
 SELECT
-    id, source_id, object_id, object_type, name, content, metadata, created_at, updated_at
-FROM documents
-WHERE source_id = $1
-ORDER BY created_at DESC
+    d.id, d.source_id, d.object_id, d.object_type, d.name, d.content, d.metadata, d.created_at, d.updated_at
+FROM documents d
+JOIN sources s ON s.id = d.source_id AND d.generation = s.generation
+WHERE d.source_id = $1
+ORDER BY d.created_at DESC
 LIMIT $2 OFFSET $3;
 `
 	var docs []*document.Document
@@ -92,8 +143,9 @@ LIMIT $2 OFFSET $3;
 	sqlQuery = `
 SELECT
 	COUNT(*)
-FROM documents
-WHERE source_id = $1;
+FROM documents d
+JOIN sources s ON s.id = d.source_id AND d.generation = s.generation
+WHERE d.source_id = $1;
 	`
 	err = s.db.QueryStruct(ctx, &total, sqlQuery, sourceID)
 	if err != nil {
@@ -0,0 +1,66 @@
+package embedding
+
+import (
+	"context"
+	"fmt"
+)
+
+// Cache хранилище эмбеддингов по модели с размерностью и хэшу текста
+type Cache struct {
+	db        db
+	trManager trManager
+}
+
+func New(db db, trManager trManager) *Cache {
+	return &Cache{
+		db:        db,
+		trManager: trManager,
+	}
+}
+
+type cached struct {
+	Hash       string    `db:"content_hash"`
+	Embeddings []float32 `db:"embeddings"`
+}
+
+// Get возвращает сохраненные эмбеддинги по хэшам, отсутствующие в кэше хэши пропускаются.
+func (c Cache) Get(ctx context.Context, model string, hashes []string) (map[string][]float32, error) {
+	res := make(map[string][]float32, len(hashes))
+	if len(hashes) == 0 {
+		return res, nil
+	}
+	var rows []cached
+	err := c.db.QueryStructs(ctx, &rows, `
+SELECT
+	content_hash,
+	embeddings
+FROM embedding_cache
+WHERE model = $1 AND content_hash = ANY($2);
+`, model, hashes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cached embeddings: %w", err)
+	}
+	for _, row := range rows {
+		res[row.Hash] = row.Embeddings
+	}
+	return res, nil
+}
+
+func (c Cache) Save(ctx context.Context, model string, embeddings map[string][]float32) error {
+	if len(embeddings) == 0 {
+		return nil
+	}
+	return c.trManager.Do(ctx, func(txCtx context.Context) error {
+		for hash, e := range embeddings {
+			err := c.db.Exec(txCtx, `
+INSERT INTO embedding_cache (model, content_hash, embeddings)
+VALUES ($1, $2, $3)
+ON CONFLICT (model, content_hash) DO NOTHING;
+`, model, hash, e)
+			if err != nil {
+				return fmt.Errorf("failed to save cached embedding: %w", err)
+			}
+		}
+		return nil
+	})
+}
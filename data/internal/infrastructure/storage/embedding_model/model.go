@@ -0,0 +1,276 @@
+package embedding_model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/larek-tech/diploma/data/internal/domain/embedding"
+	storage "github.com/larek-tech/diploma/data/internal/infrastructure/storage"
+)
+
+// Schema схема postgres с таблицами векторов моделей
+const Schema = "embeddings"
+
+const modelColumns = `id, name, version, dimensions, index_type, active, created_at, activated_at`
+
+type Storage struct {
+	db        db
+	trManager trManager
+}
+
+func New(db db, trManager trManager) *Storage {
+	return &Storage{
+		db:        db,
+		trManager: trManager,
+	}
+}
+
+func prepareVector(embeddings []float32) string {
+	if len(embeddings) == 0 {
+		return "[]"
+	}
+	embeddingsBytes, _ := json.Marshal(embeddings)
+
+	return string(embeddingsBytes)
+}
+
+func chunkTable(model *embedding.Model) string {
+	return pgx.Identifier{Schema, model.ChunkTable()}.Sanitize()
+}
+
+func questionTable(model *embedding.Model) string {
+	return pgx.Identifier{Schema, model.QuestionTable()}.Sanitize()
+}
+
+// Register добавляет модель в реестр и создает таблицы ее векторов. Зарегистрированная ранее модель
+// возвращается из реестра, при другом типе индекса индексы таблиц перестраиваются.
+// Первая зарегистрированная модель сразу становится активной.
+func (s Storage) Register(ctx context.Context, model *embedding.Model) (*embedding.Model, error) {
+	if model.Dimensions > embedding.MaxIndexDimensions {
+		return nil, fmt.Errorf("%w: %d > %d", embedding.ErrIndexDimensionLimit, model.Dimensions, embedding.MaxIndexDimensions)
+	}
+	var res embedding.Model
+	err := s.trManager.Do(ctx, func(txCtx context.Context) error {
+		err := s.db.QueryStruct(txCtx, &res, `
+INSERT INTO embedding_models (name, version, dimensions, index_type, active, activated_at)
+SELECT $1, $2, $3, $4, NOT a.has_active, CASE WHEN a.has_active THEN NULL ELSE now() END
+FROM (SELECT EXISTS (SELECT 1 FROM embedding_models WHERE active) AS has_active) a
+ON CONFLICT (name, version) DO NOTHING
+RETURNING `+modelColumns+`;
+`, model.Name, model.Version, model.Dimensions, model.IndexType)
+		if err == nil {
+			return s.createTables(txCtx, &res)
+		}
+		if !storage.IsNoRowsError(err) {
+			return fmt.Errorf("failed to insert embedding model: %w", err)
+		}
+
+		err = s.db.QueryStruct(txCtx, &res, `
+SELECT `+modelColumns+`
+FROM embedding_models
+WHERE name = $1 AND version = $2
+FOR UPDATE;
+`, model.Name, model.Version)
+		if err != nil {
+			return fmt.Errorf("failed to get embedding model: %w", err)
+		}
+		if res.Dimensions != model.Dimensions {
+			return fmt.Errorf("%w: %s has %d dimensions, got %d, change the model version",
+				embedding.ErrDimensionsMismatch, res.Key(), res.Dimensions, model.Dimensions)
+		}
+		if res.IndexType == model.IndexType {
+			return nil
+		}
+		slog.Info("rebuilding embedding model index", "model", res.Key(), "from", res.IndexType, "to", model.IndexType)
+		res.IndexType = model.IndexType
+		if err = s.db.Exec(txCtx, `UPDATE embedding_models SET index_type = $2 WHERE id = $1;`, res.ID, res.IndexType); err != nil {
+			return fmt.Errorf("failed to update embedding model index: %w", err)
+		}
+		return s.createIndexes(txCtx, &res)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// createTables создает таблицы векторов чанков и вопросов размерности модели
+func (s Storage) createTables(ctx context.Context, model *embedding.Model) error {
+	err := s.db.Exec(ctx, fmt.Sprintf(`
+CREATE TABLE IF NOT EXISTS %s (
+	chunk_id UUID PRIMARY KEY REFERENCES chunks(id) ON DELETE CASCADE,
+	embeddings VECTOR(%d) NOT NULL
+);`, chunkTable(model), model.Dimensions))
+	if err != nil {
+		return fmt.Errorf("failed to create chunk embeddings table: %w", err)
+	}
+	err = s.db.Exec(ctx, fmt.Sprintf(`
+CREATE TABLE IF NOT EXISTS %s (
+	question_id UUID PRIMARY KEY REFERENCES chunk_questions(id) ON DELETE CASCADE,
+	embeddings VECTOR(%d) NOT NULL
+);`, questionTable(model), model.Dimensions))
+	if err != nil {
+		return fmt.Errorf("failed to create question embeddings table: %w", err)
+	}
+	return s.createIndexes(ctx, model)
+}
+
+// createIndexes пересоздает векторные индексы таблиц модели с ее типом индекса
+func (s Storage) createIndexes(ctx context.Context, model *embedding.Model) error {
+	for _, table := range []string{model.ChunkTable(), model.QuestionTable()} {
+		index := pgx.Identifier{table + "_embeddings_idx"}.Sanitize()
+		if err := s.db.Exec(ctx, fmt.Sprintf(`DROP INDEX IF EXISTS %s.%s;`, pgx.Identifier{Schema}.Sanitize(), index)); err != nil {
+			return fmt.Errorf("failed to drop embeddings index: %w", err)
+		}
+		err := s.db.Exec(ctx, fmt.Sprintf(
+			`CREATE INDEX %s ON %s USING %s (embeddings vector_cosine_ops);`,
+			index, pgx.Identifier{Schema, table}.Sanitize(), model.IndexType,
+		))
+		if err != nil {
+			return fmt.Errorf("failed to create embeddings index: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetActive возвращает модель, по векторам которой выполняется поиск
+func (s Storage) GetActive(ctx context.Context) (*embedding.Model, error) {
+	var res embedding.Model
+	err := s.db.QueryStruct(ctx, &res, `SELECT `+modelColumns+` FROM embedding_models WHERE active;`)
+	if err != nil {
+		if storage.IsNoRowsError(err) {
+			return nil, embedding.ErrNoActiveModel
+		}
+		return nil, fmt.Errorf("failed to get active embedding model: %w", err)
+	}
+	return &res, nil
+}
+
+func (s Storage) GetByID(ctx context.Context, id int) (*embedding.Model, error) {
+	var res embedding.Model
+	err := s.db.QueryStruct(ctx, &res, `SELECT `+modelColumns+` FROM embedding_models WHERE id = $1;`, id)
+	if err != nil {
+		if storage.IsNoRowsError(err) {
+			return nil, embedding.ErrModelNotFound
+		}
+		return nil, fmt.Errorf("failed to get embedding model: %w", err)
+	}
+	return &res, nil
+}
+
+func (s Storage) List(ctx context.Context) ([]*embedding.Model, error) {
+	var res []*embedding.Model
+	err := s.db.QueryStructs(ctx, &res, `SELECT `+modelColumns+` FROM embedding_models ORDER BY id;`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list embedding models: %w", err)
+	}
+	return res, nil
+}
+
+// Activate переключает поиск на модель, предыдущая активная модель остается в реестре вместе с векторами
+func (s Storage) Activate(ctx context.Context, id int) error {
+	return s.trManager.Do(ctx, func(txCtx context.Context) error {
+		if _, err := s.GetByID(txCtx, id); err != nil {
+			return err
+		}
+		if err := s.db.Exec(txCtx, `UPDATE embedding_models SET active = false WHERE active AND id <> $1;`, id); err != nil {
+			return fmt.Errorf("failed to deactivate embedding model: %w", err)
+		}
+		err := s.db.Exec(txCtx, `UPDATE embedding_models SET active = true, activated_at = now() WHERE id = $1 AND NOT active;`, id)
+		if err != nil {
+			return fmt.Errorf("failed to activate embedding model: %w", err)
+		}
+		return nil
+	})
+}
+
+// Coverage считает чанки и вопросы источника, пустой sourceID - всего корпуса, и сколько из них имеют векторы модели
+func (s Storage) Coverage(ctx context.Context, model *embedding.Model, sourceID string) (embedding.Coverage, error) {
+	var res embedding.Coverage
+	err := s.db.QueryStruct(ctx, &res, fmt.Sprintf(`
+SELECT
+	(SELECT count(*) FROM chunks c WHERE $1 = '' OR c.source_id::text = $1) AS chunks,
+	(SELECT count(*) FROM chunks c JOIN %s e ON e.chunk_id = c.id WHERE $1 = '' OR c.source_id::text = $1) AS embedded_chunks,
+	(SELECT count(*) FROM chunk_questions q JOIN chunks c ON c.id = q.chunk_id WHERE $1 = '' OR c.source_id::text = $1) AS questions,
+	(
+		SELECT count(*) FROM chunk_questions q
+		JOIN chunks c ON c.id = q.chunk_id
+		JOIN %s e ON e.question_id = q.id
+		WHERE $1 = '' OR c.source_id::text = $1
+	) AS embedded_questions;
+`, chunkTable(model), questionTable(model)), sourceID)
+	if err != nil {
+		return res, fmt.Errorf("failed to get embedding model coverage: %w", err)
+	}
+	return res, nil
+}
+
+// MissingChunks возвращает чанки источника, пустой sourceID - всего корпуса, у которых нет вектора модели
+func (s Storage) MissingChunks(ctx context.Context, model *embedding.Model, sourceID string, limit int) ([]embedding.Text, error) {
+	var res []embedding.Text
+	err := s.db.QueryStructs(ctx, &res, fmt.Sprintf(`
+SELECT c.id, COALESCE(c.content, '') AS content
+FROM chunks c
+WHERE ($1 = '' OR c.source_id::text = $1)
+	AND NOT EXISTS (SELECT 1 FROM %s e WHERE e.chunk_id = c.id)
+ORDER BY c.id
+LIMIT $2;
+`, chunkTable(model)), sourceID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chunks without embeddings: %w", err)
+	}
+	return res, nil
+}
+
+// MissingQuestions возвращает вопросы источника, пустой sourceID - всего корпуса, у которых нет вектора модели
+func (s Storage) MissingQuestions(ctx context.Context, model *embedding.Model, sourceID string, limit int) ([]embedding.Text, error) {
+	var res []embedding.Text
+	err := s.db.QueryStructs(ctx, &res, fmt.Sprintf(`
+SELECT q.id, COALESCE(q.question, '') AS content
+FROM chunk_questions q
+JOIN chunks c ON c.id = q.chunk_id
+WHERE ($1 = '' OR c.source_id::text = $1)
+	AND NOT EXISTS (SELECT 1 FROM %s e WHERE e.question_id = q.id)
+ORDER BY q.id
+LIMIT $2;
+`, questionTable(model)), sourceID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get questions without embeddings: %w", err)
+	}
+	return res, nil
+}
+
+// SaveChunkVectors сохраняет векторы чанков модели, векторы удаленных за время расчета чанков пропускаются
+func (s Storage) SaveChunkVectors(ctx context.Context, model *embedding.Model, vectors []embedding.Vector) error {
+	return s.saveVectors(ctx, fmt.Sprintf(`
+INSERT INTO %s (chunk_id, embeddings)
+SELECT $1, $2 WHERE EXISTS (SELECT 1 FROM chunks WHERE id = $1)
+ON CONFLICT (chunk_id) DO UPDATE SET embeddings = EXCLUDED.embeddings;
+`, chunkTable(model)), vectors)
+}
+
+// SaveQuestionVectors сохраняет векторы вопросов модели, векторы удаленных за время расчета вопросов пропускаются
+func (s Storage) SaveQuestionVectors(ctx context.Context, model *embedding.Model, vectors []embedding.Vector) error {
+	return s.saveVectors(ctx, fmt.Sprintf(`
+INSERT INTO %s (question_id, embeddings)
+SELECT $1, $2 WHERE EXISTS (SELECT 1 FROM chunk_questions WHERE id = $1)
+ON CONFLICT (question_id) DO UPDATE SET embeddings = EXCLUDED.embeddings;
+`, questionTable(model)), vectors)
+}
+
+func (s Storage) saveVectors(ctx context.Context, sql string, vectors []embedding.Vector) error {
+	if len(vectors) == 0 {
+		return nil
+	}
+	return s.trManager.Do(ctx, func(txCtx context.Context) error {
+		for _, v := range vectors {
+			if err := s.db.Exec(txCtx, sql, v.ID, prepareVector(v.Embeddings)); err != nil {
+				return fmt.Errorf("failed to save embeddings: %w", err)
+			}
+		}
+		return nil
+	})
+}
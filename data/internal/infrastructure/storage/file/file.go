@@ -3,8 +3,10 @@ package file
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
 	"github.com/larek-tech/diploma/data/internal/domain/file"
+	"github.com/larek-tech/diploma/data/internal/domain/source"
 	"github.com/larek-tech/diploma/data/internal/infrastructure/s3"
 	postgres "github.com/larek-tech/diploma/data/internal/infrastructure/storage"
 )
@@ -42,19 +44,23 @@ UPDATE files
 SET
 	source_id = $1,
 	filename = $2,
-	extension = $3,
-	object_key = $4,
+	path = $3,
+	extension = $4,
+	object_key = $5,
+	generation = source_target_generation($1),
+	state = $7,
+	error = '',
 	updated_at = NOW()
-WHERE id = $5
-`, f.SourceID, f.Filename, f.Extension, f.ObjectURL, f.ID)
+WHERE id = $6
+`, f.SourceID, f.Filename, f.Path, f.Extension, f.ObjectURL, f.ID, source.StateQueued)
 		if err != nil {
 			return err
 		}
 	} else {
 		err := s.db.Exec(ctx, `
-INSERT INTO files (id, source_id, filename, extension, object_key, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
-`, f.ID, f.SourceID, f.Filename, f.Extension, f.ObjectURL)
+INSERT INTO files (id, source_id, filename, path, extension, object_key, created_at, updated_at, generation)
+VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW(), source_target_generation($2))
+`, f.ID, f.SourceID, f.Filename, f.Path, f.Extension, f.ObjectURL)
 		if err != nil {
 			return err
 		}
@@ -69,6 +75,32 @@ VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
 	return nil
 }
 
+// SaveRejected сохраняет файл, отклоненный до загрузки, в состоянии skipped с причиной reason,
+// чтобы он попал в отчет об обработке источника. Содержимое файла не сохраняется.
+func (s Store) SaveRejected(ctx context.Context, f *file.File, reason string) error {
+	err := s.db.Exec(ctx, `
+INSERT INTO files (id, source_id, filename, path, extension, object_key, state, error, created_at, updated_at, generation)
+VALUES ($1, $2, $3, $4, $5, '', $6, $7, NOW(), NOW(), source_target_generation($2))
+`, f.ID, f.SourceID, f.Filename, f.Path, f.Extension, source.StateSkipped, reason)
+	if err != nil {
+		return fmt.Errorf("failed to save rejected file: %w", err)
+	}
+	return nil
+}
+
+// SetState сохраняет этап обработки файла, reason - причина ошибки или пропуска
+func (s Store) SetState(ctx context.Context, id string, state source.ObjectState, reason string) error {
+	err := s.db.Exec(ctx, `
+UPDATE files
+SET state = $1, error = $2, updated_at = NOW()
+WHERE id = $3;
+`, state, reason, id)
+	if err != nil {
+		return fmt.Errorf("failed to set file state: %w", err)
+	}
+	return nil
+}
+
 func (s Store) GetByID(ctx context.Context, id string) (*file.File, error) {
 	var f file.File
 	err := s.db.QueryStruct(ctx, &f, `--sql
@@ -76,6 +108,7 @@ SELECT
 	id,
 	source_id,
 	filename,
+	path,
 	extension,
 	object_key,
 	created_at,
@@ -96,3 +129,59 @@ WHERE id = $1
 	}
 	return &f, nil
 }
+
+// GetBySourceID возвращает файлы источника без загрузки содержимого из S3.
+func (s Store) GetBySourceID(ctx context.Context, sourceID string) ([]*file.File, error) {
+	return s.getBySourceID(ctx, `
+WHERE source_id = $1
+ORDER BY created_at;
+`, sourceID)
+}
+
+func (s Store) getBySourceID(ctx context.Context, filter string, args ...any) ([]*file.File, error) {
+	var files []*file.File
+	err := s.db.QueryStructs(ctx, &files, `
+SELECT
+	id,
+	source_id,
+	filename,
+	path,
+	extension,
+	object_key,
+	created_at,
+	updated_at
+FROM files`+filter, args...)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		f.ObjectURL = s.o.GetBaseURL() + getObjectStoreKey(f)
+	}
+	return files, nil
+}
+
+// GetTargetBySourceID возвращает файлы поколения, в которое сейчас записываются данные источника.
+// Отклоненные при загрузке файлы без содержимого не возвращаются.
+func (s Store) GetTargetBySourceID(ctx context.Context, sourceID string) ([]*file.File, error) {
+	return s.getBySourceID(ctx, `
+WHERE source_id = $1 AND generation = source_target_generation(source_id) AND object_key <> ''
+ORDER BY created_at;
+`, sourceID)
+}
+
+// GetStaleBySourceID возвращает файлы источника, относящиеся к поколениям до generation.
+func (s Store) GetStaleBySourceID(ctx context.Context, sourceID string, generation int) ([]*file.File, error) {
+	return s.getBySourceID(ctx, `
+WHERE source_id = $1 AND generation < $2
+ORDER BY created_at;
+`, sourceID, generation)
+}
+
+// DeleteObjects удаляет содержимое файлов из S3, ошибки только логируются.
+func (s Store) DeleteObjects(ctx context.Context, files []*file.File) {
+	for _, f := range files {
+		if err := s.o.Delete(ctx, FileBucketName, getObjectStoreKey(f)); err != nil {
+			slog.Error("failed to delete file raw content", "fileID", f.ID, "err", err)
+		}
+	}
+}
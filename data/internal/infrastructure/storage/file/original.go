@@ -0,0 +1,57 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+	"github.com/larek-tech/diploma/data/internal/domain/file"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/s3"
+	postgres "github.com/larek-tech/diploma/data/internal/infrastructure/storage"
+)
+
+// OpenOriginal открывает загруженный файл без загрузки в память
+func (s Store) OpenOriginal(ctx context.Context, id string) (*document.Original, error) {
+	var f file.File
+	err := s.db.QueryStruct(ctx, &f, `
+SELECT
+	id,
+	filename,
+	extension
+FROM files
+WHERE id = $1;
+`, id)
+	if err != nil {
+		if postgres.IsNoRowsError(err) {
+			return nil, document.ErrOriginalNotFound
+		}
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+	body, info, err := s.o.Open(ctx, FileBucketName, getObjectStoreKey(&f))
+	if err != nil {
+		if errors.Is(err, s3.ErrObjectNotFound) {
+			return nil, document.ErrOriginalNotFound
+		}
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	// файлы загружаются без типа содержимого, тип определяется по расширению
+	contentType := info.ContentType
+	if contentType == "" || contentType == string(s3.ContentTypeUndefined) {
+		contentType = mime.TypeByExtension("." + f.Extension)
+	}
+	if contentType == "" {
+		contentType = string(s3.ContentTypeUndefined)
+	}
+	filename := f.Filename
+	if filename == "" {
+		filename = f.ID + "." + f.Extension
+	}
+	return &document.Original{
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        info.Size,
+		Body:        body,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package filejob
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/larek-tech/diploma/data/internal/domain/source"
+)
+
+// finalStates этапы, после которых файл больше не обрабатывается
+var finalStates = []string{
+	string(source.StateEmbedded),
+	string(source.StateSkipped),
+	string(source.StateFailed),
+}
+
+type Storage struct {
+	db        db
+	trManager trManager
+}
+
+func New(db db, trManager trManager) *Storage {
+	return &Storage{
+		db:        db,
+		trManager: trManager,
+	}
+}
+
+// Finish сохраняет итоговый этап обработки файла и возвращает true, если это был последний
+// необработанный файл его поколения. Строка источника блокируется на время проверки,
+// поэтому из одновременно завершившихся файлов последним признается ровно один.
+func (s Storage) Finish(ctx context.Context, fileID string, state source.ObjectState, reason string) (bool, error) {
+	var remaining int
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		err := s.db.Exec(ctx, `
+SELECT 1
+FROM sources
+WHERE id = (SELECT source_id FROM files WHERE id = $1)
+FOR NO KEY UPDATE;
+`, fileID)
+		if err != nil {
+			return fmt.Errorf("failed to lock source: %w", err)
+		}
+		err = s.db.Exec(ctx, `
+UPDATE files
+SET state = $2, error = $3, updated_at = NOW()
+WHERE id = $1;
+`, fileID, state, reason)
+		if err != nil {
+			return fmt.Errorf("failed to set file state: %w", err)
+		}
+		// запрос выполняется после получения блокировки и видит этапы, сохраненные другими задачами
+		err = s.db.QueryStruct(ctx, &remaining, `
+SELECT
+	COUNT(f.id)
+FROM files f
+JOIN files cur ON cur.id = $1
+WHERE
+	f.source_id = cur.source_id AND
+	f.generation = cur.generation AND
+	f.state <> ALL($2::text[]);
+`, fileID, finalStates)
+		if err != nil {
+			return fmt.Errorf("failed to count unprocessed files: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return remaining == 0, nil
+}
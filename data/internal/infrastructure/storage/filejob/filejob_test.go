@@ -0,0 +1,89 @@
+package filejob
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/larek-tech/diploma/data/internal/domain/source"
+	"github.com/larek-tech/diploma/data/internal/migrations"
+	"github.com/larek-tech/storage/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+// testDSNEnv строка подключения к Postgres для интеграционных тестов хранилища
+const testDSNEnv = "TEST_POSTGRES_DSN"
+
+type dsn string
+
+func (d dsn) DSN() string {
+	return string(d)
+}
+
+func TestStorage_FinishConcurrent(t *testing.T) {
+	conn := os.Getenv(testDSNEnv)
+	if conn == "" {
+		t.Skipf("%s is not set", testDSNEnv)
+	}
+	ctx := context.Background()
+	pg, trManager, err := postgres.New(ctx, dsn(conn))
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer pg.Close()
+
+	ready := migrations.Migrate(pg)
+	deadline := time.Now().Add(time.Minute)
+	for !ready() {
+		if time.Now().After(deadline) {
+			t.Fatal("migrations are not applied")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	s := New(pg, trManager)
+	// две последние задачи источника завершаются одновременно, последней должна оказаться ровно одна
+	for i := 0; i < 20; i++ {
+		var sourceID string
+		err = pg.QueryStruct(ctx, &sourceID, `
+INSERT INTO sources (title, type) VALUES ('filejob test', 1)
+RETURNING id;
+`)
+		if !assert.NoError(t, err) {
+			return
+		}
+		var fileIDs []string
+		err = pg.QueryStructs(ctx, &fileIDs, `
+INSERT INTO files (source_id, filename, extension)
+VALUES ($1, 'a.txt', 'txt'), ($1, 'b.txt', 'txt')
+RETURNING id;
+`, sourceID)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		var (
+			wg    sync.WaitGroup
+			start = make(chan struct{})
+			last  = make([]bool, len(fileIDs))
+			errs  = make([]error, len(fileIDs))
+		)
+		for idx, fileID := range fileIDs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				last[idx], errs[idx] = s.Finish(ctx, fileID, source.StateEmbedded, "")
+			}()
+		}
+		close(start)
+		wg.Wait()
+
+		assert.NoError(t, errs[0])
+		assert.NoError(t, errs[1])
+		assert.True(t, last[0] != last[1], "exactly one file must complete the source, got %v", last)
+		assert.NoError(t, pg.Exec(ctx, `DELETE FROM sources WHERE id = $1;`, sourceID))
+	}
+}
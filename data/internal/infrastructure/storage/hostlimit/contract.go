@@ -0,0 +1,7 @@
+package hostlimit
+
+import "context"
+
+type db interface {
+	QueryStruct(ctx context.Context, dst interface{}, sql string, args ...interface{}) error
+}
@@ -0,0 +1,35 @@
+package hostlimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type Storage struct {
+	db db
+}
+
+func New(db db) *Storage {
+	return &Storage{
+		db: db,
+	}
+}
+
+// Reserve резервирует время следующего запроса к хосту и возвращает, сколько нужно ждать до него.
+// Время следующего разрешенного запроса хранится в строке хоста и сдвигается на interval атомарно,
+// поэтому интервал соблюдается всеми процессами краулера. Используется время сервера БД.
+func (s Storage) Reserve(ctx context.Context, host string, interval time.Duration) (time.Duration, error) {
+	var waitMs int64
+	err := s.db.QueryStruct(ctx, &waitMs, `
+INSERT INTO crawler_hosts (host, next_allowed_at)
+VALUES ($1, now() + $2::bigint * interval '1 millisecond')
+ON CONFLICT (host) DO UPDATE
+SET next_allowed_at = GREATEST(crawler_hosts.next_allowed_at, now()) + $2::bigint * interval '1 millisecond'
+RETURNING (EXTRACT(EPOCH FROM next_allowed_at - now()) * 1000)::bigint - $2::bigint;
+`, host, interval.Milliseconds())
+	if err != nil {
+		return 0, fmt.Errorf("failed to reserve host request: %w", err)
+	}
+	return time.Duration(max(waitMs, 0)) * time.Millisecond, nil
+}
@@ -0,0 +1,117 @@
+package ingestion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/larek-tech/diploma/data/internal/domain/source"
+)
+
+const maxPageSize = 50
+
+// objectsQuery объекты строящегося поколения источника $1 с фильтром по типу $2:
+// сохраненные страницы и файлы, а также страницы, задачи на загрузку которых еще не обработаны
+const objectsQuery = `
+WITH target AS (
+	SELECT source_target_generation($1) AS generation
+), objects AS (
+	SELECT p.id, 'page' AS type, p.url AS name, p.state, p.error, p.created_at, p.updated_at
+	FROM pages p
+	JOIN sites s ON s.id = p.site_id
+	JOIN target t ON s.generation = t.generation
+	WHERE s.source_id = $1
+	UNION ALL
+	SELECT DISTINCT ON (j.payload -> 'payload' ->> 'URL')
+		(j.payload -> 'payload' ->> 'ID')::uuid,
+		'page',
+		j.payload -> 'payload' ->> 'URL',
+		'queued',
+		'',
+		j.created_at,
+		j.created_at
+	FROM web_parse_page j
+	JOIN sites s ON s.id::text = j.payload -> 'payload' ->> 'SiteID'
+	JOIN target t ON s.generation = t.generation
+	WHERE s.source_id = $1 AND
+		j.processed_at IS NULL AND
+		NOT EXISTS (
+			SELECT 1 FROM pages p
+			WHERE p.site_id = s.id AND p.url = j.payload -> 'payload' ->> 'URL'
+		)
+	UNION ALL
+	SELECT f.id, 'file', CASE WHEN f.path <> '' THEN f.path ELSE f.filename END, f.state, f.error, f.created_at, f.updated_at
+	FROM files f
+	JOIN target t ON f.generation = t.generation
+	WHERE f.source_id = $1
+), filtered AS (
+	SELECT * FROM objects WHERE $2::text = '' OR type = $2::text
+)
+`
+
+type Storage struct {
+	db db
+}
+
+func New(db db) *Storage {
+	return &Storage{
+		db: db,
+	}
+}
+
+// GetReport возвращает состояние обработки страниц и файлов источника.
+// Для обновляемого источника отчет строится по новому поколению.
+func (s Storage) GetReport(ctx context.Context, filter source.ReportFilter) (*source.IngestionReport, error) {
+	size := filter.Size
+	if size <= 0 || size > maxPageSize {
+		size = maxPageSize
+	}
+	page := max(filter.Page, 1)
+	states := make([]string, 0, len(filter.States))
+	for _, state := range filter.States {
+		states = append(states, string(state))
+	}
+
+	var counts []source.StateCount
+	err := s.db.QueryStructs(ctx, &counts, objectsQuery+`
+SELECT state, COUNT(*) AS count
+FROM filtered
+GROUP BY state
+ORDER BY state;
+`, filter.SourceID, string(filter.Type))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count ingestion objects: %w", err)
+	}
+
+	var objects []*source.IngestionObject
+	err = s.db.QueryStructs(ctx, &objects, objectsQuery+`
+SELECT
+	o.id, o.type, o.name, o.state, o.error, o.created_at, o.updated_at,
+	COALESCE(c.chunk_count, 0) AS chunk_count
+FROM filtered o
+LEFT JOIN LATERAL (
+	SELECT COUNT(ch.id) AS chunk_count
+	FROM documents d
+	JOIN chunks ch ON ch.document_id = d.id
+	WHERE d.object_id = o.id AND
+		d.generation = (SELECT MAX(generation) FROM documents WHERE object_id = o.id)
+) c ON true
+WHERE cardinality($3::text[]) = 0 OR o.state = ANY($3::text[])
+ORDER BY o.created_at, o.name
+LIMIT $4 OFFSET $5;
+`, filter.SourceID, string(filter.Type), states, size, (page-1)*size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ingestion objects: %w", err)
+	}
+
+	report := &source.IngestionReport{
+		Counts:  counts,
+		Objects: objects,
+	}
+	for _, c := range counts {
+		if len(filter.States) == 0 || slices.Contains(filter.States, c.State) {
+			report.Total += c.Count
+		}
+	}
+	return report, nil
+}
@@ -2,8 +2,6 @@ package object_store
 
 import (
 	"context"
-
-	"github.com/larek-tech/diploma/data/internal/infrastructure/s3"
 )
 
 type (
@@ -12,8 +10,8 @@ type (
 		QueryStruct(ctx context.Context, dst interface{}, sql string, args ...interface{}) error
 		QueryStructs(ctx context.Context, dst interface{}, sql string, args ...interface{}) error
 	}
-	objectStore interface {
-		Upload(ctx context.Context, object *s3.Object) error
-		Download(ctx context.Context, bucketName, key string) (*s3.Object, error)
+	secretCipher interface {
+		Encrypt(value string) (string, error)
+		Decrypt(value string) (string, error)
 	}
 )
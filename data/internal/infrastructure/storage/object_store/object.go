@@ -2,103 +2,181 @@ package object_store
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/larek-tech/diploma/data/internal/domain/object_store"
-	"github.com/larek-tech/diploma/data/internal/infrastructure/s3"
 	postgres "github.com/larek-tech/diploma/data/internal/infrastructure/storage"
 )
 
-const (
-	ObjectBucketName = "objects"
-	ObjectKeyPrefix  = "object/"
-)
-
 type Storage struct {
-	db          db
-	objectStore objectStore
+	db      db
+	secrets secretCipher
 }
 
-func NewStorage(db db, objectStore objectStore) *Storage {
+func NewStorage(db db, secrets secretCipher) *Storage {
 	return &Storage{
-		db:          db,
-		objectStore: objectStore,
+		db:      db,
+		secrets: secrets,
 	}
 }
 
-func getObjectStoreKey(object *object_store.Object) string {
-	return ObjectKeyPrefix + object.ID + object.ContentType
+// SaveStore сохраняет конфигурацию бакета, секретный ключ хранится в базе только в зашифрованном виде.
+func (s Storage) SaveStore(ctx context.Context, store *object_store.ObjectStore) error {
+	cfg := store.Config
+	secretKey, err := s.secrets.Encrypt(cfg.SecretKey)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt secret key: %w", err)
+	}
+	cfg.SecretKey = secretKey
+	err = s.db.Exec(ctx, `
+INSERT INTO object_storage (id, source_id, config, generation)
+VALUES ($1, $2, $3, source_target_generation($2))
+ON CONFLICT (id) DO UPDATE
+SET source_id = EXCLUDED.source_id, config = EXCLUDED.config, generation = EXCLUDED.generation;
+`, store.ID, store.SourceID, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to save object storage: %w", err)
+	}
+	return nil
 }
 
-func assembleObject(object *object_store.Object) *s3.Object {
-	metadata := map[string]string{
-		"object_id": object.ID,
+func (s Storage) GetStoreBySourceID(ctx context.Context, sourceID string) (*object_store.ObjectStore, error) {
+	var store object_store.ObjectStore
+	err := s.db.QueryStruct(ctx, &store, `
+SELECT
+	id,
+	source_id,
+	config
+FROM object_storage
+WHERE source_id = $1
+ORDER BY generation DESC
+LIMIT 1;
+`, sourceID)
+	if err != nil {
+		if postgres.IsNoRowsError(err) {
+			return nil, nil
+		}
+		return nil, err
 	}
-	obj := s3.NewObject(ObjectBucketName, getObjectStoreKey(object), object.Data, s3.ContentTypeUndefined, metadata)
-	return obj
+	store.Config.SecretKey, err = s.secrets.Decrypt(store.Config.SecretKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt secret key: %w", err)
+	}
+	return &store, nil
 }
 
-func (s Storage) Save(ctx context.Context, object *object_store.Object) error {
-	currentObject, err := s.GetByID(ctx, object.ID)
-	if err != nil && !postgres.IsNoRowsError(err) {
-		return err
+// EncryptSecrets шифрует секретные ключи конфигураций, сохраненных до появления шифрования.
+func (s Storage) EncryptSecrets(ctx context.Context) error {
+	var stores []*object_store.ObjectStore
+	err := s.db.QueryStructs(ctx, &stores, `
+SELECT
+	id,
+	source_id,
+	config
+FROM object_storage
+WHERE config ->> 'secretKey' <> '' AND config ->> 'secretKey' NOT LIKE 'enc:%';
+`)
+	if err != nil {
+		return fmt.Errorf("failed to get object storages: %w", err)
 	}
-
-	if currentObject != nil {
-		object.RawContentID = getObjectStoreKey(object)
-		object.ContentType = currentObject.ContentType
-		object.Size = currentObject.Size
-		object.CreatedAt = currentObject.CreatedAt
-		object.UpdatedAt = currentObject.UpdatedAt
-		err = s.db.Exec(ctx, `
-UPDATE object_storage_files
-SET
-	object_storage_id = $1,
-	raw_object_id = $2,
-	content_type = $3,
-	content_size = $4,
-		`)
+	for _, store := range stores {
+		secretKey, err := s.secrets.Encrypt(store.Config.SecretKey)
 		if err != nil {
-			return err
+			return fmt.Errorf("failed to encrypt secret key: %w", err)
 		}
-	}
-
-	if object.Data != nil {
-		err = s.objectStore.Upload(ctx, assembleObject(object))
+		err = s.db.Exec(ctx, `
+UPDATE object_storage
+SET config = jsonb_set(config::jsonb, '{secretKey}', to_jsonb($2::text))::json
+WHERE id = $1;
+`, store.ID, secretKey)
 		if err != nil {
-			return err
+			return fmt.Errorf("failed to save encrypted secret key: %w", err)
 		}
 	}
-
 	return nil
 }
 
-func (s Storage) GetByID(ctx context.Context, id string) (*object_store.Object, error) {
-	var obj object_store.Object
-	sql := `
+// GetObjects возвращает объекты бакета, уже загруженные в систему.
+func (s Storage) GetObjects(ctx context.Context, objectStorageID string) ([]*object_store.Object, error) {
+	var objects []*object_store.Object
+	err := s.db.QueryStructs(ctx, &objects, `
 SELECT
 	id,
 	object_storage_id,
-	raw_object_id,
-	content_type,
+	object_key,
+	etag,
+	COALESCE(file_id::text, '') AS file_id,
 	content_size,
+	content_type,
+	raw_object_id,
+	COALESCE(last_modified, created_at) AS last_modified,
 	created_at,
 	updated_at
-FROM
-	object_storage_files
-WHERE id = $1
-`
-	err := s.db.QueryStruct(ctx, &obj, sql, id)
+FROM object_storage_files
+WHERE object_storage_id = $1;
+`, objectStorageID)
 	if err != nil {
 		return nil, err
 	}
-	if obj.RawContentID != "" {
-		// Get the object from the object store
-		object, err := s.objectStore.Download(ctx, ObjectBucketName, obj.RawContentID)
-		if err != nil {
-			return nil, err
-		}
-		obj.Data = object.GetData()
+	return objects, nil
+}
+
+func (s Storage) SaveObject(ctx context.Context, object *object_store.Object) error {
+	err := s.db.Exec(ctx, `
+INSERT INTO object_storage_files (id, object_storage_id, object_key, etag, file_id, raw_object_id, content_type, content_size, last_modified, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, now(), now())
+ON CONFLICT (object_storage_id, object_key) DO UPDATE
+SET
+	etag = EXCLUDED.etag,
+	file_id = EXCLUDED.file_id,
+	raw_object_id = EXCLUDED.raw_object_id,
+	content_type = EXCLUDED.content_type,
+	content_size = EXCLUDED.content_size,
+	last_modified = EXCLUDED.last_modified,
+	updated_at = now();
+`, object.ID, object.ObjectStorageID, object.Key, object.ETag, object.FileID, object.RawContentID, object.ContentType, object.Size, object.LastModified)
+	if err != nil {
+		return fmt.Errorf("failed to save object: %w", err)
 	}
+	return nil
+}
 
-	return &obj, nil
+// DeleteObjects удаляет объекты, пропавшие из бакета, вместе с файлами и документами, созданными из них.
+func (s Storage) DeleteObjects(ctx context.Context, objects []*object_store.Object) error {
+	if len(objects) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(objects))
+	fileIDs := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		ids = append(ids, obj.ID)
+		if obj.FileID != "" {
+			fileIDs = append(fileIDs, obj.FileID)
+		}
+	}
+	err := s.db.Exec(ctx, `
+DELETE FROM object_storage_files
+WHERE id = ANY($1);
+`, ids)
+	if err != nil {
+		return fmt.Errorf("failed to delete objects: %w", err)
+	}
+	if len(fileIDs) == 0 {
+		return nil
+	}
+	err = s.db.Exec(ctx, `
+DELETE FROM documents
+WHERE object_id = ANY($1);
+`, fileIDs)
+	if err != nil {
+		return fmt.Errorf("failed to delete object documents: %w", err)
+	}
+	err = s.db.Exec(ctx, `
+DELETE FROM files
+WHERE id = ANY($1);
+`, fileIDs)
+	if err != nil {
+		return fmt.Errorf("failed to delete object files: %w", err)
+	}
+	return nil
 }
@@ -2,6 +2,7 @@ package page
 
 import (
 	"context"
+	"io"
 
 	"github.com/larek-tech/diploma/data/internal/infrastructure/s3"
 )
@@ -15,5 +16,7 @@ type (
 	objectStore interface {
 		Upload(ctx context.Context, object *s3.Object) error
 		Download(ctx context.Context, bucketName, key string) (*s3.Object, error)
+		Delete(ctx context.Context, bucketName, key string) error
+		Open(ctx context.Context, bucketName, key string) (io.ReadCloser, s3.ObjectInfo, error)
 	}
 )
@@ -0,0 +1,63 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/larek-tech/diploma/data/internal/domain/document"
+	"github.com/larek-tech/diploma/data/internal/domain/site"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/s3"
+	postgres "github.com/larek-tech/diploma/data/internal/infrastructure/storage"
+)
+
+// OpenOriginal открывает сохраненный html снимок страницы без загрузки в память
+func (s Store) OpenOriginal(ctx context.Context, id string) (*document.Original, error) {
+	var page site.Page
+	err := s.db.QueryStruct(ctx, &page, `
+SELECT
+	id,
+	url
+FROM pages
+WHERE id = $1;
+`, id)
+	if err != nil {
+		if postgres.IsNoRowsError(err) {
+			return nil, document.ErrOriginalNotFound
+		}
+		return nil, fmt.Errorf("failed to get page: %w", err)
+	}
+	body, info, err := s.objectStore.Open(ctx, PageBucketName, getObjectStoreKey(&page))
+	if err != nil {
+		if errors.Is(err, s3.ErrObjectNotFound) {
+			return nil, document.ErrOriginalNotFound
+		}
+		return nil, fmt.Errorf("failed to open page snapshot: %w", err)
+	}
+	return &document.Original{
+		Filename:    snapshotFilename(page.URL, page.ID),
+		ContentType: string(s3.ContentTypeHTML),
+		Size:        info.Size,
+		Body:        body,
+	}, nil
+}
+
+// snapshotFilename возвращает имя файла снимка по последнему сегменту пути страницы
+func snapshotFilename(pageURL, id string) string {
+	u, err := url.Parse(pageURL)
+	if err != nil {
+		return id + ".html"
+	}
+	name := path.Base(u.Path)
+	if name == "." || name == "/" {
+		name = u.Hostname()
+	}
+	if name == "" {
+		name = id
+	}
+	name = strings.TrimSuffix(name, path.Ext(name))
+	return name + ".html"
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 
 	"github.com/larek-tech/diploma/data/internal/domain/site"
+	"github.com/larek-tech/diploma/data/internal/domain/source"
 	"github.com/larek-tech/diploma/data/internal/infrastructure/s3"
 	postgres "github.com/larek-tech/diploma/data/internal/infrastructure/storage"
 )
@@ -42,13 +43,22 @@ func assembleObject(page *site.Page) *s3.Object {
 }
 
 func (s Store) Save(ctx context.Context, page *site.Page) error {
-	page.RawObjectID = getObjectStoreKey(page)
-
 	currentPage, err := s.GetByURL(ctx, page.URL)
 	if err != nil && !postgres.IsNoRowsError(err) {
 		return err
 	}
+	if currentPage != nil {
+		// страница уже сохранена, сохраняем ее идентификатор, чтобы не потерять связанные документы
+		page.ID = currentPage.ID
+		page.CreatedAt = currentPage.CreatedAt
+	}
+	// у пропущенной страницы нет содержимого для загрузки в S3
+	page.RawObjectID = ""
+	if page.SkipReason == "" {
+		page.RawObjectID = getObjectStoreKey(page)
+	}
 
+	state := pageState(page)
 	// Determine whether to update or insert
 	if currentPage != nil {
 		err = s.db.Exec(ctx, `
@@ -59,18 +69,22 @@ SET
 	raw_object_id = $3,
 	metadata = $4,
 	content = $5,
+	skip_reason = $6,
+	etag = $7,
+	last_modified = $8,
+	content_hash = $9,
+	-- неизмененная страница не индексируется повторно и остается проиндексированной
+	state = CASE WHEN state = $11 AND COALESCE(content_hash, '') = $9 AND $12 = $13 THEN state ELSE $12 END,
+	error = $6,
 	updated_at = now()
-WHERE id = $6;
-`, page.SiteID, page.URL, page.RawObjectID, page.Metadata, page.Content, currentPage.ID)
-
-		if err == nil {
-			*page = *currentPage // update all fields in the passed-in page pointer
-		}
+WHERE id = $10;
+`, page.SiteID, page.URL, page.RawObjectID, page.Metadata, page.Content, page.SkipReason, page.ETag, page.LastModified, page.ContentHash, currentPage.ID,
+			source.StateEmbedded, state, source.StateParsed)
 	} else {
 		err = s.db.Exec(ctx, `
-INSERT INTO pages (id, site_id, url, raw_object_id, metadata, content, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, now(), now());
-`, page.ID, page.SiteID, page.URL, page.RawObjectID, page.Metadata, page.Content)
+INSERT INTO pages (id, site_id, url, raw_object_id, metadata, content, skip_reason, etag, last_modified, content_hash, state, error, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $7, now(), now());
+`, page.ID, page.SiteID, page.URL, page.RawObjectID, page.Metadata, page.Content, page.SkipReason, page.ETag, page.LastModified, page.ContentHash, state)
 	}
 
 	if err != nil {
@@ -87,6 +101,147 @@ VALUES ($1, $2, $3, $4, $5, $6, now(), now());
 	return nil
 }
 
+// pageState этап обработки страницы после загрузки
+func pageState(page *site.Page) source.ObjectState {
+	switch {
+	case page.SkipReason != "":
+		return source.StateSkipped
+	case page.Content != "":
+		return source.StateParsed
+	default:
+		return source.StateFetched
+	}
+}
+
+// SetState сохраняет этап обработки страницы, reason - причина ошибки или пропуска
+func (s Store) SetState(ctx context.Context, id string, state source.ObjectState, reason string) error {
+	err := s.db.Exec(ctx, `
+UPDATE pages
+SET state = $1, error = $2, updated_at = now()
+WHERE id = $3;
+`, state, reason, id)
+	if err != nil {
+		return fmt.Errorf("failed to set page state: %w", err)
+	}
+	return nil
+}
+
+// SaveFailed сохраняет страницу, которую не удалось загрузить, с последней ошибкой.
+// Ранее загруженное содержимое страницы не изменяется.
+func (s Store) SaveFailed(ctx context.Context, page *site.Page, reason string) error {
+	currentPage, err := s.GetByURL(ctx, page.URL)
+	if err != nil && !postgres.IsNoRowsError(err) {
+		return err
+	}
+	if currentPage != nil {
+		err = s.db.Exec(ctx, `
+UPDATE pages
+SET site_id = $1, state = $2, error = $3, updated_at = now()
+WHERE id = $4;
+`, page.SiteID, source.StateFailed, reason, currentPage.ID)
+	} else {
+		err = s.db.Exec(ctx, `
+INSERT INTO pages (id, site_id, url, metadata, raw_object_id, content, state, error, created_at, updated_at)
+VALUES ($1, $2, $3, $4, '', '', $5, $6, now(), now());
+`, page.ID, page.SiteID, page.URL, page.Metadata, source.StateFailed, reason)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save failed page: %w", err)
+	}
+	return nil
+}
+
+// Delete удаляет страницу и документы, полученные из нее, чанки удаляются каскадно.
+func (s Store) Delete(ctx context.Context, id string) error {
+	err := s.db.Exec(ctx, `
+DELETE FROM documents
+WHERE object_id = $1;
+`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete page documents: %w", err)
+	}
+	err = s.db.Exec(ctx, `
+DELETE FROM pages
+WHERE id = $1;
+`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete page: %w", err)
+	}
+	s.deleteObject(ctx, id)
+	return nil
+}
+
+// DeleteVanished удаляет страницы сайта, url которых не был поставлен в очередь при обходе siteJobID.
+// Возвращает идентификаторы удаленных страниц.
+func (s Store) DeleteVanished(ctx context.Context, siteID, siteJobID string) ([]string, error) {
+	var ids []string
+	err := s.db.QueryStructs(ctx, &ids, `
+SELECT
+	p.id
+FROM pages p
+WHERE
+	p.site_id = $1 AND
+	NOT EXISTS (
+		SELECT 1 FROM site_job_urls u
+		WHERE u.site_job_id = $2 AND u.url = p.url
+	);
+`, siteID, siteJobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vanished pages: %w", err)
+	}
+	if len(ids) == 0 {
+		return ids, nil
+	}
+	err = s.db.Exec(ctx, `
+DELETE FROM documents
+WHERE object_id = ANY($1);
+`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete vanished page documents: %w", err)
+	}
+	err = s.db.Exec(ctx, `
+DELETE FROM pages
+WHERE id = ANY($1);
+`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete vanished pages: %w", err)
+	}
+	for _, id := range ids {
+		s.deleteObject(ctx, id)
+	}
+	return ids, nil
+}
+
+// GetIDsBySourceID возвращает идентификаторы страниц всех сайтов источника.
+func (s Store) GetIDsBySourceID(ctx context.Context, sourceID string) ([]string, error) {
+	var ids []string
+	err := s.db.QueryStructs(ctx, &ids, `
+SELECT
+	p.id
+FROM pages p
+JOIN sites s ON s.id = p.site_id
+WHERE s.source_id = $1;
+`, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source pages: %w", err)
+	}
+	return ids, nil
+}
+
+// DeleteObjects удаляет содержимое страниц из S3, записи в БД должны быть удалены заранее.
+func (s Store) DeleteObjects(ctx context.Context, ids []string) {
+	for _, id := range ids {
+		s.deleteObject(ctx, id)
+	}
+}
+
+func (s Store) deleteObject(ctx context.Context, id string) {
+	err := s.objectStore.Delete(ctx, PageBucketName, getObjectStoreKey(&site.Page{ID: id}))
+	if err != nil {
+		slog.Error("failed to delete page raw content", "pageID", id, "err", err)
+	}
+}
+
 // FIXME: multiple parse_pages can return same page_url as outgoing and it goes to parse_page forever
 func (s Store) GetByURL(ctx context.Context, url string) (*site.Page, error) {
 	var page site.Page
@@ -98,6 +253,10 @@ SELECT
 	metadata,
 	raw_object_id,
 	content,
+	COALESCE(skip_reason, '') AS skip_reason,
+	COALESCE(etag, '') AS etag,
+	COALESCE(last_modified, '') AS last_modified,
+	COALESCE(content_hash, '') AS content_hash,
 	created_at,
 	updated_at
 FROM pages
@@ -130,6 +289,10 @@ SELECT
 	metadata,
 	raw_object_id,
 	content,
+	COALESCE(skip_reason, '') AS skip_reason,
+	COALESCE(etag, '') AS etag,
+	COALESCE(last_modified, '') AS last_modified,
+	COALESCE(content_hash, '') AS content_hash,
 	created_at,
 	updated_at
 FROM pages
@@ -151,3 +314,19 @@ WHERE id = $1;
 	}
 	return &page, err
 }
+
+// GetStaleIDs возвращает идентификаторы страниц сайтов источника, относящихся к поколениям до generation.
+func (s Store) GetStaleIDs(ctx context.Context, sourceID string, generation int) ([]string, error) {
+	var ids []string
+	err := s.db.QueryStructs(ctx, &ids, `
+SELECT
+	p.id
+FROM pages p
+JOIN sites s ON s.id = p.site_id
+WHERE s.source_id = $1 AND s.generation < $2;
+`, sourceID, generation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stale pages: %w", err)
+	}
+	return ids, nil
+}
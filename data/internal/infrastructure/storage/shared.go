@@ -2,10 +2,29 @@ package postgres
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 func IsNoRowsError(err error) bool {
 	return errors.Is(err, pgx.ErrNoRows)
 }
+
+// IsInvalidInputError ошибка синтаксиса или формата значения, переданного в запрос
+func IsInvalidInputError(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	// 42601 syntax_error, класс 22 data_exception
+	return pgErr.Code == "42601" || strings.HasPrefix(pgErr.Code, "22")
+}
+
+// IsQueryCanceledError запрос прерван по statement_timeout или отмене
+func IsQueryCanceledError(err error) bool {
+	var pgErr *pgconn.PgError
+	// 57014 query_canceled
+	return errors.As(err, &pgErr) && pgErr.Code == "57014"
+}
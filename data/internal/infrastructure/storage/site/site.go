@@ -23,9 +23,9 @@ func (s Storage) Save(ctx context.Context, site *site.Site) error {
 		// if record not found, create a new one
 		if storage.IsNoRowsError(err) {
 			err = s.db.Exec(ctx, `
-INSERT INTO sites (id, source_id, url, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5);
-`, site.ID, site.SourceID, site.URL, site.CreatedAt, site.UpdatedAt)
+INSERT INTO sites (id, source_id, url, available_pages, crawl_mode, created_at, updated_at, generation)
+VALUES ($1, $2, $3, $4, $5, $6, $7, source_target_generation($2));
+`, site.ID, site.SourceID, site.URL, site.AvailablePages, site.CrawlMode, site.CreatedAt, site.UpdatedAt)
 			return err
 		}
 		return err
@@ -33,9 +33,9 @@ VALUES ($1, $2, $3, $4, $5);
 	if currentSite != nil {
 		err = s.db.Exec(ctx, `
 UPDATE sites
-SET source_id = $1, url = $2, updated_at = $3
-WHERE id = $4;
-`, site.SourceID, site.URL, site.UpdatedAt, site.ID)
+SET source_id = $1, url = $2, available_pages = $3, crawl_mode = $4, updated_at = $5, generation = source_target_generation($1)
+WHERE id = $6;
+`, site.SourceID, site.URL, site.AvailablePages, site.CrawlMode, site.UpdatedAt, currentSite.ID)
 		site.ID = currentSite.ID
 		if err != nil {
 			return err
@@ -52,6 +52,7 @@ SELECT
     source_id,
     url,
 	available_pages,
+    crawl_mode,
     created_at,
     updated_at
 FROM sites WHERE id = $1;
@@ -71,6 +72,7 @@ SELECT
     source_id,
     url,
 	available_pages,
+    crawl_mode,
     created_at,
     updated_at
 FROM sites WHERE url = $1;
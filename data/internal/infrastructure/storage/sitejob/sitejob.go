@@ -32,16 +32,25 @@ limit 1;
 	return false, nil
 }
 
+// GetProcessedPageCount возвращает количество url обхода, все задачи которых обработаны.
+// Повторы задачи после ошибки создают новые записи в очереди, поэтому считаются url, а не записи.
 func (s Storage) GetProcessedPageCount(ctx context.Context, parseSiteJobID string) (int, error) {
 	var count int
 	err := s.db.QueryStruct(ctx, &count, `
 SELECT
-    COUNT(id) AS id_count
-FROM
-    web_parse_page
-WHERE
-    payload -> 'metadata' ->> 'siteJobID' = $1 AND
-	processed_at IS NOT NULL;
+    COUNT(*) AS url_count
+FROM (
+    SELECT
+        payload -> 'payload' ->> 'URL'
+    FROM
+        web_parse_page
+    WHERE
+        payload -> 'metadata' ->> 'siteJobID' = $1
+    GROUP BY
+        payload -> 'payload' ->> 'URL'
+    HAVING
+        bool_and(processed_at IS NOT NULL)
+) processed;
 `, parseSiteJobID)
 	if err != nil {
 		return 0, err
@@ -52,11 +61,12 @@ WHERE
 	return 0, nil
 }
 
+// GetUnprocessedPageCount возвращает количество url обхода, задачи которых ожидают обработки, включая повторы.
 func (s Storage) GetUnprocessedPageCount(ctx context.Context, parseSiteJobID string) (int, error) {
 	var count int
 	err := s.db.QueryStruct(ctx, &count, `
 SELECT
-    COUNT(id) AS id_count
+    COUNT(DISTINCT payload -> 'payload' ->> 'URL') AS url_count
 FROM
     web_parse_page
 WHERE
@@ -71,3 +81,64 @@ WHERE
 	}
 	return 0, nil
 }
+
+// RegisterURLs помечает url сайта siteID как поставленные в очередь в рамках обхода и возвращает только новые.
+func (s Storage) RegisterURLs(ctx context.Context, siteID, parseSiteJobID string, urls []string) ([]string, error) {
+	registered := make([]string, 0, len(urls))
+	if len(urls) == 0 {
+		return registered, nil
+	}
+	err := s.db.QueryStructs(ctx, &registered, `
+INSERT INTO site_job_urls (site_job_id, site_id, url)
+SELECT $1, $2, u FROM unnest($3::text[]) AS u
+ON CONFLICT (site_job_id, url) DO NOTHING
+RETURNING url;
+`, parseSiteJobID, siteID, urls)
+	if err != nil {
+		return nil, err
+	}
+	return registered, nil
+}
+
+// GetRegisteredURLCount возвращает количество url, поставленных в очередь в рамках обхода.
+func (s Storage) GetRegisteredURLCount(ctx context.Context, parseSiteJobID string) (int, error) {
+	var count int
+	err := s.db.QueryStruct(ctx, &count, `
+SELECT
+    COUNT(url)
+FROM
+    site_job_urls
+WHERE
+    site_job_id = $1;
+`, parseSiteJobID)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// DeleteURLs удаляет url завершенного обхода, после очистки сайта они больше не нужны.
+func (s Storage) DeleteURLs(ctx context.Context, parseSiteJobID string) error {
+	return s.db.Exec(ctx, `
+DELETE FROM site_job_urls
+WHERE site_job_id = $1;
+`, parseSiteJobID)
+}
+
+// GetUnprocessedResultCount возвращает количество страниц обхода, которые еще не были проиндексированы.
+func (s Storage) GetUnprocessedResultCount(ctx context.Context, parseSiteJobID string) (int, error) {
+	var count int
+	err := s.db.QueryStruct(ctx, &count, `
+SELECT
+    COUNT(DISTINCT payload -> 'payload' ->> 'URL') AS url_count
+FROM
+    web_parse_page_result
+WHERE
+    payload -> 'metadata' ->> 'siteJobID' = $1 AND
+	processed_at IS NULL;
+`, parseSiteJobID)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
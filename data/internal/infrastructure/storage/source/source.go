@@ -2,6 +2,7 @@ package source
 
 import (
 	"context"
+	"time"
 
 	"github.com/larek-tech/diploma/data/internal/domain/source"
 	storage "github.com/larek-tech/diploma/data/internal/infrastructure/storage"
@@ -25,9 +26,9 @@ func (s Storage) Save(ctx context.Context, src *source.Source) error {
 	}
 	if currentSource == nil {
 		err = s.db.Exec(ctx, `
-INSERT INTO sources (id, title, type, credentials, created_at, updated_at)
-VALUES ($1, $2, $3, $4, NOW(), NOW());
-`, src.ID, src.Title, src.Type, src.Credentials)
+INSERT INTO sources (id, title, type, credentials, external_key, content, update_params, next_refresh_at, chunking, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW());
+`, src.ID, src.Title, src.Type, src.Credentials, src.ExternalKey, src.Content, src.UpdateParams, src.NextRefreshAt, src.Chunking)
 		if err != nil {
 			return err
 		}
@@ -36,9 +37,9 @@ VALUES ($1, $2, $3, $4, NOW(), NOW());
 
 	err = s.db.Exec(ctx, `
 UPDATE sources
-SET title = $1, type = $2, credentials = $3, updated_at = NOW()
-WHERE id = $4;
-`, src.Title, src.Type, src.Credentials, src.ID)
+SET title = $1, type = $2, credentials = $3, external_key = $4, content = $5, update_params = $6, next_refresh_at = $7, chunking = $8, updated_at = NOW()
+WHERE id = $9;
+`, src.Title, src.Type, src.Credentials, src.ExternalKey, src.Content, src.UpdateParams, src.NextRefreshAt, src.Chunking, src.ID)
 	src.ID = currentSource.ID
 	if err != nil {
 		return err
@@ -54,7 +55,12 @@ SELECT
 	id,
 	title,
 	type,
-	credentials
+	credentials,
+	COALESCE(external_key, '') AS external_key,
+	content,
+	update_params,
+	next_refresh_at,
+	chunking
 FROM sources 
 WHERE title = $1;
 `, name)
@@ -74,7 +80,12 @@ SELECT
 	id,
 	title,
 	type,
-	credentials
+	credentials,
+	COALESCE(external_key, '') AS external_key,
+	content,
+	update_params,
+	next_refresh_at,
+	chunking
 FROM sources
 WHERE id = $1;
 `, id)
@@ -86,3 +97,145 @@ WHERE id = $1;
 	}
 	return &res, nil
 }
+
+// ClaimRefresh переносит следующее обновление источника на next, если запланированное время совпадает с scheduledFor.
+// Возвращает false, если обновление уже было выполнено другой репликой или расписание изменилось.
+func (s Storage) ClaimRefresh(ctx context.Context, id string, scheduledFor time.Time, next *time.Time) (bool, error) {
+	var claimedID string
+	err := s.db.QueryStruct(ctx, &claimedID, `
+UPDATE sources
+SET next_refresh_at = $3
+WHERE id = $1 AND next_refresh_at = $2
+RETURNING id;
+`, id, scheduledFor, next)
+	if err != nil {
+		if storage.IsNoRowsError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Delete удаляет источник, сайты, url обходов сайтов, страницы, файлы, документы, чанки и таблицы удаляются каскадно.
+// Удаление несуществующего источника не считается ошибкой.
+func (s Storage) Delete(ctx context.Context, id string) error {
+	return s.db.Exec(ctx, `
+DELETE FROM sources
+WHERE id = $1;
+`, id)
+}
+
+// BeginGeneration начинает построение нового поколения индекса источника и возвращает его номер.
+// Если предыдущее поколение еще не было переключено, его построение заменяется новым:
+// задачи предыдущего построения помечены его номером и их результаты отбрасываются,
+// а уже записанные данные удаляются вместе с остальными устаревшими поколениями.
+func (s Storage) BeginGeneration(ctx context.Context, id string) (int, error) {
+	var generation int
+	err := s.db.QueryStruct(ctx, &generation, `
+UPDATE sources
+SET pending_generation = COALESCE(pending_generation, generation) + 1
+WHERE id = $1
+RETURNING pending_generation;
+`, id)
+	if err != nil {
+		return 0, err
+	}
+	return generation, nil
+}
+
+// TargetGeneration возвращает поколение, в которое сейчас записываются данные источника,
+// source.ErrSourceNotFound - если источник удален.
+func (s Storage) TargetGeneration(ctx context.Context, id string) (int, error) {
+	var generation int
+	err := s.db.QueryStruct(ctx, &generation, `
+SELECT COALESCE(pending_generation, generation)
+FROM sources
+WHERE id = $1;
+`, id)
+	if err != nil {
+		if storage.IsNoRowsError(err) {
+			return 0, source.ErrSourceNotFound
+		}
+		return 0, err
+	}
+	return generation, nil
+}
+
+// LockGeneration возвращает поколение, в которое записываются данные источника, и блокирует его
+// смену до конца транзакции: новое построение не начнется, пока записываются данные текущего.
+func (s Storage) LockGeneration(ctx context.Context, id string) (int, error) {
+	var generation int
+	err := s.db.QueryStruct(ctx, &generation, `
+SELECT COALESCE(pending_generation, generation)
+FROM sources
+WHERE id = $1
+FOR SHARE;
+`, id)
+	if err != nil {
+		if storage.IsNoRowsError(err) {
+			return 0, source.ErrSourceNotFound
+		}
+		return 0, err
+	}
+	return generation, nil
+}
+
+// SwapGeneration делает построенное поколение generation активным и возвращает его номер.
+// Возвращает false, если нового поколения нет, его построение заменено более новым
+// или в нем еще нет ни одного документа, в этом случае поиск продолжает использовать предыдущее поколение.
+// Для generation < 0 переключается любое строящееся поколение.
+func (s Storage) SwapGeneration(ctx context.Context, id string, generation int) (int, bool, error) {
+	err := s.db.QueryStruct(ctx, &generation, `
+UPDATE sources src
+SET generation = src.pending_generation, pending_generation = NULL
+WHERE src.id = $1
+	AND src.pending_generation IS NOT NULL
+	AND ($2 < 0 OR src.pending_generation = $2)
+	AND EXISTS (
+		SELECT 1 FROM documents d
+		WHERE d.source_id = src.id AND d.generation = src.pending_generation
+	)
+RETURNING generation;
+`, id, generation)
+	if err != nil {
+		if storage.IsNoRowsError(err) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	return generation, true, nil
+}
+
+// DeleteStaleGenerations удаляет данные поколений источника, предшествующих generation.
+// Документы страниц, которые не изменились с прошлого обхода и поэтому не были обработаны заново,
+// переносятся в новое поколение, если страница по-прежнему относится к сайту этого поколения.
+func (s Storage) DeleteStaleGenerations(ctx context.Context, id string, generation int) error {
+	err := s.db.Exec(ctx, `
+UPDATE documents d
+SET generation = $2
+WHERE d.source_id = $1 AND d.generation < $2
+	AND EXISTS (
+		SELECT 1 FROM pages p
+		JOIN sites st ON st.id = p.site_id
+		WHERE p.id = d.object_id AND st.generation = $2
+	)
+	AND NOT EXISTS (
+		SELECT 1 FROM documents n
+		WHERE n.object_id = d.object_id AND n.generation = $2
+	);
+`, id, generation)
+	if err != nil {
+		return err
+	}
+	for _, table := range []string{"documents", "sites", "files", "object_storage"} {
+		err = s.db.Exec(ctx, `
+DELETE FROM `+table+`
+WHERE source_id = $1 AND generation < $2;
+`, id, generation)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
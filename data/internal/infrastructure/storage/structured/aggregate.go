@@ -0,0 +1,117 @@
+package structured
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/larek-tech/diploma/data/internal/domain/structured"
+)
+
+var filterOperators = map[structured.FilterOp]string{
+	structured.FilterEq:  "=",
+	structured.FilterNe:  "<>",
+	structured.FilterGt:  ">",
+	structured.FilterGte: ">=",
+	structured.FilterLt:  "<",
+	structured.FilterLte: "<=",
+}
+
+// Aggregate выполняет проверенный запрос в транзакции только для чтения с ограничением времени выполнения.
+// Все значения результата приводятся к тексту в postgres, чтобы не терять точность numeric.
+func (s Storage) Aggregate(ctx context.Context, plan *structured.Plan) (*structured.Result, error) {
+	sql, args, columns := buildAggregate(plan)
+
+	var rows []map[string]any
+	err := s.trManager.Do(ctx, func(txCtx context.Context) error {
+		if txErr := s.db.Exec(txCtx, "SET TRANSACTION READ ONLY;"); txErr != nil {
+			return fmt.Errorf("failed to set read only transaction: %w", txErr)
+		}
+		timeout := fmt.Sprintf("SET LOCAL statement_timeout = %d;", structured.QueryTimeout.Milliseconds())
+		if txErr := s.db.Exec(txCtx, timeout); txErr != nil {
+			return fmt.Errorf("failed to set statement timeout: %w", txErr)
+		}
+		return s.db.QueryStructs(txCtx, &rows, sql, args...)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to run aggregate query: %w", err)
+	}
+
+	res := &structured.Result{Columns: columns}
+	if len(rows) > plan.Limit {
+		rows = rows[:plan.Limit]
+		res.Truncated = true
+	}
+	for _, row := range rows {
+		values := make([]string, len(columns))
+		for i := range columns {
+			if v, ok := row[alias(i)].(string); ok {
+				values[i] = v
+			}
+		}
+		res.Rows = append(res.Rows, values)
+	}
+	return res, nil
+}
+
+// buildAggregate собирает sql запроса: имена таблицы и столбцов берутся только из реестра таблиц,
+// значения фильтров передаются параметрами
+func buildAggregate(plan *structured.Plan) (string, []any, []string) {
+	var selects, columns []string
+	for _, c := range plan.GroupBy {
+		selects = append(selects, fmt.Sprintf("(%s)::text AS %s", pgx.Identifier{c.Field}.Sanitize(), alias(len(selects))))
+		columns = append(columns, c.Name)
+	}
+	for _, a := range plan.Aggregations {
+		expr, name := "count(*)", "count(*)"
+		if a.Column != nil {
+			expr = fmt.Sprintf("%s(%s)", a.Func, pgx.Identifier{a.Column.Field}.Sanitize())
+			name = fmt.Sprintf("%s(%s)", a.Func, a.Column.Name)
+		}
+		selects = append(selects, fmt.Sprintf("(%s)::text AS %s", expr, alias(len(selects))))
+		columns = append(columns, name)
+	}
+
+	var sb strings.Builder
+	var args []any
+	sb.WriteString("SELECT " + strings.Join(selects, ", "))
+	sb.WriteString(" FROM " + pgx.Identifier{Schema, plan.Table.TableName}.Sanitize())
+
+	conditions := make([]string, 0, len(plan.Filters))
+	for _, f := range plan.Filters {
+		field := pgx.Identifier{f.Column.Field}.Sanitize()
+		if f.Op == structured.FilterIn {
+			placeholders := make([]string, len(f.Values))
+			for i, v := range f.Values {
+				args = append(args, v)
+				placeholders[i] = "$" + strconv.Itoa(len(args))
+			}
+			conditions = append(conditions, fmt.Sprintf("%s IN (%s)", field, strings.Join(placeholders, ", ")))
+			continue
+		}
+		args = append(args, f.Values[0])
+		conditions = append(conditions, fmt.Sprintf("%s %s $%d", field, filterOperators[f.Op], len(args)))
+	}
+	if len(conditions) > 0 {
+		sb.WriteString(" WHERE " + strings.Join(conditions, " AND "))
+	}
+	if len(plan.GroupBy) > 0 {
+		// группировка и сортировка по исходным столбцам, чтобы числа и даты сортировались по значению, а не как текст
+		fields := make([]string, len(plan.GroupBy))
+		for i, c := range plan.GroupBy {
+			fields[i] = pgx.Identifier{c.Field}.Sanitize()
+		}
+		sb.WriteString(" GROUP BY " + strings.Join(fields, ", "))
+		sb.WriteString(" ORDER BY " + strings.Join(fields, ", "))
+	}
+	// строка сверх лимита показывает, что результат обрезан
+	args = append(args, plan.Limit+1)
+	sb.WriteString(fmt.Sprintf(" LIMIT $%d;", len(args)))
+	return sb.String(), args, columns
+}
+
+func alias(i int) string {
+	return "v" + strconv.Itoa(i)
+}
@@ -0,0 +1,38 @@
+package structured
+
+import (
+	"testing"
+
+	"github.com/larek-tech/diploma/data/internal/domain/structured"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildAggregate(t *testing.T) {
+	t.Parallel()
+
+	table := &structured.Table{
+		TableName: "t_1",
+		Columns: []structured.Column{
+			{Name: "region", Field: "c1", Type: structured.TypeText},
+			{Name: "total", Field: "c2", Type: structured.TypeNumeric},
+			{Name: "quarter", Field: "c3", Type: structured.TypeText},
+		},
+	}
+	plan, err := structured.Query{
+		Aggregations: []structured.Aggregation{{Func: structured.AggregateSum, Column: "total"}, {Func: structured.AggregateCount}},
+		GroupBy:      []string{"region"},
+		Filters: []structured.Filter{
+			{Column: "quarter", Op: structured.FilterIn, Values: []string{"Q3", "Q4"}},
+			{Column: "total", Op: structured.FilterGt, Values: []string{"0"}},
+		},
+		Limit: 10,
+	}.Plan(table)
+	assert.NoError(t, err)
+
+	sql, args, columns := buildAggregate(plan)
+	assert.Equal(t, `SELECT ("c1")::text AS v0, (sum("c2"))::text AS v1, (count(*))::text AS v2`+
+		` FROM "structured_data"."t_1" WHERE "c3" IN ($1, $2) AND "c2" > $3`+
+		` GROUP BY "c1" ORDER BY "c1" LIMIT $4;`, sql)
+	assert.Equal(t, []any{"Q3", "Q4", "0", 11}, args)
+	assert.Equal(t, []string{"region", "sum(total)", "count(*)"}, columns)
+}
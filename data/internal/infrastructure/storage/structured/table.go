@@ -0,0 +1,113 @@
+package structured
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/larek-tech/diploma/data/internal/domain/structured"
+)
+
+// Schema схема postgres, в которой создаются таблицы листов
+const Schema = "structured_data"
+
+// maxInsertParams ограничение на количество параметров одного insert (в postgres не больше 65535)
+const maxInsertParams = 60000
+
+type Storage struct {
+	db        db
+	trManager trManager
+}
+
+func New(db db, trManager trManager) *Storage {
+	return &Storage{
+		db:        db,
+		trManager: trManager,
+	}
+}
+
+// Save создает таблицу листа, регистрирует ее и загружает строки. Первое значение строки - ее номер в исходном файле.
+// Таблица удаляется триггером вместе с записью в structured_tables, то есть при удалении документа или источника.
+func (s Storage) Save(ctx context.Context, table *structured.Table, rows [][]any) error {
+	ident := pgx.Identifier{Schema, table.TableName}.Sanitize()
+	fields := make([]string, 0, len(table.Columns)+1)
+	defs := make([]string, 0, len(table.Columns)+1)
+	fields = append(fields, `"_row"`)
+	defs = append(defs, `"_row" INTEGER NOT NULL`)
+	for _, c := range table.Columns {
+		field := pgx.Identifier{c.Field}.Sanitize()
+		fields = append(fields, field)
+		defs = append(defs, field+" "+c.Type.SQL())
+	}
+
+	err := s.db.Exec(ctx, fmt.Sprintf("CREATE TABLE %s (%s);", ident, strings.Join(defs, ", ")))
+	if err != nil {
+		return fmt.Errorf("failed to create structured table: %w", err)
+	}
+	err = s.db.Exec(ctx, `
+INSERT INTO structured_tables (id, source_id, document_id, sheet, table_name, columns, row_count)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+`, table.ID, table.SourceID, table.DocumentID, table.Sheet, table.TableName, table.Columns, table.RowCount)
+	if err != nil {
+		return fmt.Errorf("failed to register structured table: %w", err)
+	}
+
+	batch := max(1, maxInsertParams/len(fields))
+	for start := 0; start < len(rows); start += batch {
+		end := min(start+batch, len(rows))
+		values := make([]string, 0, end-start)
+		args := make([]any, 0, (end-start)*len(fields))
+		for _, row := range rows[start:end] {
+			placeholders := make([]string, len(row))
+			for i, v := range row {
+				args = append(args, v)
+				placeholders[i] = fmt.Sprintf("$%d", len(args))
+			}
+			values = append(values, "("+strings.Join(placeholders, ", ")+")")
+		}
+		err = s.db.Exec(ctx, fmt.Sprintf("INSERT INTO %s (%s) VALUES %s;", ident, strings.Join(fields, ", "), strings.Join(values, ", ")), args...)
+		if err != nil {
+			return fmt.Errorf("failed to insert structured table rows: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetByID возвращает таблицу активного поколения одного из источников sourceIDs
+func (s Storage) GetByID(ctx context.Context, id string, sourceIDs []string) (*structured.Table, error) {
+	var table structured.Table
+	err := s.db.QueryStruct(ctx, &table, `
+SELECT
+	t.id, t.source_id, t.document_id, t.sheet, t.table_name, t.columns, t.row_count, t.created_at
+FROM structured_tables t
+JOIN documents d ON d.id = t.document_id
+JOIN sources s ON s.id = d.source_id AND d.generation = s.generation
+WHERE t.id = $1 AND t.source_id = ANY($2);
+`, id, sourceIDs)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, structured.ErrTableNotFound
+		}
+		return nil, fmt.Errorf("failed to get structured table: %w", err)
+	}
+	return &table, nil
+}
+
+func (s Storage) ListBySourceIDs(ctx context.Context, sourceIDs []string) ([]*structured.Table, error) {
+	var tables []*structured.Table
+	err := s.db.QueryStructs(ctx, &tables, `
+SELECT
+	t.id, t.source_id, t.document_id, t.sheet, t.table_name, t.columns, t.row_count, t.created_at
+FROM structured_tables t
+JOIN documents d ON d.id = t.document_id
+JOIN sources s ON s.id = d.source_id AND d.generation = s.generation
+WHERE t.source_id = ANY($1)
+ORDER BY t.created_at, t.sheet;
+`, sourceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list structured tables: %w", err)
+	}
+	return tables, nil
+}
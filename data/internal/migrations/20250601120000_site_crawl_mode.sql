@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+ALTER TABLE sites ADD COLUMN crawl_mode SMALLINT NOT NULL DEFAULT 0;
+
+-- url уже поставленные в очередь в рамках одного обхода сайта
+CREATE TABLE IF NOT EXISTS site_job_urls (
+    site_job_id UUID NOT NULL,
+    url TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (site_job_id, url)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+DROP TABLE IF EXISTS site_job_urls;
+ALTER TABLE sites DROP COLUMN crawl_mode;
+-- +goose StatementEnd
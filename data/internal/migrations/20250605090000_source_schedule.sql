@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+ALTER TABLE sources ADD COLUMN external_key TEXT;
+ALTER TABLE sources ADD COLUMN content BYTEA;
+ALTER TABLE sources ADD COLUMN update_params JSONB;
+ALTER TABLE sources ADD COLUMN next_refresh_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+ALTER TABLE sources DROP COLUMN next_refresh_at;
+ALTER TABLE sources DROP COLUMN update_params;
+ALTER TABLE sources DROP COLUMN content;
+ALTER TABLE sources DROP COLUMN external_key;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+ALTER TABLE pages ADD COLUMN etag TEXT;
+ALTER TABLE pages ADD COLUMN last_modified TEXT;
+ALTER TABLE pages ADD COLUMN content_hash TEXT;
+CREATE INDEX IF NOT EXISTS pages_url_idx ON pages (url);
+CREATE INDEX IF NOT EXISTS documents_object_id_idx ON documents (object_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+DROP INDEX IF EXISTS documents_object_id_idx;
+DROP INDEX IF EXISTS pages_url_idx;
+ALTER TABLE pages DROP COLUMN content_hash;
+ALTER TABLE pages DROP COLUMN last_modified;
+ALTER TABLE pages DROP COLUMN etag;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+ALTER TABLE object_storage_files ADD COLUMN object_key TEXT NOT NULL DEFAULT '';
+ALTER TABLE object_storage_files ADD COLUMN etag TEXT NOT NULL DEFAULT '';
+ALTER TABLE object_storage_files ADD COLUMN file_id UUID REFERENCES files(id) ON DELETE SET NULL;
+ALTER TABLE object_storage_files ADD COLUMN last_modified TIMESTAMPTZ;
+CREATE UNIQUE INDEX IF NOT EXISTS object_storage_files_key_idx ON object_storage_files (object_storage_id, object_key);
+CREATE INDEX IF NOT EXISTS object_storage_source_id_idx ON object_storage (source_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+DROP INDEX IF EXISTS object_storage_source_id_idx;
+DROP INDEX IF EXISTS object_storage_files_key_idx;
+ALTER TABLE object_storage_files DROP COLUMN last_modified;
+ALTER TABLE object_storage_files DROP COLUMN file_id;
+ALTER TABLE object_storage_files DROP COLUMN etag;
+ALTER TABLE object_storage_files DROP COLUMN object_key;
+-- +goose StatementEnd
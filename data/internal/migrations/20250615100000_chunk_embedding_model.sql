@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+ALTER TABLE chunks ADD COLUMN embedding_model TEXT;
+ALTER TABLE chunk_questions ADD COLUMN embedding_model TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+ALTER TABLE chunk_questions DROP COLUMN embedding_model;
+ALTER TABLE chunks DROP COLUMN embedding_model;
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+CREATE SCHEMA IF NOT EXISTS structured_data;
+
+CREATE TABLE IF NOT EXISTS structured_tables (
+    id UUID PRIMARY KEY,
+    source_id UUID NOT NULL REFERENCES sources(id) ON DELETE CASCADE,
+    document_id UUID NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    sheet TEXT NOT NULL DEFAULT '',
+    table_name TEXT NOT NULL UNIQUE,
+    columns JSONB NOT NULL,
+    row_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS structured_tables_source_id_idx ON structured_tables (source_id);
+CREATE INDEX IF NOT EXISTS structured_tables_document_id_idx ON structured_tables (document_id);
+
+-- таблица листа удаляется вместе с записью реестра, в том числе при каскадном удалении документа или источника
+CREATE OR REPLACE FUNCTION drop_structured_table() RETURNS TRIGGER AS $$
+BEGIN
+    EXECUTE format('DROP TABLE IF EXISTS structured_data.%I', OLD.table_name);
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER structured_tables_drop
+AFTER DELETE ON structured_tables
+FOR EACH ROW EXECUTE FUNCTION drop_structured_table();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+DROP TRIGGER IF EXISTS structured_tables_drop ON structured_tables;
+DROP FUNCTION IF EXISTS drop_structured_table();
+DROP TABLE IF EXISTS structured_tables;
+DROP SCHEMA IF EXISTS structured_data CASCADE;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- generation - поколение индекса, которое используется при поиске,
+-- pending_generation - поколение, которое строится после изменения источника
+ALTER TABLE sources ADD COLUMN IF NOT EXISTS generation INT NOT NULL DEFAULT 0;
+ALTER TABLE sources ADD COLUMN IF NOT EXISTS pending_generation INT;
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS generation INT NOT NULL DEFAULT 0;
+ALTER TABLE sites ADD COLUMN IF NOT EXISTS generation INT NOT NULL DEFAULT 0;
+ALTER TABLE files ADD COLUMN IF NOT EXISTS generation INT NOT NULL DEFAULT 0;
+ALTER TABLE object_storage ADD COLUMN IF NOT EXISTS generation INT NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS documents_source_generation_idx ON documents (source_id, generation);
+
+-- поколение, в которое записываются новые данные источника
+CREATE OR REPLACE FUNCTION source_target_generation(src UUID) RETURNS INT AS $$
+    SELECT COALESCE(pending_generation, generation) FROM sources WHERE id = src;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+DROP FUNCTION IF EXISTS source_target_generation(UUID);
+DROP INDEX IF EXISTS documents_source_generation_idx;
+ALTER TABLE object_storage DROP COLUMN IF EXISTS generation;
+ALTER TABLE files DROP COLUMN IF EXISTS generation;
+ALTER TABLE sites DROP COLUMN IF EXISTS generation;
+ALTER TABLE documents DROP COLUMN IF EXISTS generation;
+ALTER TABLE sources DROP COLUMN IF EXISTS pending_generation;
+ALTER TABLE sources DROP COLUMN IF EXISTS generation;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- state - этап обработки страницы или файла, error - причина ошибки или пропуска
+ALTER TABLE pages ADD COLUMN IF NOT EXISTS state TEXT NOT NULL DEFAULT 'fetched';
+ALTER TABLE pages ADD COLUMN IF NOT EXISTS error TEXT NOT NULL DEFAULT '';
+ALTER TABLE files ADD COLUMN IF NOT EXISTS state TEXT NOT NULL DEFAULT 'queued';
+ALTER TABLE files ADD COLUMN IF NOT EXISTS error TEXT NOT NULL DEFAULT '';
+
+UPDATE pages SET state = 'parsed' WHERE COALESCE(content, '') <> '';
+UPDATE pages SET state = 'skipped', error = skip_reason WHERE COALESCE(skip_reason, '') <> '';
+UPDATE pages p SET state = 'embedded' WHERE EXISTS (SELECT 1 FROM documents d WHERE d.object_id = p.id);
+UPDATE files f SET state = 'embedded' WHERE EXISTS (SELECT 1 FROM documents d WHERE d.object_id = f.id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+ALTER TABLE files DROP COLUMN IF EXISTS error;
+ALTER TABLE files DROP COLUMN IF EXISTS state;
+ALTER TABLE pages DROP COLUMN IF EXISTS error;
+ALTER TABLE pages DROP COLUMN IF EXISTS state;
+-- +goose StatementEnd
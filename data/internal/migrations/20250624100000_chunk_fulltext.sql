@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- полнотекстовое представление чанка для лексического поиска по кодам форм, артикулам и аббревиатурам,
+-- русская и английская конфигурации объединяются, чтобы находить термины на обоих языках
+ALTER TABLE chunks ADD COLUMN IF NOT EXISTS content_tsv TSVECTOR
+    GENERATED ALWAYS AS (
+        to_tsvector('russian', COALESCE(content, '')) || to_tsvector('english', COALESCE(content, ''))
+    ) STORED;
+CREATE INDEX IF NOT EXISTS chunks_content_tsv_idx ON chunks USING GIN (content_tsv);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+DROP INDEX IF EXISTS chunks_content_tsv_idx;
+ALTER TABLE chunks DROP COLUMN IF EXISTS content_tsv;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS extension TEXT NOT NULL DEFAULT '';
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS url TEXT NOT NULL DEFAULT '';
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS language TEXT NOT NULL DEFAULT '';
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS document_date TIMESTAMPTZ NOT NULL DEFAULT now();
+
+UPDATE documents d
+SET extension = '.html', url = p.url, document_date = p.updated_at
+FROM pages p
+WHERE p.id = d.object_id AND d.object_type = 'web.page';
+
+UPDATE documents d
+SET extension = lower(f.extension),
+    url = CASE WHEN f.path <> '' THEN f.path ELSE f.filename END,
+    document_date = f.updated_at
+FROM files f
+WHERE f.id = d.object_id AND d.object_type = 'file';
+
+UPDATE documents
+SET extension = '.' || extension
+WHERE extension <> '' AND extension NOT LIKE '.%';
+
+UPDATE documents
+SET language = CASE
+    WHEN content ~ '[А-Яа-яЁё]' THEN 'ru'
+    WHEN content ~ '[A-Za-z]' THEN 'en'
+    ELSE ''
+END;
+
+CREATE INDEX IF NOT EXISTS documents_extension_idx ON documents (extension);
+CREATE INDEX IF NOT EXISTS documents_url_idx ON documents (url text_pattern_ops);
+CREATE INDEX IF NOT EXISTS documents_document_date_idx ON documents (document_date);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+DROP INDEX IF EXISTS documents_document_date_idx;
+DROP INDEX IF EXISTS documents_url_idx;
+DROP INDEX IF EXISTS documents_extension_idx;
+ALTER TABLE documents DROP COLUMN IF EXISTS document_date;
+ALTER TABLE documents DROP COLUMN IF EXISTS language;
+ALTER TABLE documents DROP COLUMN IF EXISTS url;
+ALTER TABLE documents DROP COLUMN IF EXISTS extension;
+-- +goose StatementEnd
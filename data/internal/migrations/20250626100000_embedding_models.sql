@@ -0,0 +1,82 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- реестр моделей эмбеддингов: векторы каждой модели хранятся в отдельных таблицах схемы embeddings
+-- с размерностью модели, поиск выполняется по активной модели
+CREATE TABLE IF NOT EXISTS embedding_models (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    version TEXT NOT NULL DEFAULT '',
+    dimensions INTEGER NOT NULL,
+    index_type TEXT NOT NULL DEFAULT 'ivfflat',
+    active BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    activated_at TIMESTAMPTZ,
+    UNIQUE (name, version)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS embedding_models_active_idx ON embedding_models (active) WHERE active;
+
+CREATE SCHEMA IF NOT EXISTS embeddings;
+
+-- существующие векторы VECTOR(1024) переносятся в таблицы модели, которой они были получены
+INSERT INTO embedding_models (id, name, dimensions, active, activated_at)
+VALUES (
+    1,
+    COALESCE(
+        (SELECT embedding_model FROM chunks WHERE embedding_model IS NOT NULL GROUP BY embedding_model ORDER BY count(*) DESC LIMIT 1),
+        'bge-m3:latest'
+    ),
+    1024,
+    true,
+    now()
+);
+SELECT setval(pg_get_serial_sequence('embedding_models', 'id'), 1);
+
+CREATE TABLE embeddings.chunks_1 (
+    chunk_id UUID PRIMARY KEY REFERENCES chunks(id) ON DELETE CASCADE,
+    embeddings VECTOR(1024) NOT NULL
+);
+CREATE TABLE embeddings.questions_1 (
+    question_id UUID PRIMARY KEY REFERENCES chunk_questions(id) ON DELETE CASCADE,
+    embeddings VECTOR(1024) NOT NULL
+);
+INSERT INTO embeddings.chunks_1 (chunk_id, embeddings)
+SELECT id, embeddings FROM chunks WHERE embeddings IS NOT NULL;
+INSERT INTO embeddings.questions_1 (question_id, embeddings)
+SELECT id, embeddings FROM chunk_questions WHERE embeddings IS NOT NULL;
+CREATE INDEX chunks_1_embeddings_idx ON embeddings.chunks_1 USING ivfflat (embeddings vector_cosine_ops);
+CREATE INDEX questions_1_embeddings_idx ON embeddings.questions_1 USING ivfflat (embeddings vector_cosine_ops);
+
+UPDATE chunks SET embedding_model = (SELECT name FROM embedding_models WHERE id = 1) WHERE embedding_model IS NULL;
+UPDATE chunk_questions SET embedding_model = (SELECT name FROM embedding_models WHERE id = 1) WHERE embedding_model IS NULL;
+ALTER TABLE chunks DROP COLUMN embeddings;
+ALTER TABLE chunk_questions DROP COLUMN embeddings;
+
+-- таблицы векторов удаляются вместе с записью реестра
+CREATE OR REPLACE FUNCTION drop_embedding_tables() RETURNS TRIGGER AS $$
+BEGIN
+    EXECUTE format('DROP TABLE IF EXISTS embeddings.%I', 'chunks_' || OLD.id);
+    EXECUTE format('DROP TABLE IF EXISTS embeddings.%I', 'questions_' || OLD.id);
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER embedding_models_drop
+AFTER DELETE ON embedding_models
+FOR EACH ROW EXECUTE FUNCTION drop_embedding_tables();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+ALTER TABLE chunks ADD COLUMN embeddings VECTOR(1024);
+ALTER TABLE chunk_questions ADD COLUMN embeddings VECTOR(1024);
+UPDATE chunks c SET embeddings = e.embeddings FROM embeddings.chunks_1 e WHERE e.chunk_id = c.id;
+UPDATE chunk_questions q SET embeddings = e.embeddings FROM embeddings.questions_1 e WHERE e.question_id = q.id;
+CREATE INDEX ON chunks USING ivfflat (embeddings vector_cosine_ops);
+CREATE INDEX ON chunk_questions USING ivfflat (embeddings vector_cosine_ops);
+DROP TRIGGER IF EXISTS embedding_models_drop ON embedding_models;
+DROP FUNCTION IF EXISTS drop_embedding_tables();
+DROP SCHEMA IF EXISTS embeddings CASCADE;
+DROP TABLE IF EXISTS embedding_models;
+-- +goose StatementEnd
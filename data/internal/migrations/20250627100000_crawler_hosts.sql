@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- время следующего разрешенного запроса к хосту, общее для всех процессов краулера
+CREATE TABLE IF NOT EXISTS crawler_hosts (
+    host TEXT PRIMARY KEY,
+    next_allowed_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+DROP TABLE IF EXISTS crawler_hosts;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- url обхода удаляются вместе с сайтом и его источником
+ALTER TABLE site_job_urls ADD COLUMN site_id UUID REFERENCES sites(id) ON DELETE CASCADE;
+UPDATE site_job_urls u SET site_id = p.site_id FROM pages p WHERE p.url = u.url;
+DELETE FROM site_job_urls WHERE site_id IS NULL;
+ALTER TABLE site_job_urls ALTER COLUMN site_id SET NOT NULL;
+CREATE INDEX IF NOT EXISTS site_job_urls_site_id_idx ON site_job_urls (site_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+DROP INDEX IF EXISTS site_job_urls_site_id_idx;
+ALTER TABLE site_job_urls DROP COLUMN IF EXISTS site_id;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+package delete_source
+
+import (
+	"context"
+)
+
+type (
+	service interface {
+		DeleteSource(ctx context.Context, id string) error
+	}
+	kafkaProducer interface {
+		Produce(ctx context.Context, topic string, key []byte, value []byte) error
+	}
+)
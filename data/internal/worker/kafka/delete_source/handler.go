@@ -0,0 +1,59 @@
+package delete_source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/IBM/sarama"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/queue/messages"
+)
+
+const resultTopic string = "source_deleted"
+
+type Handler struct {
+	service       service
+	kafkaProducer kafkaProducer
+}
+
+func New(service service, kafkaProducer kafkaProducer) *Handler {
+	return &Handler{
+		service:       service,
+		kafkaProducer: kafkaProducer,
+	}
+}
+
+// Handle удаляет данные источника и сообщает результат в топик source_deleted.
+func (h Handler) Handle(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	var payload messages.DeleteMessage
+	if err := json.Unmarshal(msg.Value, &payload); err != nil {
+		return fmt.Errorf("failed to decode DeleteMessage: %w", err)
+	}
+	if payload.SourceID == "" {
+		return fmt.Errorf("delete message has empty source id")
+	}
+	slog.Debug("received delete source msg", "sourceID", payload.SourceID)
+
+	result := messages.DeletionResult{
+		SourceID: payload.SourceID,
+		Status:   messages.DeletionDone,
+	}
+	deleteErr := h.service.DeleteSource(ctx, payload.SourceID)
+	if deleteErr != nil {
+		result.Status = messages.DeletionFailed
+		result.Error = deleteErr.Error()
+	}
+
+	value, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload of DeletionResult: %w", err)
+	}
+	if err = h.kafkaProducer.Produce(ctx, resultTopic, msg.Key, value); err != nil {
+		slog.Error("failed to produce deletion result", "sourceID", payload.SourceID, "error", err)
+	}
+	if deleteErr != nil {
+		return fmt.Errorf("failed to delete source: %w", deleteErr)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package update_source
+
+import (
+	"context"
+
+	"github.com/larek-tech/diploma/data/internal/domain/source"
+)
+
+type (
+	service interface {
+		UpdateSource(ctx context.Context, message source.UpdateMessage) (*source.Source, error)
+	}
+	kafkaProducer interface {
+		Produce(ctx context.Context, topic string, key []byte, value []byte) error
+	}
+)
@@ -0,0 +1,67 @@
+package update_source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/IBM/sarama"
+	"github.com/larek-tech/diploma/data/internal/domain/source"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/queue/messages"
+)
+
+const resultTopic string = "status"
+
+type Handler struct {
+	service       service
+	kafkaProducer kafkaProducer
+}
+
+func New(service service, kafkaProducer kafkaProducer) *Handler {
+	return &Handler{
+		service:       service,
+		kafkaProducer: kafkaProducer,
+	}
+}
+
+// Handle запускает загрузку нового содержимого источника и сообщает статус в топик status.
+// Изменение только настроек не переиндексирует источник, поэтому статус не отправляется.
+func (h Handler) Handle(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	var payload source.UpdateMessage
+	if err := json.Unmarshal(msg.Value, &payload); err != nil {
+		return fmt.Errorf("failed to decode UpdateMessage: %w", err)
+	}
+	if payload.SourceID == "" {
+		return fmt.Errorf("update message has empty source id")
+	}
+	slog.Debug("received update source msg", "sourceID", payload.SourceID, "settingsOnly", payload.SettingsOnly)
+
+	if payload.SettingsOnly {
+		if _, err := h.service.UpdateSource(ctx, payload); err != nil {
+			return fmt.Errorf("failed to update source settings: %w", err)
+		}
+		return nil
+	}
+
+	status := messages.ParsingStatus{
+		SourceID: payload.SourceID,
+		Status:   messages.StatusParsing,
+	}
+	_, updateErr := h.service.UpdateSource(ctx, payload)
+	if updateErr != nil {
+		status.Status = messages.StatusFailed
+	}
+
+	value, err := json.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload of ParsingStatus: %w", err)
+	}
+	if err = h.kafkaProducer.Produce(ctx, resultTopic, msg.Key, value); err != nil {
+		slog.Error("failed to produce update status", "sourceID", payload.SourceID, "error", err)
+	}
+	if updateErr != nil {
+		return fmt.Errorf("failed to update source: %w", updateErr)
+	}
+	return nil
+}
@@ -7,19 +7,34 @@ import (
 	"github.com/larek-tech/diploma/data/internal/domain/document"
 	"github.com/larek-tech/diploma/data/internal/domain/file"
 	"github.com/larek-tech/diploma/data/internal/domain/site"
+	"github.com/larek-tech/diploma/data/internal/domain/source"
 )
 
 type (
 	embeddingService interface {
-		Process(ctx context.Context, obj io.ReadSeeker, fileExt document.FileExtension, sourceObj any, sourceID string, metadata map[string]any) error
+		Process(ctx context.Context, obj io.ReadSeeker, fileExt document.FileExtension, sourceObj any, sourceID string, generation int, metadata map[string]any) error
+	}
+	stateStore interface {
+		SetState(ctx context.Context, id string, state source.ObjectState, reason string) error
 	}
 	pageStore interface {
+		stateStore
 		GetByID(ctx context.Context, id string) (*site.Page, error)
 	}
 	siteStore interface {
 		GetByID(ctx context.Context, id string) (*site.Site, error)
 	}
 	fileStore interface {
+		stateStore
 		GetByID(ctx context.Context, id string) (*file.File, error)
 	}
+	fileJobStore interface {
+		Finish(ctx context.Context, fileID string, state source.ObjectState, reason string) (bool, error)
+	}
+	generationSwapper interface {
+		CompleteGeneration(ctx context.Context, sourceID string, generation int) (bool, error)
+	}
+	kafkaProducer interface {
+		Produce(ctx context.Context, topic string, key []byte, value []byte) error
+	}
 )
@@ -6,50 +6,71 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 	"strings"
 
 	"github.com/larek-tech/diploma/data/internal/domain/document"
+	"github.com/larek-tech/diploma/data/internal/domain/file"
+	"github.com/larek-tech/diploma/data/internal/domain/source"
 	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/queue/messages"
 	"go.dataddo.com/pgq"
 )
 
 const (
+	resultTopic    = "status"
 	resourceUrlKey = "resourceUrl"
+	// archivePathKey путь файла внутри загруженного архива
+	archivePathKey = "archivePath"
 )
 
 type Handler struct {
-	embeddingService embeddingService
-	pageStore        pageStore
-	siteStore        siteStore
-	fileStore        fileStore
+	embeddingService  embeddingService
+	pageStore         pageStore
+	siteStore         siteStore
+	fileStore         fileStore
+	fileJobStore      fileJobStore
+	generationSwapper generationSwapper
+	kafkaProducer     kafkaProducer
 }
 
-func New(embeddingService embeddingService, pageStore pageStore, siteStore siteStore, fileStore fileStore) *Handler {
+func New(
+	embeddingService embeddingService,
+	pageStore pageStore,
+	siteStore siteStore,
+	fileStore fileStore,
+	fileJobStore fileJobStore,
+	generationSwapper generationSwapper,
+	kafkaProducer kafkaProducer,
+) *Handler {
 	return &Handler{
-		embeddingService: embeddingService,
-		pageStore:        pageStore,
-		siteStore:        siteStore,
-		fileStore:        fileStore,
+		embeddingService:  embeddingService,
+		pageStore:         pageStore,
+		siteStore:         siteStore,
+		fileStore:         fileStore,
+		fileJobStore:      fileJobStore,
+		generationSwapper: generationSwapper,
+		kafkaProducer:     kafkaProducer,
 	}
 }
 
 func (h Handler) Handle(ctx context.Context, msg *pgq.MessageIncoming) (bool, error) {
 	objType, ok := msg.Metadata["sourceQueue"]
 	if !ok {
-		return false, nil
+		return true, qaas.Permanent(fmt.Errorf("missing sourceQueue in metadata"))
 	}
 	switch qaas.Queue(objType) {
 	case qaas.ParsePageQueue:
 		var job qaas.PageJob
 		if err := json.Unmarshal(msg.Payload, &job); err != nil {
-			return true, fmt.Errorf("failed to unmarshal PageJob: %w", err)
+			return true, qaas.Permanent(fmt.Errorf("failed to unmarshal PageJob: %w", err))
 		}
 		page, err := h.pageStore.GetByID(ctx, job.Payload.ID)
 		if err != nil {
 			return true, fmt.Errorf("failed to get page in embed_document: %w", err)
 		}
 		if page == nil {
-			return true, fmt.Errorf("page not found in embed_document")
+			return true, qaas.Permanent(fmt.Errorf("page not found in embed_document"))
 		}
 		site, err := h.siteStore.GetByID(ctx, page.SiteID)
 		if err != nil {
@@ -61,29 +82,49 @@ func (h Handler) Handle(ctx context.Context, msg *pgq.MessageIncoming) (bool, er
 			document.HTML,
 			page,
 			site.SourceID,
+			qaas.Generation(job.Metadata),
 			map[string]any{
 				resourceUrlKey: page.URL,
 			},
 		)
+		if errors.Is(err, document.ErrStaleGeneration) {
+			slog.Info("dropped page of replaced generation or deleted source", "pageID", page.ID, "sourceID", site.SourceID)
+			return true, nil
+		}
 		if err != nil {
-			return true, fmt.Errorf("failed to process page in embed_document: %w", err)
+			err = fmt.Errorf("failed to process page in embed_document: %w", err)
+			if qaas.LastAttempt(ctx, msg) {
+				h.setState(ctx, h.pageStore, page.ID, source.StateFailed, err.Error())
+			}
+			return true, err
 		}
+		h.setState(ctx, h.pageStore, page.ID, source.StateEmbedded, "")
 		return true, nil
 	case qaas.ParseFileQueue:
 		var job qaas.FileJob
 		if err := json.Unmarshal(msg.Payload, &job); err != nil {
-			return true, fmt.Errorf("failed to unmarshal FileJob: %w", err)
+			return true, qaas.Permanent(fmt.Errorf("failed to unmarshal FileJob: %w", err))
 		}
 		file, err := h.fileStore.GetByID(ctx, job.Payload.ID)
 		if err != nil {
 			return true, fmt.Errorf("failed o get file from db: %w", err)
 		}
 		if file == nil {
-			return true, fmt.Errorf("got empty file from storage: %v", job)
+			return true, qaas.Permanent(fmt.Errorf("got empty file from storage: %v", job))
 		}
+		externalKey, _ := job.Metadata["externalKey"].(string)
+		generation := qaas.Generation(job.Metadata)
 		ext, err := getFileExt(file.Filename)
 		if err != nil {
-			return true, fmt.Errorf("failed to determine file extension")
+			// неподдерживаемый файл не мешает завершению обработки источника
+			h.finishFile(ctx, msg.ID().String(), file, generation, source.StateSkipped, err.Error(), externalKey)
+			return true, nil
+		}
+		metadata := map[string]any{
+			resourceUrlKey: file.ObjectURL,
+		}
+		if file.Path != "" {
+			metadata[archivePathKey] = file.Path
 		}
 		err = h.embeddingService.Process(
 			ctx,
@@ -91,19 +132,68 @@ func (h Handler) Handle(ctx context.Context, msg *pgq.MessageIncoming) (bool, er
 			ext,
 			file,
 			file.SourceID,
-			map[string]any{
-				resourceUrlKey: file.ObjectURL,
-			},
+			generation,
+			metadata,
 		)
+		if errors.Is(err, document.ErrStaleGeneration) {
+			// файл уже передан построению нового поколения и будет завершен его задачей
+			slog.Info("dropped file of replaced generation or deleted source", "fileID", file.ID, "sourceID", file.SourceID)
+			return true, nil
+		}
 		if err != nil {
-			return true, fmt.Errorf("failed to process file in embed_document: %w", err)
+			err = fmt.Errorf("failed to process file in embed_document: %w", err)
+			// при повторе задача снова попадет в очередь, поколение переключит последняя обработанная задача
+			if qaas.LastAttempt(ctx, msg) {
+				h.finishFile(ctx, msg.ID().String(), file, generation, source.StateFailed, err.Error(), externalKey)
+			}
+			return true, err
 		}
+		h.finishFile(ctx, msg.ID().String(), file, generation, source.StateEmbedded, "", externalKey)
 	default:
-		return true, fmt.Errorf("unknown job type: %T", msg)
+		return true, qaas.Permanent(fmt.Errorf("unknown job type: %s", objType))
 	}
 	return true, nil
 }
 
+// setState сохраняет этап обработки страницы или файла для отчета об обработке источника
+func (h Handler) setState(ctx context.Context, store stateStore, id string, state source.ObjectState, reason string) {
+	if err := store.SetState(ctx, id, state, reason); err != nil {
+		slog.Error("failed to set object state", "id", id, "state", state, "error", err)
+	}
+}
+
+// finishFile сохраняет итоговый этап обработки файла. Если файл был последним в своем поколении,
+// поиск переключается на поколение generation и в топик status отправляется готовность источника.
+func (h Handler) finishFile(ctx context.Context, jobID string, f *file.File, generation int, state source.ObjectState, reason, externalKey string) {
+	sourceID := f.SourceID
+	last, err := h.fileJobStore.Finish(ctx, f.ID, state, reason)
+	if err != nil {
+		slog.Error("failed to finish file", "fileID", f.ID, "sourceID", sourceID, "error", err)
+		return
+	}
+	if !last {
+		return
+	}
+	if _, err = h.generationSwapper.CompleteGeneration(ctx, sourceID, generation); err != nil {
+		slog.Error("failed to complete source generation", "sourceID", sourceID, "error", err)
+	}
+	if externalKey == "" {
+		return
+	}
+	value, err := json.Marshal(messages.ParsingStatus{
+		SourceID: sourceID,
+		Status:   messages.StatusReady,
+		JobID:    jobID,
+	})
+	if err != nil {
+		slog.Error("failed to marshal payload of ParsingStatus", "error", err)
+		return
+	}
+	if err = h.kafkaProducer.Produce(ctx, resultTopic, []byte(externalKey), value); err != nil {
+		slog.Error("failed to produce file status", "sourceID", sourceID, "error", err)
+	}
+}
+
 func getFileExt(title string) (document.FileExtension, error) {
 	parts := strings.Split(title, ".")
 	if len(parts) < 2 {
@@ -12,11 +12,15 @@ type (
 		Save(ctx context.Context, page *site.Page) error
 		GetByURL(ctx context.Context, url string) (*site.Page, error)
 		GetByID(ctx context.Context, id string) (*site.Page, error)
+		SaveFailed(ctx context.Context, page *site.Page, reason string) error
+	}
+	sourceStore interface {
+		TargetGeneration(ctx context.Context, sourceID string) (int, error)
 	}
 	publisher interface {
 		Publish(ctx context.Context, rawMsg []any, opts ...qaas.PublishOption) ([]string, error)
 	}
 	pageService interface {
-		ParsePage(ctx context.Context, page *site.Page, siteJobID string) ([]*site.Page, bool, error)
+		ParsePage(ctx context.Context, page *site.Page, task site.PageTask) ([]*site.Page, bool, error)
 	}
 )
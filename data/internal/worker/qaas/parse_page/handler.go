@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"time"
 
+	"github.com/larek-tech/diploma/data/internal/domain/site"
 	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
+	"github.com/samber/lo"
 	"go.dataddo.com/pgq"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -15,6 +18,7 @@ import (
 type Handler struct {
 	pageStore   pageStore
 	pageService pageService
+	sourceStore sourceStore
 	publisher   publisher
 	tracer      trace.Tracer
 }
@@ -22,12 +26,14 @@ type Handler struct {
 func New(
 	pageStore pageStore,
 	pageService pageService,
+	sourceStore sourceStore,
 	publisher publisher,
 	tracer trace.Tracer,
 ) *Handler {
 	return &Handler{
 		pageService: pageService,
 		pageStore:   pageStore,
+		sourceStore: sourceStore,
 		publisher:   publisher,
 		tracer:      tracer,
 	}
@@ -40,19 +46,19 @@ func (h Handler) Handle(ctx context.Context, msg *pgq.MessageIncoming) (bool, er
 	var job qaas.PageJob
 	err := json.Unmarshal(msg.Payload, &job)
 	if err != nil {
-		err = fmt.Errorf("failed to unmarshal parsepage payload: %w", err)
+		err = qaas.Permanent(fmt.Errorf("failed to unmarshal parsepage payload: %w", err))
 		span.RecordError(err)
 		return true, err
 	}
 
 	if job.Metadata == nil {
-		err = fmt.Errorf("failed to get siteJobID from job")
+		err = qaas.Permanent(fmt.Errorf("failed to get siteJobID from job"))
 		span.RecordError(err)
 		return true, err
 	}
 	siteJobID, ok := job.Metadata["siteJobID"]
 	if !ok || siteJobID == "" {
-		err = fmt.Errorf("failed to get siteJobID from job")
+		err = qaas.Permanent(fmt.Errorf("failed to get siteJobID from job"))
 		span.RecordError(err)
 		return true, err
 	}
@@ -60,11 +66,33 @@ func (h Handler) Handle(ctx context.Context, msg *pgq.MessageIncoming) (bool, er
 	slog.Debug("handled page job", "jon", job)
 	page := job.Payload
 	if page == nil {
-		err = fmt.Errorf("failed to get page from job")
+		err = qaas.Permanent(fmt.Errorf("failed to get page from job"))
 		span.RecordError(err)
 		return true, err
 	}
-	_, _, err = h.pageService.ParsePage(ctx, page, siteJobID.(string))
+	sourceID, _ := job.Metadata[qaas.SourceIDKey].(string)
+	generation := qaas.Generation(job.Metadata)
+	stale, err := qaas.IsStale(ctx, h.sourceStore, sourceID, generation)
+	if err != nil {
+		span.RecordError(err)
+		return true, err
+	}
+	if stale {
+		slog.Info("dropped page job of replaced generation or deleted source", "pageID", page.ID, "sourceID", sourceID)
+		return true, nil
+	}
+	// числа в метаданных после json.Unmarshal приходят как float64
+	depth, _ := job.Metadata["depth"].(float64)
+	task := site.PageTask{
+		SiteJobID: siteJobID.(string),
+		Depth:     int(depth),
+	}
+	if rawLastMod, ok := job.Metadata["lastMod"].(string); ok {
+		if lastMod, parseErr := time.Parse(time.RFC3339, rawLastMod); parseErr == nil {
+			task.LastMod = lastMod
+		}
+	}
+	outgoing, parsed, err := h.pageService.ParsePage(ctx, page, task)
 	if err != nil {
 		err = fmt.Errorf("failed to handle page job: %w", err)
 		span.RecordError(err,
@@ -73,21 +101,61 @@ func (h Handler) Handle(ctx context.Context, msg *pgq.MessageIncoming) (bool, er
 				attribute.String("pageID", page.ID),
 			),
 		)
-		return true, fmt.Errorf("failed to handle page job: %w", err)
+		if qaas.LastAttempt(ctx, msg) {
+			if saveErr := h.pageStore.SaveFailed(ctx, page, err.Error()); saveErr != nil {
+				slog.Error("failed to save failed page", "pageID", page.ID, "error", saveErr)
+			}
+		}
+		return true, err
+	}
+
+	// ссылки неизмененной страницы тоже обходятся, иначе страницы глубже нее будут удалены как исчезнувшие
+	if parsed {
+		publishOptions := []qaas.PublishOption{
+			qaas.WithQueue(qaas.ParsePageResultQueue),
+			qaas.WithSourceQueue(qaas.ParsePageQueue),
+		}
+		_, err = h.publisher.Publish(ctx, []any{qaas.PageResultJob{
+			Payload: page,
+			Delay:   0,
+			Metadata: map[string]any{
+				"siteJobID":        siteJobID,
+				qaas.SourceIDKey:   sourceID,
+				qaas.GenerationKey: generation,
+			},
+		}}, publishOptions...)
+		if err != nil {
+			err = fmt.Errorf("failed to publish result message for page: %w", err)
+			span.RecordError(err)
+			return true, err
+		}
+	} else {
+		slog.Debug("page is not changed", "pageID", page.ID, "reason", page.SkipReason)
 	}
 
-	publishOptions := []qaas.PublishOption{
-		qaas.WithQueue(qaas.ParsePageResultQueue),
-		qaas.WithSourceQueue(qaas.ParsePageQueue),
+	if len(outgoing) == 0 {
+		return true, nil
+	}
+	metadata := map[string]any{
+		"siteJobID":   siteJobID,
+		"externalKey": job.Metadata["externalKey"],
+		"depth":       int(depth) + 1,
+		// метка поколения передается найденным страницам
+		qaas.SourceIDKey:   sourceID,
+		qaas.GenerationKey: generation,
 	}
-	_, err = h.publisher.Publish(ctx, []any{qaas.PageResultJob{
-		Payload: page,
-		Delay:   0,
-	}}, publishOptions...)
+	pageJobs := lo.Map(outgoing, func(p *site.Page, _ int) any {
+		return qaas.PageJob{
+			Payload:  p,
+			Delay:    0,
+			Metadata: metadata,
+		}
+	})
+	_, err = h.publisher.Publish(ctx, pageJobs, qaas.WithQueue(qaas.ParsePageQueue))
 	if err != nil {
-		err = fmt.Errorf("failed to publish result message for page: %w", err)
+		err = fmt.Errorf("failed to publish outgoing pages: %w", err)
 		span.RecordError(err)
-		slog.Error("failed to publish result message for page", "err", err, "pageID", page.ID)
+		return true, err
 	}
 
 	return true, nil
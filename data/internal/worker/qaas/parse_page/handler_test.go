@@ -0,0 +1,99 @@
+package parse_page
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/larek-tech/diploma/data/internal/domain/site"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
+	"github.com/stretchr/testify/assert"
+	"go.dataddo.com/pgq"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type fakePageService struct {
+	outgoing []*site.Page
+	parsed   bool
+}
+
+func (s fakePageService) ParsePage(_ context.Context, _ *site.Page, _ site.PageTask) ([]*site.Page, bool, error) {
+	return s.outgoing, s.parsed, nil
+}
+
+type fakeSourceStore struct{}
+
+func (fakeSourceStore) TargetGeneration(_ context.Context, _ string) (int, error) {
+	return 1, nil
+}
+
+type fakePublisher struct {
+	published map[qaas.Queue][]any
+}
+
+func (p *fakePublisher) Publish(_ context.Context, rawMsg []any, opts ...qaas.PublishOption) ([]string, error) {
+	options := &qaas.PublishOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	p.published[options.Queue] = append(p.published[options.Queue], rawMsg...)
+	return nil, nil
+}
+
+func pageMessage(t *testing.T, page *site.Page) *pgq.MessageIncoming {
+	t.Helper()
+	payload, err := json.Marshal(qaas.PageJob{
+		Payload: page,
+		Metadata: map[string]any{
+			"siteJobID":        "job",
+			"depth":            0,
+			qaas.SourceIDKey:   "source",
+			qaas.GenerationKey: 1,
+		},
+	})
+	assert.NoError(t, err)
+	return &pgq.MessageIncoming{Payload: payload}
+}
+
+func TestHandle(t *testing.T) {
+	t.Parallel()
+
+	root := &site.Page{ID: "root", SiteID: "site", URL: "https://example.com/"}
+	outgoing := []*site.Page{
+		{ID: "a", SiteID: "site", URL: "https://example.com/a"},
+		{ID: "b", SiteID: "site", URL: "https://example.com/b"},
+	}
+
+	tests := []struct {
+		name    string
+		parsed  bool
+		results int
+	}{
+		{name: "changed page", parsed: true, results: 1},
+		// ответ 304: страница не индексируется заново, но ее ссылки обходятся
+		{name: "not modified page", parsed: false, results: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pub := &fakePublisher{published: make(map[qaas.Queue][]any)}
+			h := New(nil, fakePageService{outgoing: outgoing, parsed: tt.parsed}, fakeSourceStore{}, pub, noop.NewTracerProvider().Tracer("test"))
+
+			ack, err := h.Handle(context.Background(), pageMessage(t, root))
+			assert.NoError(t, err)
+			assert.True(t, ack)
+			assert.Len(t, pub.published[qaas.ParsePageResultQueue], tt.results)
+
+			jobs := pub.published[qaas.ParsePageQueue]
+			if assert.Len(t, jobs, len(outgoing)) {
+				for i, raw := range jobs {
+					job := raw.(qaas.PageJob)
+					assert.Equal(t, outgoing[i].URL, job.Payload.URL)
+					assert.Equal(t, 1, job.Metadata["depth"])
+					assert.Equal(t, 1, job.Metadata[qaas.GenerationKey])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package parse_s3
+
+import (
+	"context"
+
+	"github.com/larek-tech/diploma/data/internal/domain/object_store"
+	"github.com/larek-tech/diploma/data/internal/domain/object_store/service"
+)
+
+type (
+	syncService interface {
+		SyncBucket(ctx context.Context, store *object_store.ObjectStore, externalKey string, generation int) (service.SyncResult, error)
+	}
+	objectStorage interface {
+		GetStoreBySourceID(ctx context.Context, sourceID string) (*object_store.ObjectStore, error)
+	}
+	generationSwapper interface {
+		CompleteGeneration(ctx context.Context, sourceID string, generation int) (bool, error)
+	}
+	sourceStore interface {
+		TargetGeneration(ctx context.Context, sourceID string) (int, error)
+	}
+	kafkaProducer interface {
+		Produce(ctx context.Context, topic string, key []byte, value []byte) error
+	}
+)
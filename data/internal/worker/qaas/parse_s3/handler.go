@@ -0,0 +1,130 @@
+package parse_s3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/queue/messages"
+	"go.dataddo.com/pgq"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	resultTopic string = "status"
+)
+
+type Handler struct {
+	service           syncService
+	objectStorage     objectStorage
+	generationSwapper generationSwapper
+	sourceStore       sourceStore
+	kafkaProducer     kafkaProducer
+	tracer            trace.Tracer
+}
+
+func New(service syncService, objectStorage objectStorage, generationSwapper generationSwapper, sourceStore sourceStore, kafkaProducer kafkaProducer, tracer trace.Tracer) *Handler {
+	return &Handler{
+		service:           service,
+		objectStorage:     objectStorage,
+		generationSwapper: generationSwapper,
+		sourceStore:       sourceStore,
+		kafkaProducer:     kafkaProducer,
+		tracer:            tracer,
+	}
+}
+
+func (h Handler) Handle(ctx context.Context, msg *pgq.MessageIncoming) (bool, error) {
+	ctx, span := h.tracer.Start(ctx, "parse_s3.Handle")
+	defer span.End()
+
+	var job qaas.ParseS3Job
+	err := json.Unmarshal(msg.Payload, &job)
+	if err != nil {
+		err = qaas.Permanent(fmt.Errorf("failed to unmarshal parse s3 payload: %w", err))
+		span.RecordError(err)
+		return true, err
+	}
+	if job.Payload == nil {
+		return true, qaas.Permanent(fmt.Errorf("got empty parse s3 job"))
+	}
+	externalKey, _ := job.Metadata["externalKey"].(string)
+	jobID, _ := job.Metadata["jobID"].(string)
+	span.SetAttributes(attribute.String("sourceID", job.Payload.SourceID))
+
+	generation := qaas.Generation(job.Metadata)
+	stale, err := qaas.IsStale(ctx, h.sourceStore, job.Payload.SourceID, generation)
+	if err != nil {
+		span.RecordError(err)
+		return true, err
+	}
+	if stale {
+		slog.Info("dropped s3 job of replaced generation or deleted source", "sourceID", job.Payload.SourceID)
+		return true, nil
+	}
+
+	// конфигурация читается из базы, чтобы не передавать учетные данные через очередь
+	store, err := h.objectStorage.GetStoreBySourceID(ctx, job.Payload.SourceID)
+	if err != nil {
+		err = fmt.Errorf("failed to get object storage: %w", err)
+		span.RecordError(err)
+		return true, err
+	}
+	if store == nil {
+		return true, qaas.Permanent(fmt.Errorf("object storage for source %s not found", job.Payload.SourceID))
+	}
+
+	res, err := h.service.SyncBucket(ctx, store, externalKey, generation)
+	if err != nil {
+		err = fmt.Errorf("failed to sync bucket: %w", err)
+		span.RecordError(err)
+		if !qaas.LastAttempt(ctx, msg) {
+			return true, err
+		}
+		if produceErr := h.produceStatus(ctx, externalKey, messages.ParsingStatus{
+			SourceID: store.SourceID,
+			Status:   messages.StatusFailed,
+			JobID:    jobID,
+		}); produceErr != nil {
+			slog.Error("failed to produce s3 status", "sourceID", store.SourceID, "error", produceErr)
+		}
+		return true, err
+	}
+
+	// измененные объекты индексируются задачами file_parse, готовность сообщает embed_document
+	status := messages.StatusParsing
+	if res.Changed == 0 {
+		status = messages.StatusReady
+		if _, swapErr := h.generationSwapper.CompleteGeneration(ctx, store.SourceID, generation); swapErr != nil {
+			span.RecordError(swapErr)
+			slog.Error("failed to complete source generation", "sourceID", store.SourceID, "error", swapErr)
+		}
+	}
+	err = h.produceStatus(ctx, externalKey, messages.ParsingStatus{
+		SourceID:  store.SourceID,
+		Status:    status,
+		JobID:     jobID,
+		Processed: res.Changed,
+		Total:     res.Total,
+	})
+	if err != nil {
+		err = fmt.Errorf("failed to produce s3 status: %w", err)
+		span.RecordError(err)
+		slog.Error("failed to produce s3 status", "sourceID", store.SourceID, "error", err)
+	}
+	return true, nil
+}
+
+func (h Handler) produceStatus(ctx context.Context, externalKey string, status messages.ParsingStatus) error {
+	if externalKey == "" {
+		return fmt.Errorf("source %s has no external key", status.SourceID)
+	}
+	value, err := json.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload of ParsingStatus: %w", err)
+	}
+	return h.kafkaProducer.Produce(ctx, resultTopic, []byte(externalKey), value)
+}
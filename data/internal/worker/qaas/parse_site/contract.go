@@ -11,6 +11,12 @@ type (
 	siteStore interface {
 		Save(ctx context.Context, site *site.Site) error
 	}
+	siteJobStore interface {
+		RegisterURLs(ctx context.Context, siteID, parseSiteJobID string, urls []string) ([]string, error)
+	}
+	sourceStore interface {
+		TargetGeneration(ctx context.Context, sourceID string) (int, error)
+	}
 	publisher interface {
 		Publish(ctx context.Context, rawMsg []any, opts ...qaas.PublishOption) ([]string, error)
 	}
@@ -19,15 +19,21 @@ const (
 
 type Handler struct {
 	siteStore     siteStore
+	siteJobStore  siteJobStore
+	sourceStore   sourceStore
 	pagePublisher publisher
 }
 
 func New(
 	siteStore siteStore,
+	siteJobStore siteJobStore,
+	sourceStore sourceStore,
 	pagePublisher publisher,
 ) *Handler {
 	return &Handler{
 		siteStore:     siteStore,
+		siteJobStore:  siteJobStore,
+		sourceStore:   sourceStore,
 		pagePublisher: pagePublisher,
 	}
 }
@@ -37,36 +43,57 @@ func (h Handler) Handle(ctx context.Context, msg *pgq.MessageIncoming) (bool, er
 	var job qaas.SiteJob
 	err := json.Unmarshal(msg.Payload, &job)
 	if err != nil {
-		return true, fmt.Errorf("failed to unmarshal parsesite payload: %w", err)
+		return true, qaas.Permanent(fmt.Errorf("failed to unmarshal parsesite payload: %w", err))
 	}
 	if job.Metadata == nil {
-		return true, fmt.Errorf("failed to get siteJobID from job")
+		return true, qaas.Permanent(fmt.Errorf("failed to get siteJobID from job"))
 	}
 	siteJobID, ok := job.Metadata["siteJobID"]
 	if !ok || siteJobID == "" {
-		return true, fmt.Errorf("failed to get siteJobID from job")
+		return true, qaas.Permanent(fmt.Errorf("failed to get siteJobID from job"))
 	}
 	externalKey, ok := job.Metadata["externalKey"]
 	if !ok {
-		return true, fmt.Errorf("missing external key in Metadata")
+		return true, qaas.Permanent(fmt.Errorf("missing external key in Metadata"))
 	}
 
 	slog.Debug("handled site job", "job", job)
 	currentSite := job.Payload
+	generation := qaas.Generation(job.Metadata)
+	stale, err := qaas.IsStale(ctx, h.sourceStore, currentSite.SourceID, generation)
+	if err != nil {
+		return true, err
+	}
+	if stale {
+		slog.Info("dropped site job of replaced generation or deleted source", "siteJobID", siteJobID, "sourceID", currentSite.SourceID)
+		return true, nil
+	}
 
 	if err := h.siteStore.Save(ctx, currentSite); err != nil {
 		slog.Error("failed to save site", "site", currentSite, "error", err)
 		return true, err
 	}
-	metadata := map[string]any{
-		"siteJobID":   siteJobID,
-		"externalKey": externalKey.(string),
+	// стартовые страницы учитываются при дедупликации ссылок, найденных при обходе
+	seedURLs, err := h.siteJobStore.RegisterURLs(ctx, currentSite.ID, siteJobID.(string), currentSite.AvailablePages)
+	if err != nil {
+		slog.Error("failed to register seed urls", "site", currentSite.ID, "error", err)
+		return true, err
 	}
-	parseJobs := lo.Map(currentSite.AvailablePages, func(url string, _ int) any {
+	parseJobs := lo.Map(seedURLs, func(url string, _ int) any {
 		page, mapErr := site.NewPage(currentSite.ID, url)
 		if mapErr != nil {
 			slog.Error("failed to create page", "site", currentSite, "error", mapErr)
 		}
+		metadata := map[string]any{
+			"siteJobID":        siteJobID,
+			"externalKey":      externalKey.(string),
+			"depth":            0,
+			qaas.SourceIDKey:   currentSite.SourceID,
+			qaas.GenerationKey: generation,
+		}
+		if lastMod, ok := currentSite.PageLastMod[url]; ok {
+			metadata["lastMod"] = lastMod.Format(time.RFC3339)
+		}
 
 		return qaas.PageJob{
 			Payload:  page,
@@ -96,6 +123,7 @@ func (h Handler) Handle(ctx context.Context, msg *pgq.MessageIncoming) (bool, er
 		ParsePageJobsIDs: parsePageJobIDs,
 		Delay:            StatusDelay,
 		SiteJobID:        siteJobID.(string),
+		Generation:       &generation,
 	}
 
 	_, err = h.pagePublisher.Publish(ctx, []any{statusJob}, publishOptions...)
@@ -13,6 +13,13 @@ type (
 	pageJobStore interface {
 		GetProcessedPageCount(ctx context.Context, parseSiteJobID string) (int, error)
 		GetUnprocessedPageCount(ctx context.Context, parseSiteJobID string) (int, error)
+		GetUnprocessedResultCount(ctx context.Context, parseSiteJobID string) (int, error)
+	}
+	siteCleaner interface {
+		CleanupSite(ctx context.Context, siteID, siteJobID string) (int, error)
+	}
+	generationSwapper interface {
+		CompleteGeneration(ctx context.Context, sourceID string, generation int) (bool, error)
 	}
 
 	kafkaProducer interface {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/larek-tech/diploma/data/internal/infrastructure/ptr"
@@ -18,16 +19,20 @@ const (
 )
 
 type Handler struct {
-	publisher     publisher
-	pageJobStore  pageJobStore
-	kafkaProducer kafkaProducer
+	publisher         publisher
+	pageJobStore      pageJobStore
+	siteCleaner       siteCleaner
+	generationSwapper generationSwapper
+	kafkaProducer     kafkaProducer
 }
 
-func New(publisher publisher, pageJobStore pageJobStore, kafkaProducer kafkaProducer) *Handler {
+func New(publisher publisher, pageJobStore pageJobStore, siteCleaner siteCleaner, generationSwapper generationSwapper, kafkaProducer kafkaProducer) *Handler {
 	return &Handler{
-		pageJobStore:  pageJobStore,
-		publisher:     publisher,
-		kafkaProducer: kafkaProducer,
+		pageJobStore:      pageJobStore,
+		publisher:         publisher,
+		siteCleaner:       siteCleaner,
+		generationSwapper: generationSwapper,
+		kafkaProducer:     kafkaProducer,
 	}
 }
 
@@ -36,10 +41,10 @@ func (h Handler) Handle(ctx context.Context, queueMsg *pgq.MessageIncoming) (boo
 	var payload qaas.ParseStatusJob
 	err := json.Unmarshal(queueMsg.Payload, &payload)
 	if err != nil {
-		return true, fmt.Errorf("failed to unmarshal payload: %w", err)
+		return true, qaas.Permanent(fmt.Errorf("failed to unmarshal payload: %w", err))
 	}
 	if payload.ExternalKey == "" {
-		return true, fmt.Errorf("parse_site_status failed to receive ExternalKey from payload")
+		return true, qaas.Permanent(fmt.Errorf("parse_site_status failed to receive ExternalKey from payload"))
 	}
 
 	processed, err := h.pageJobStore.GetProcessedPageCount(ctx, payload.SiteJobID)
@@ -51,18 +56,39 @@ func (h Handler) Handle(ctx context.Context, queueMsg *pgq.MessageIncoming) (boo
 		return true, fmt.Errorf("failed to get unprocessed page count: %w", err)
 	}
 
+	// страницы обхода считаются готовыми только после индексации
+	unembedded, err := h.pageJobStore.GetUnprocessedResultCount(ctx, payload.SiteJobID)
+	if err != nil {
+		return true, fmt.Errorf("failed to get unprocessed result count: %w", err)
+	}
+
 	var status messages.SourceStatus
-	if processed > 0 && unprocessed == 0 {
+	if processed > 0 && unprocessed == 0 && unembedded == 0 {
 		status = messages.StatusReady
+		// обход завершен, страницы, исчезнувшие с сайта, больше не должны участвовать в поиске
+		deleted, cleanupErr := h.siteCleaner.CleanupSite(ctx, payload.SiteID, payload.SiteJobID)
+		if cleanupErr != nil {
+			slog.Error("failed to cleanup vanished pages", "siteID", payload.SiteID, "error", cleanupErr)
+		} else if deleted > 0 {
+			slog.Info("deleted vanished pages", "siteID", payload.SiteID, "count", deleted)
+		}
+		// после обновления источника поиск переключается на новое поколение
+		generation := qaas.UnknownGeneration
+		if payload.Generation != nil {
+			generation = *payload.Generation
+		}
+		if _, swapErr := h.generationSwapper.CompleteGeneration(ctx, payload.SourceID, generation); swapErr != nil {
+			slog.Error("failed to complete source generation", "sourceID", payload.SourceID, "error", swapErr)
+		}
 	}
-	if unprocessed != 0 {
+	if unprocessed != 0 || unembedded != 0 {
 		status = messages.StatusParsing
 	}
 
 	key, value, err := h.assembleMessage(payload.ExternalKey, messages.ParsingStatus{
 		SourceID:  payload.SourceID,
 		Status:    status,
-		JobID:     "",
+		JobID:     payload.SiteJobID,
 		Processed: processed,
 		Total:     processed + unprocessed,
 	})
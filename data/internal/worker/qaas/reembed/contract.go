@@ -0,0 +1,26 @@
+package reembed
+
+import (
+	"context"
+
+	"github.com/larek-tech/diploma/data/internal/domain/embedding"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
+)
+
+type (
+	modelStorage interface {
+		GetByID(ctx context.Context, id int) (*embedding.Model, error)
+		Activate(ctx context.Context, id int) error
+		Coverage(ctx context.Context, model *embedding.Model, sourceID string) (embedding.Coverage, error)
+		MissingChunks(ctx context.Context, model *embedding.Model, sourceID string, limit int) ([]embedding.Text, error)
+		MissingQuestions(ctx context.Context, model *embedding.Model, sourceID string, limit int) ([]embedding.Text, error)
+		SaveChunkVectors(ctx context.Context, model *embedding.Model, vectors []embedding.Vector) error
+		SaveQuestionVectors(ctx context.Context, model *embedding.Model, vectors []embedding.Vector) error
+	}
+	embedders interface {
+		Embed(ctx context.Context, modelID int, texts []embedding.Text) ([]embedding.Vector, error)
+	}
+	publisher interface {
+		Publish(ctx context.Context, rawMsg []any, opts ...qaas.PublishOption) ([]string, error)
+	}
+)
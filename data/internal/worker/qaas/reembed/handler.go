@@ -0,0 +1,141 @@
+package reembed
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/larek-tech/diploma/data/internal/domain/embedding"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
+	"go.dataddo.com/pgq"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// batchSize количество чанков и вопросов, векторы которых считаются одной задачей
+const batchSize = 256
+
+type Handler struct {
+	models    modelStorage
+	embedders embedders
+	publisher publisher
+	tracer    trace.Tracer
+}
+
+func New(models modelStorage, embedders embedders, publisher publisher, tracer trace.Tracer) *Handler {
+	return &Handler{
+		models:    models,
+		embedders: embedders,
+		publisher: publisher,
+		tracer:    tracer,
+	}
+}
+
+// Handle считает векторы очередной партии чанков и вопросов без векторов модели.
+// Прогресс хранится в таблицах векторов, поэтому после перезапуска или повтора задача продолжает с места остановки.
+func (h Handler) Handle(ctx context.Context, msg *pgq.MessageIncoming) (bool, error) {
+	ctx, span := h.tracer.Start(ctx, "reembed.Handle")
+	defer span.End()
+
+	var job qaas.ReembedJob
+	if err := json.Unmarshal(msg.Payload, &job); err != nil {
+		err = qaas.Permanent(fmt.Errorf("failed to unmarshal reembed payload: %w", err))
+		span.RecordError(err)
+		return true, err
+	}
+	span.SetAttributes(
+		attribute.Int("modelID", job.ModelID),
+		attribute.String("sourceID", job.SourceID),
+		attribute.Bool("cutOver", job.CutOver),
+	)
+
+	model, err := h.models.GetByID(ctx, job.ModelID)
+	if err != nil {
+		if errors.Is(err, embedding.ErrModelNotFound) {
+			err = qaas.Permanent(err)
+		}
+		span.RecordError(err)
+		return true, err
+	}
+
+	chunks, err := h.models.MissingChunks(ctx, model, job.SourceID, batchSize)
+	if err != nil {
+		span.RecordError(err)
+		return true, err
+	}
+	if err = h.embed(ctx, model, chunks, h.models.SaveChunkVectors); err != nil {
+		span.RecordError(err)
+		return true, fmt.Errorf("failed to reembed chunks: %w", err)
+	}
+	questions, err := h.models.MissingQuestions(ctx, model, job.SourceID, batchSize)
+	if err != nil {
+		span.RecordError(err)
+		return true, err
+	}
+	if err = h.embed(ctx, model, questions, h.models.SaveQuestionVectors); err != nil {
+		span.RecordError(err)
+		return true, fmt.Errorf("failed to reembed questions: %w", err)
+	}
+	if len(chunks) == batchSize || len(questions) == batchSize {
+		return true, h.next(ctx, job)
+	}
+
+	coverage, err := h.models.Coverage(ctx, model, "")
+	if err != nil {
+		span.RecordError(err)
+		return true, err
+	}
+	slog.Info("reembedding finished",
+		"model", model.Key(),
+		"sourceID", job.SourceID,
+		"chunks", coverage.EmbeddedChunks, "totalChunks", coverage.Chunks,
+		"questions", coverage.EmbeddedQuestions, "totalQuestions", coverage.Questions,
+	)
+	if !job.CutOver || model.Active {
+		return true, nil
+	}
+	if !coverage.Complete() {
+		if job.SourceID != "" {
+			// поиск общий для всех источников, переключение дождется пересчета остального корпуса
+			slog.Info("embedding model cutover waits for the rest of the corpus", "model", model.Key())
+			return true, nil
+		}
+		// за время пересчета появились новые чанки, они досчитываются следующей задачей
+		return true, h.next(ctx, job)
+	}
+	if err = h.models.Activate(ctx, model.ID); err != nil {
+		span.RecordError(err)
+		return true, fmt.Errorf("failed to activate embedding model: %w", err)
+	}
+	slog.Info("vector search switched to embedding model", "model", model.Key())
+	return true, nil
+}
+
+func (h Handler) embed(
+	ctx context.Context,
+	model *embedding.Model,
+	texts []embedding.Text,
+	save func(ctx context.Context, model *embedding.Model, vectors []embedding.Vector) error,
+) error {
+	if len(texts) == 0 {
+		return nil
+	}
+	vectors, err := h.embedders.Embed(ctx, model.ID, texts)
+	if err != nil {
+		if errors.Is(err, embedding.ErrModelNotConfigured) {
+			return qaas.Permanent(err)
+		}
+		return err
+	}
+	return save(ctx, model, vectors)
+}
+
+// next публикует продолжение пересчета
+func (h Handler) next(ctx context.Context, job qaas.ReembedJob) error {
+	if _, err := h.publisher.Publish(ctx, []any{job}, qaas.WithQueue(qaas.ReembedQueue)); err != nil {
+		return fmt.Errorf("failed to publish next reembed job: %w", err)
+	}
+	return nil
+}
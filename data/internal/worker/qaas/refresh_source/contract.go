@@ -0,0 +1,17 @@
+package refresh_source
+
+import (
+	"context"
+	"time"
+
+	"github.com/larek-tech/diploma/data/internal/domain/source"
+)
+
+type (
+	service interface {
+		RefreshSource(ctx context.Context, sourceID string, scheduledFor time.Time) (*source.Source, string, error)
+	}
+	kafkaProducer interface {
+		Produce(ctx context.Context, topic string, key []byte, value []byte) error
+	}
+)
@@ -0,0 +1,88 @@
+package refresh_source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/larek-tech/diploma/data/internal/domain/source"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/qaas"
+	"github.com/larek-tech/diploma/data/internal/infrastructure/queue/messages"
+	"go.dataddo.com/pgq"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	resultTopic string = "status"
+)
+
+type Handler struct {
+	service       service
+	kafkaProducer kafkaProducer
+	tracer        trace.Tracer
+}
+
+func New(service service, kafkaProducer kafkaProducer, tracer trace.Tracer) *Handler {
+	return &Handler{
+		service:       service,
+		kafkaProducer: kafkaProducer,
+		tracer:        tracer,
+	}
+}
+
+func (h Handler) Handle(ctx context.Context, msg *pgq.MessageIncoming) (bool, error) {
+	ctx, span := h.tracer.Start(ctx, "refresh_source.Handle")
+	defer span.End()
+
+	var job qaas.RefreshSourceJob
+	err := json.Unmarshal(msg.Payload, &job)
+	if err != nil {
+		err = qaas.Permanent(fmt.Errorf("failed to unmarshal refresh source payload: %w", err))
+		span.RecordError(err)
+		return true, err
+	}
+	span.SetAttributes(attribute.String("sourceID", job.SourceID))
+
+	src, jobID, err := h.service.RefreshSource(ctx, job.SourceID, job.ScheduledFor)
+	if err != nil {
+		err = fmt.Errorf("failed to refresh source: %w", err)
+		span.RecordError(err)
+		// статус FAILED отправляется только когда повторов больше не будет
+		if src != nil && qaas.LastAttempt(ctx, msg) {
+			if produceErr := h.produceStatus(ctx, src, jobID, messages.StatusFailed); produceErr != nil {
+				slog.Error("failed to produce refresh status", "sourceID", src.ID, "error", produceErr)
+			}
+		}
+		return true, err
+	}
+	if src == nil {
+		return true, nil
+	}
+	slog.Info("source refresh started", "sourceID", src.ID, "jobID", jobID, "next", src.NextRefreshAt)
+
+	err = h.produceStatus(ctx, src, jobID, messages.StatusParsing)
+	if err != nil {
+		err = fmt.Errorf("failed to produce refresh status: %w", err)
+		span.RecordError(err)
+		slog.Error("failed to produce refresh status", "sourceID", src.ID, "error", err)
+	}
+	return true, nil
+}
+
+func (h Handler) produceStatus(ctx context.Context, src *source.Source, jobID string, status messages.SourceStatus) error {
+	if src.ExternalKey == "" {
+		// без внешнего ключа сообщение не сопоставить с источником в domain
+		return fmt.Errorf("source %s has no external key", src.ID)
+	}
+	value, err := json.Marshal(messages.ParsingStatus{
+		SourceID: src.ID,
+		Status:   status,
+		JobID:    jobID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload of ParsingStatus: %w", err)
+	}
+	return h.kafkaProducer.Produce(ctx, resultTopic, []byte(src.ExternalKey), value)
+}
@@ -4,6 +4,7 @@ go 1.24.2
 
 require (
 	github.com/IBM/sarama v1.45.1
+	github.com/google/uuid v1.6.0
 	github.com/grpc-ecosystem/go-grpc-middleware/v2 v2.3.1
 	github.com/ilyakaznacheev/cleanenv v1.5.0
 	github.com/jackc/pgx/v5 v5.7.4
@@ -33,7 +34,6 @@ require (
 	github.com/go-playground/validator/v10 v10.26.0 // indirect
 	github.com/gofiber/fiber/v2 v2.52.6 // indirect
 	github.com/golang/snappy v1.0.0 // indirect
-	github.com/google/uuid v1.6.0 // indirect
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.26.3 // indirect
 	github.com/hashicorp/errwrap v1.1.0 // indirect
 	github.com/hashicorp/go-multierror v1.1.1 // indirect
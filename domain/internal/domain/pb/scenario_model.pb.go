@@ -221,6 +221,7 @@ type UpdateScenarioRequest struct {
 	SearchByQuery     *bool                  `protobuf:"varint,16,opt,name=searchByQuery,proto3,oneof" json:"searchByQuery,omitempty"`
 	Title             string                 `protobuf:"bytes,17,opt,name=title,proto3" json:"title,omitempty"`
 	DomainId          int64                  `protobuf:"varint,18,opt,name=domainId,proto3" json:"domainId,omitempty"`
+	Filter            *SearchFilter          `protobuf:"bytes,19,opt,name=filter,proto3,oneof" json:"filter,omitempty"` // Фильтры векторного поиска по умолчанию
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -381,6 +382,13 @@ func (x *UpdateScenarioRequest) GetDomainId() int64 {
 	return 0
 }
 
+func (x *UpdateScenarioRequest) GetFilter() *SearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 type DeleteScenarioRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ScenarioId    int64                  `protobuf:"varint,1,opt,name=scenarioId,proto3" json:"scenarioId,omitempty"`
@@ -604,7 +612,7 @@ const file_domain_v1_scenario_model_proto_rawDesc = "" +
 	"scenarioId\x18\x01 \x01(\x03R\n" +
 	"scenarioId\"?\n" +
 	"\x19GetDefaultScenarioRequest\x12\"\n" +
-	"\fdefaultTitle\x18\x01 \x01(\tR\fdefaultTitle\"\x8a\a\n" +
+	"\fdefaultTitle\x18\x01 \x01(\tR\fdefaultTitle\"\xc7\a\n" +
 	"\x15UpdateScenarioRequest\x12\x1e\n" +
 	"\n" +
 	"scenarioId\x18\x01 \x01(\x03R\n" +
@@ -627,7 +635,8 @@ const file_domain_v1_scenario_model_proto_rawDesc = "" +
 	"\tthreshold\x18\x0f \x01(\x02H\rR\tthreshold\x88\x01\x01\x12)\n" +
 	"\rsearchByQuery\x18\x10 \x01(\bH\x0eR\rsearchByQuery\x88\x01\x01\x12\x14\n" +
 	"\x05title\x18\x11 \x01(\tR\x05title\x12\x1a\n" +
-	"\bdomainId\x18\x12 \x01(\x03R\bdomainIdB\x10\n" +
+	"\bdomainId\x18\x12 \x01(\x03R\bdomainId\x120\n" +
+	"\x06filter\x18\x13 \x01(\v2\x13.pb.ml.SearchFilterH\x0fR\x06filter\x88\x01\x01B\x10\n" +
 	"\x0e_useMultiqueryB\v\n" +
 	"\t_nQueriesB\x11\n" +
 	"\x0f_queryModelNameB\f\n" +
@@ -646,7 +655,8 @@ const file_domain_v1_scenario_model_proto_rawDesc = "" +
 	"\x05_topNB\f\n" +
 	"\n" +
 	"_thresholdB\x10\n" +
-	"\x0e_searchByQuery\"7\n" +
+	"\x0e_searchByQueryB\t\n" +
+	"\a_filter\"7\n" +
 	"\x15DeleteScenarioRequest\x12\x1e\n" +
 	"\n" +
 	"scenarioId\x18\x01 \x01(\x03R\n" +
@@ -687,19 +697,21 @@ var file_domain_v1_scenario_model_proto_goTypes = []any{
 	(*Reranker)(nil),                     // 9: pb.ml.Reranker
 	(*VectorSearch)(nil),                 // 10: pb.ml.VectorSearch
 	(*LlmModel)(nil),                     // 11: pb.ml.LlmModel
-	(*Scenario)(nil),                     // 12: pb.ml.Scenario
+	(*SearchFilter)(nil),                 // 12: pb.ml.SearchFilter
+	(*Scenario)(nil),                     // 13: pb.ml.Scenario
 }
 var file_domain_v1_scenario_model_proto_depIdxs = []int32{
 	8,  // 0: domain.v1.CreateScenarioRequest.multiQuery:type_name -> pb.ml.MultiQuery
 	9,  // 1: domain.v1.CreateScenarioRequest.reranker:type_name -> pb.ml.Reranker
 	10, // 2: domain.v1.CreateScenarioRequest.vectorSearch:type_name -> pb.ml.VectorSearch
 	11, // 3: domain.v1.CreateScenarioRequest.model:type_name -> pb.ml.LlmModel
-	12, // 4: domain.v1.ListScenariosResponse.scenarios:type_name -> pb.ml.Scenario
-	5,  // [5:5] is the sub-list for method output_type
-	5,  // [5:5] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	12, // 4: domain.v1.UpdateScenarioRequest.filter:type_name -> pb.ml.SearchFilter
+	13, // 5: domain.v1.ListScenariosResponse.scenarios:type_name -> pb.ml.Scenario
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_domain_v1_scenario_model_proto_init() }
@@ -129,6 +129,104 @@ func (SourceStatus) EnumDescriptor() ([]byte, []int) {
 	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{1}
 }
 
+type ChunkingStrategy int32
+
+const (
+	ChunkingStrategy_CHUNKING_UNDEFINED ChunkingStrategy = 0
+	ChunkingStrategy_CHUNKING_RECURSIVE ChunkingStrategy = 1
+	ChunkingStrategy_CHUNKING_MARKDOWN  ChunkingStrategy = 2
+)
+
+// Enum value maps for ChunkingStrategy.
+var (
+	ChunkingStrategy_name = map[int32]string{
+		0: "CHUNKING_UNDEFINED",
+		1: "CHUNKING_RECURSIVE",
+		2: "CHUNKING_MARKDOWN",
+	}
+	ChunkingStrategy_value = map[string]int32{
+		"CHUNKING_UNDEFINED": 0,
+		"CHUNKING_RECURSIVE": 1,
+		"CHUNKING_MARKDOWN":  2,
+	}
+)
+
+func (x ChunkingStrategy) Enum() *ChunkingStrategy {
+	p := new(ChunkingStrategy)
+	*p = x
+	return p
+}
+
+func (x ChunkingStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChunkingStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_domain_v1_source_model_proto_enumTypes[2].Descriptor()
+}
+
+func (ChunkingStrategy) Type() protoreflect.EnumType {
+	return &file_domain_v1_source_model_proto_enumTypes[2]
+}
+
+func (x ChunkingStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChunkingStrategy.Descriptor instead.
+func (ChunkingStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{2}
+}
+
+type ChunkSizeUnit int32
+
+const (
+	ChunkSizeUnit_UNIT_UNDEFINED ChunkSizeUnit = 0
+	ChunkSizeUnit_UNIT_CHARS     ChunkSizeUnit = 1
+	ChunkSizeUnit_UNIT_TOKENS    ChunkSizeUnit = 2
+)
+
+// Enum value maps for ChunkSizeUnit.
+var (
+	ChunkSizeUnit_name = map[int32]string{
+		0: "UNIT_UNDEFINED",
+		1: "UNIT_CHARS",
+		2: "UNIT_TOKENS",
+	}
+	ChunkSizeUnit_value = map[string]int32{
+		"UNIT_UNDEFINED": 0,
+		"UNIT_CHARS":     1,
+		"UNIT_TOKENS":    2,
+	}
+)
+
+func (x ChunkSizeUnit) Enum() *ChunkSizeUnit {
+	p := new(ChunkSizeUnit)
+	*p = x
+	return p
+}
+
+func (x ChunkSizeUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChunkSizeUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_domain_v1_source_model_proto_enumTypes[3].Descriptor()
+}
+
+func (ChunkSizeUnit) Type() protoreflect.EnumType {
+	return &file_domain_v1_source_model_proto_enumTypes[3]
+}
+
+func (x ChunkSizeUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChunkSizeUnit.Descriptor instead.
+func (ChunkSizeUnit) EnumDescriptor() ([]byte, []int) {
+	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{3}
+}
+
 type CronFormat struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Minute        int32                  `protobuf:"varint,1,opt,name=minute,proto3" json:"minute,omitempty"`
@@ -205,6 +303,74 @@ func (x *CronFormat) GetDayOfWeek() int32 {
 	return 0
 }
 
+type ChunkingParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Strategy      ChunkingStrategy       `protobuf:"varint,1,opt,name=strategy,proto3,enum=domain.v1.ChunkingStrategy" json:"strategy,omitempty"`
+	Unit          ChunkSizeUnit          `protobuf:"varint,2,opt,name=unit,proto3,enum=domain.v1.ChunkSizeUnit" json:"unit,omitempty"`
+	ChunkSize     int32                  `protobuf:"varint,3,opt,name=chunkSize,proto3" json:"chunkSize,omitempty"`
+	ChunkOverlap  int32                  `protobuf:"varint,4,opt,name=chunkOverlap,proto3" json:"chunkOverlap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChunkingParams) Reset() {
+	*x = ChunkingParams{}
+	mi := &file_domain_v1_source_model_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkingParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkingParams) ProtoMessage() {}
+
+func (x *ChunkingParams) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_v1_source_model_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkingParams.ProtoReflect.Descriptor instead.
+func (*ChunkingParams) Descriptor() ([]byte, []int) {
+	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChunkingParams) GetStrategy() ChunkingStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return ChunkingStrategy_CHUNKING_UNDEFINED
+}
+
+func (x *ChunkingParams) GetUnit() ChunkSizeUnit {
+	if x != nil {
+		return x.Unit
+	}
+	return ChunkSizeUnit_UNIT_UNDEFINED
+}
+
+func (x *ChunkingParams) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *ChunkingParams) GetChunkOverlap() int32 {
+	if x != nil {
+		return x.ChunkOverlap
+	}
+	return 0
+}
+
 type UpdateParams struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EveryPeriod   *int64                 `protobuf:"varint,1,opt,name=everyPeriod,proto3,oneof" json:"everyPeriod,omitempty"`
@@ -215,7 +381,7 @@ type UpdateParams struct {
 
 func (x *UpdateParams) Reset() {
 	*x = UpdateParams{}
-	mi := &file_domain_v1_source_model_proto_msgTypes[1]
+	mi := &file_domain_v1_source_model_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -227,7 +393,7 @@ func (x *UpdateParams) String() string {
 func (*UpdateParams) ProtoMessage() {}
 
 func (x *UpdateParams) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_source_model_proto_msgTypes[1]
+	mi := &file_domain_v1_source_model_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -240,7 +406,7 @@ func (x *UpdateParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateParams.ProtoReflect.Descriptor instead.
 func (*UpdateParams) Descriptor() ([]byte, []int) {
-	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{1}
+	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *UpdateParams) GetEveryPeriod() int64 {
@@ -275,7 +441,7 @@ type Source struct {
 
 func (x *Source) Reset() {
 	*x = Source{}
-	mi := &file_domain_v1_source_model_proto_msgTypes[2]
+	mi := &file_domain_v1_source_model_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -287,7 +453,7 @@ func (x *Source) String() string {
 func (*Source) ProtoMessage() {}
 
 func (x *Source) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_source_model_proto_msgTypes[2]
+	mi := &file_domain_v1_source_model_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,7 +466,7 @@ func (x *Source) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Source.ProtoReflect.Descriptor instead.
 func (*Source) Descriptor() ([]byte, []int) {
-	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{2}
+	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Source) GetId() int64 {
@@ -380,13 +546,14 @@ type CreateSourceRequest struct {
 	Typ           SourceType             `protobuf:"varint,3,opt,name=typ,proto3,enum=domain.v1.SourceType" json:"typ,omitempty"`
 	UpdateParams  *UpdateParams          `protobuf:"bytes,4,opt,name=updateParams,proto3,oneof" json:"updateParams,omitempty"`
 	Credentials   []byte                 `protobuf:"bytes,5,opt,name=credentials,proto3,oneof" json:"credentials,omitempty"`
+	Chunking      *ChunkingParams        `protobuf:"bytes,6,opt,name=chunking,proto3,oneof" json:"chunking,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateSourceRequest) Reset() {
 	*x = CreateSourceRequest{}
-	mi := &file_domain_v1_source_model_proto_msgTypes[3]
+	mi := &file_domain_v1_source_model_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -398,7 +565,7 @@ func (x *CreateSourceRequest) String() string {
 func (*CreateSourceRequest) ProtoMessage() {}
 
 func (x *CreateSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_source_model_proto_msgTypes[3]
+	mi := &file_domain_v1_source_model_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +578,7 @@ func (x *CreateSourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSourceRequest.ProtoReflect.Descriptor instead.
 func (*CreateSourceRequest) Descriptor() ([]byte, []int) {
-	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{3}
+	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateSourceRequest) GetTitle() string {
@@ -449,6 +616,13 @@ func (x *CreateSourceRequest) GetCredentials() []byte {
 	return nil
 }
 
+func (x *CreateSourceRequest) GetChunking() *ChunkingParams {
+	if x != nil {
+		return x.Chunking
+	}
+	return nil
+}
+
 type GetSourceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SourceId      int64                  `protobuf:"varint,1,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
@@ -458,7 +632,7 @@ type GetSourceRequest struct {
 
 func (x *GetSourceRequest) Reset() {
 	*x = GetSourceRequest{}
-	mi := &file_domain_v1_source_model_proto_msgTypes[4]
+	mi := &file_domain_v1_source_model_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -470,7 +644,7 @@ func (x *GetSourceRequest) String() string {
 func (*GetSourceRequest) ProtoMessage() {}
 
 func (x *GetSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_source_model_proto_msgTypes[4]
+	mi := &file_domain_v1_source_model_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -483,7 +657,7 @@ func (x *GetSourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSourceRequest.ProtoReflect.Descriptor instead.
 func (*GetSourceRequest) Descriptor() ([]byte, []int) {
-	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{4}
+	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetSourceRequest) GetSourceId() int64 {
@@ -502,7 +676,7 @@ type GetSourceIDsRequest struct {
 
 func (x *GetSourceIDsRequest) Reset() {
 	*x = GetSourceIDsRequest{}
-	mi := &file_domain_v1_source_model_proto_msgTypes[5]
+	mi := &file_domain_v1_source_model_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -514,7 +688,7 @@ func (x *GetSourceIDsRequest) String() string {
 func (*GetSourceIDsRequest) ProtoMessage() {}
 
 func (x *GetSourceIDsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_source_model_proto_msgTypes[5]
+	mi := &file_domain_v1_source_model_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -527,7 +701,7 @@ func (x *GetSourceIDsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSourceIDsRequest.ProtoReflect.Descriptor instead.
 func (*GetSourceIDsRequest) Descriptor() ([]byte, []int) {
-	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{5}
+	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetSourceIDsRequest) GetSourceIds() []int64 {
@@ -546,7 +720,7 @@ type GetSourceIDsResponse struct {
 
 func (x *GetSourceIDsResponse) Reset() {
 	*x = GetSourceIDsResponse{}
-	mi := &file_domain_v1_source_model_proto_msgTypes[6]
+	mi := &file_domain_v1_source_model_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -558,7 +732,7 @@ func (x *GetSourceIDsResponse) String() string {
 func (*GetSourceIDsResponse) ProtoMessage() {}
 
 func (x *GetSourceIDsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_source_model_proto_msgTypes[6]
+	mi := &file_domain_v1_source_model_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -571,7 +745,7 @@ func (x *GetSourceIDsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSourceIDsResponse.ProtoReflect.Descriptor instead.
 func (*GetSourceIDsResponse) Descriptor() ([]byte, []int) {
-	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{6}
+	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetSourceIDsResponse) GetSourceIds() []string {
@@ -594,7 +768,7 @@ type UpdateSourceRequest struct {
 
 func (x *UpdateSourceRequest) Reset() {
 	*x = UpdateSourceRequest{}
-	mi := &file_domain_v1_source_model_proto_msgTypes[7]
+	mi := &file_domain_v1_source_model_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -606,7 +780,7 @@ func (x *UpdateSourceRequest) String() string {
 func (*UpdateSourceRequest) ProtoMessage() {}
 
 func (x *UpdateSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_source_model_proto_msgTypes[7]
+	mi := &file_domain_v1_source_model_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -619,7 +793,7 @@ func (x *UpdateSourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSourceRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSourceRequest) Descriptor() ([]byte, []int) {
-	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{7}
+	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateSourceRequest) GetSourceId() int64 {
@@ -666,7 +840,7 @@ type DeleteSourceRequest struct {
 
 func (x *DeleteSourceRequest) Reset() {
 	*x = DeleteSourceRequest{}
-	mi := &file_domain_v1_source_model_proto_msgTypes[8]
+	mi := &file_domain_v1_source_model_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -678,7 +852,7 @@ func (x *DeleteSourceRequest) String() string {
 func (*DeleteSourceRequest) ProtoMessage() {}
 
 func (x *DeleteSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_source_model_proto_msgTypes[8]
+	mi := &file_domain_v1_source_model_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,7 +865,7 @@ func (x *DeleteSourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSourceRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSourceRequest) Descriptor() ([]byte, []int) {
-	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{8}
+	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteSourceRequest) GetSourceId() int64 {
@@ -711,7 +885,7 @@ type ListSourcesRequest struct {
 
 func (x *ListSourcesRequest) Reset() {
 	*x = ListSourcesRequest{}
-	mi := &file_domain_v1_source_model_proto_msgTypes[9]
+	mi := &file_domain_v1_source_model_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -723,7 +897,7 @@ func (x *ListSourcesRequest) String() string {
 func (*ListSourcesRequest) ProtoMessage() {}
 
 func (x *ListSourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_source_model_proto_msgTypes[9]
+	mi := &file_domain_v1_source_model_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -736,7 +910,7 @@ func (x *ListSourcesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSourcesRequest.ProtoReflect.Descriptor instead.
 func (*ListSourcesRequest) Descriptor() ([]byte, []int) {
-	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{9}
+	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListSourcesRequest) GetOffset() uint64 {
@@ -764,7 +938,7 @@ type ListSourcesByDomainRequest struct {
 
 func (x *ListSourcesByDomainRequest) Reset() {
 	*x = ListSourcesByDomainRequest{}
-	mi := &file_domain_v1_source_model_proto_msgTypes[10]
+	mi := &file_domain_v1_source_model_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -776,7 +950,7 @@ func (x *ListSourcesByDomainRequest) String() string {
 func (*ListSourcesByDomainRequest) ProtoMessage() {}
 
 func (x *ListSourcesByDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_source_model_proto_msgTypes[10]
+	mi := &file_domain_v1_source_model_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -789,7 +963,7 @@ func (x *ListSourcesByDomainRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSourcesByDomainRequest.ProtoReflect.Descriptor instead.
 func (*ListSourcesByDomainRequest) Descriptor() ([]byte, []int) {
-	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{10}
+	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListSourcesByDomainRequest) GetDomainId() int64 {
@@ -822,7 +996,7 @@ type ListSourcesResponse struct {
 
 func (x *ListSourcesResponse) Reset() {
 	*x = ListSourcesResponse{}
-	mi := &file_domain_v1_source_model_proto_msgTypes[11]
+	mi := &file_domain_v1_source_model_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -834,7 +1008,7 @@ func (x *ListSourcesResponse) String() string {
 func (*ListSourcesResponse) ProtoMessage() {}
 
 func (x *ListSourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_v1_source_model_proto_msgTypes[11]
+	mi := &file_domain_v1_source_model_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -847,7 +1021,7 @@ func (x *ListSourcesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSourcesResponse.ProtoReflect.Descriptor instead.
 func (*ListSourcesResponse) Descriptor() ([]byte, []int) {
-	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{11}
+	return file_domain_v1_source_model_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListSourcesResponse) GetSources() []*Source {
@@ -870,7 +1044,12 @@ const file_domain_v1_source_model_proto_rawDesc = "" +
 	"dayOfMonth\x18\x03 \x01(\x05R\n" +
 	"dayOfMonth\x12\x14\n" +
 	"\x05month\x18\x04 \x01(\x05R\x05month\x12\x1c\n" +
-	"\tdayOfWeek\x18\x05 \x01(\x05R\tdayOfWeek\"~\n" +
+	"\tdayOfWeek\x18\x05 \x01(\x05R\tdayOfWeek\"\xb9\x01\n" +
+	"\x0eChunkingParams\x127\n" +
+	"\bstrategy\x18\x01 \x01(\x0e2\x1b.domain.v1.ChunkingStrategyR\bstrategy\x12,\n" +
+	"\x04unit\x18\x02 \x01(\x0e2\x18.domain.v1.ChunkSizeUnitR\x04unit\x12\x1c\n" +
+	"\tchunkSize\x18\x03 \x01(\x05R\tchunkSize\x12\"\n" +
+	"\fchunkOverlap\x18\x04 \x01(\x05R\fchunkOverlap\"~\n" +
 	"\fUpdateParams\x12%\n" +
 	"\veveryPeriod\x18\x01 \x01(\x03H\x00R\veveryPeriod\x88\x01\x01\x12.\n" +
 	"\x04cron\x18\x02 \x01(\v2\x15.domain.v1.CronFormatH\x01R\x04cron\x88\x01\x01B\x0e\n" +
@@ -889,15 +1068,17 @@ const file_domain_v1_source_model_proto_rawDesc = "" +
 	"\tupdatedAt\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0f\n" +
 	"\r_updateParamsB\x0e\n" +
-	"\f_credentials\"\xf8\x01\n" +
+	"\f_credentials\"\xc1\x02\n" +
 	"\x13CreateSourceRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\fR\acontent\x12'\n" +
 	"\x03typ\x18\x03 \x01(\x0e2\x15.domain.v1.SourceTypeR\x03typ\x12@\n" +
 	"\fupdateParams\x18\x04 \x01(\v2\x17.domain.v1.UpdateParamsH\x00R\fupdateParams\x88\x01\x01\x12%\n" +
-	"\vcredentials\x18\x05 \x01(\fH\x01R\vcredentials\x88\x01\x01B\x0f\n" +
+	"\vcredentials\x18\x05 \x01(\fH\x01R\vcredentials\x88\x01\x01\x12:\n" +
+	"\bchunking\x18\x06 \x01(\v2\x19.domain.v1.ChunkingParamsH\x02R\bchunking\x88\x01\x01B\x0f\n" +
 	"\r_updateParamsB\x0e\n" +
-	"\f_credentials\".\n" +
+	"\f_credentialsB\v\n" +
+	"\t_chunking\".\n" +
 	"\x10GetSourceRequest\x12\x1a\n" +
 	"\bsourceId\x18\x01 \x01(\x03R\bsourceId\"3\n" +
 	"\x13GetSourceIDsRequest\x12\x1c\n" +
@@ -937,7 +1118,16 @@ const file_domain_v1_source_model_proto_rawDesc = "" +
 	"\x10STATUS_UNDEFINED\x10\x00\x12\x10\n" +
 	"\fSTATUS_READY\x10\x01\x12\x12\n" +
 	"\x0eSTATUS_PARSING\x10\x02\x12\x11\n" +
-	"\rSTATUS_FAILED\x10\x03B\x14Z\x12internal/domain/pbb\x06proto3"
+	"\rSTATUS_FAILED\x10\x03*Y\n" +
+	"\x10ChunkingStrategy\x12\x16\n" +
+	"\x12CHUNKING_UNDEFINED\x10\x00\x12\x16\n" +
+	"\x12CHUNKING_RECURSIVE\x10\x01\x12\x15\n" +
+	"\x11CHUNKING_MARKDOWN\x10\x02*D\n" +
+	"\rChunkSizeUnit\x12\x12\n" +
+	"\x0eUNIT_UNDEFINED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"UNIT_CHARS\x10\x01\x12\x0f\n" +
+	"\vUNIT_TOKENS\x10\x02B\x14Z\x12internal/domain/pbb\x06proto3"
 
 var (
 	file_domain_v1_source_model_proto_rawDescOnce sync.Once
@@ -951,41 +1141,47 @@ func file_domain_v1_source_model_proto_rawDescGZIP() []byte {
 	return file_domain_v1_source_model_proto_rawDescData
 }
 
-var file_domain_v1_source_model_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_domain_v1_source_model_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_domain_v1_source_model_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_domain_v1_source_model_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_domain_v1_source_model_proto_goTypes = []any{
 	(SourceType)(0),                    // 0: domain.v1.SourceType
 	(SourceStatus)(0),                  // 1: domain.v1.SourceStatus
-	(*CronFormat)(nil),                 // 2: domain.v1.CronFormat
-	(*UpdateParams)(nil),               // 3: domain.v1.UpdateParams
-	(*Source)(nil),                     // 4: domain.v1.Source
-	(*CreateSourceRequest)(nil),        // 5: domain.v1.CreateSourceRequest
-	(*GetSourceRequest)(nil),           // 6: domain.v1.GetSourceRequest
-	(*GetSourceIDsRequest)(nil),        // 7: domain.v1.GetSourceIDsRequest
-	(*GetSourceIDsResponse)(nil),       // 8: domain.v1.GetSourceIDsResponse
-	(*UpdateSourceRequest)(nil),        // 9: domain.v1.UpdateSourceRequest
-	(*DeleteSourceRequest)(nil),        // 10: domain.v1.DeleteSourceRequest
-	(*ListSourcesRequest)(nil),         // 11: domain.v1.ListSourcesRequest
-	(*ListSourcesByDomainRequest)(nil), // 12: domain.v1.ListSourcesByDomainRequest
-	(*ListSourcesResponse)(nil),        // 13: domain.v1.ListSourcesResponse
-	(*timestamppb.Timestamp)(nil),      // 14: google.protobuf.Timestamp
+	(ChunkingStrategy)(0),              // 2: domain.v1.ChunkingStrategy
+	(ChunkSizeUnit)(0),                 // 3: domain.v1.ChunkSizeUnit
+	(*CronFormat)(nil),                 // 4: domain.v1.CronFormat
+	(*ChunkingParams)(nil),             // 5: domain.v1.ChunkingParams
+	(*UpdateParams)(nil),               // 6: domain.v1.UpdateParams
+	(*Source)(nil),                     // 7: domain.v1.Source
+	(*CreateSourceRequest)(nil),        // 8: domain.v1.CreateSourceRequest
+	(*GetSourceRequest)(nil),           // 9: domain.v1.GetSourceRequest
+	(*GetSourceIDsRequest)(nil),        // 10: domain.v1.GetSourceIDsRequest
+	(*GetSourceIDsResponse)(nil),       // 11: domain.v1.GetSourceIDsResponse
+	(*UpdateSourceRequest)(nil),        // 12: domain.v1.UpdateSourceRequest
+	(*DeleteSourceRequest)(nil),        // 13: domain.v1.DeleteSourceRequest
+	(*ListSourcesRequest)(nil),         // 14: domain.v1.ListSourcesRequest
+	(*ListSourcesByDomainRequest)(nil), // 15: domain.v1.ListSourcesByDomainRequest
+	(*ListSourcesResponse)(nil),        // 16: domain.v1.ListSourcesResponse
+	(*timestamppb.Timestamp)(nil),      // 17: google.protobuf.Timestamp
 }
 var file_domain_v1_source_model_proto_depIdxs = []int32{
-	2,  // 0: domain.v1.UpdateParams.cron:type_name -> domain.v1.CronFormat
-	0,  // 1: domain.v1.Source.typ:type_name -> domain.v1.SourceType
-	3,  // 2: domain.v1.Source.updateParams:type_name -> domain.v1.UpdateParams
-	1,  // 3: domain.v1.Source.status:type_name -> domain.v1.SourceStatus
-	14, // 4: domain.v1.Source.createdAt:type_name -> google.protobuf.Timestamp
-	14, // 5: domain.v1.Source.updatedAt:type_name -> google.protobuf.Timestamp
-	0,  // 6: domain.v1.CreateSourceRequest.typ:type_name -> domain.v1.SourceType
-	3,  // 7: domain.v1.CreateSourceRequest.updateParams:type_name -> domain.v1.UpdateParams
-	3,  // 8: domain.v1.UpdateSourceRequest.updateParams:type_name -> domain.v1.UpdateParams
-	4,  // 9: domain.v1.ListSourcesResponse.sources:type_name -> domain.v1.Source
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	2,  // 0: domain.v1.ChunkingParams.strategy:type_name -> domain.v1.ChunkingStrategy
+	3,  // 1: domain.v1.ChunkingParams.unit:type_name -> domain.v1.ChunkSizeUnit
+	4,  // 2: domain.v1.UpdateParams.cron:type_name -> domain.v1.CronFormat
+	0,  // 3: domain.v1.Source.typ:type_name -> domain.v1.SourceType
+	6,  // 4: domain.v1.Source.updateParams:type_name -> domain.v1.UpdateParams
+	1,  // 5: domain.v1.Source.status:type_name -> domain.v1.SourceStatus
+	17, // 6: domain.v1.Source.createdAt:type_name -> google.protobuf.Timestamp
+	17, // 7: domain.v1.Source.updatedAt:type_name -> google.protobuf.Timestamp
+	0,  // 8: domain.v1.CreateSourceRequest.typ:type_name -> domain.v1.SourceType
+	6,  // 9: domain.v1.CreateSourceRequest.updateParams:type_name -> domain.v1.UpdateParams
+	5,  // 10: domain.v1.CreateSourceRequest.chunking:type_name -> domain.v1.ChunkingParams
+	6,  // 11: domain.v1.UpdateSourceRequest.updateParams:type_name -> domain.v1.UpdateParams
+	7,  // 12: domain.v1.ListSourcesResponse.sources:type_name -> domain.v1.Source
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_domain_v1_source_model_proto_init() }
@@ -993,17 +1189,17 @@ func file_domain_v1_source_model_proto_init() {
 	if File_domain_v1_source_model_proto != nil {
 		return
 	}
-	file_domain_v1_source_model_proto_msgTypes[1].OneofWrappers = []any{}
 	file_domain_v1_source_model_proto_msgTypes[2].OneofWrappers = []any{}
 	file_domain_v1_source_model_proto_msgTypes[3].OneofWrappers = []any{}
-	file_domain_v1_source_model_proto_msgTypes[7].OneofWrappers = []any{}
+	file_domain_v1_source_model_proto_msgTypes[4].OneofWrappers = []any{}
+	file_domain_v1_source_model_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_domain_v1_source_model_proto_rawDesc), len(file_domain_v1_source_model_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   12,
+			NumEnums:      4,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
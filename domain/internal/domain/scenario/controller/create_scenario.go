@@ -53,6 +53,10 @@ func (ctrl *Controller) CreateScenario(ctx context.Context, req *pb.CreateScenar
 		UpdatedAt:         time.Now(),
 	}
 
+	if err := scenario.SetSearchFilter(vectorSearch.GetFilter()); err != nil {
+		return nil, errs.WrapErr(err)
+	}
+
 	scenarioID, err := ctrl.sr.InsertScenario(ctx, scenario)
 	if err != nil {
 		return nil, errs.WrapErr(err)
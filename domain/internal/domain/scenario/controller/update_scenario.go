@@ -45,6 +45,9 @@ func (ctrl *Controller) UpdateScenario(ctx context.Context, req *pb.UpdateScenar
 	scenario.Threshold = req.GetThreshold()
 	scenario.SearchByQuery = req.GetSearchByQuery()
 	scenario.UpdatedAt = time.Now()
+	if err = scenario.SetSearchFilter(req.GetFilter()); err != nil {
+		return nil, errs.WrapErr(err)
+	}
 
 	if err = ctrl.sr.UpdateScenario(ctx, scenario, meta.GetUserId()); err != nil {
 		return nil, errs.WrapErr(err)
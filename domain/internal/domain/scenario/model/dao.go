@@ -4,6 +4,9 @@ import (
 	"time"
 
 	"github.com/larek-tech/diploma/domain/internal/domain/pb"
+	"github.com/rs/zerolog/log"
+	"github.com/yogenyslav/pkg/errs"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -29,6 +32,7 @@ type ScenarioDao struct {
 	TopN              int64     `db:"top_n"`
 	Threshold         float32   `db:"threshold"`
 	SearchByQuery     bool      `db:"search_by_query"`
+	SearchFilter      []byte    `db:"search_filter"`
 	CreatedAt         time.Time `db:"created_at"`
 	UpdatedAt         time.Time `db:"updated_at"`
 }
@@ -53,6 +57,7 @@ func (s *ScenarioDao) ToProto() *pb.Scenario {
 			TopN:          s.TopN,
 			Threshold:     s.Threshold,
 			SearchByQuery: s.SearchByQuery,
+			Filter:        s.searchFilter(),
 		},
 		Model: &pb.LlmModel{
 			ModelName:    s.LlmModelName,
@@ -65,3 +70,29 @@ func (s *ScenarioDao) ToProto() *pb.Scenario {
 		UpdatedAt: timestamppb.New(s.UpdatedAt),
 	}
 }
+
+// SetSearchFilter stores default vector search filter as json, nil filter removes it.
+func (s *ScenarioDao) SetSearchFilter(filter *pb.SearchFilter) error {
+	if filter == nil {
+		s.SearchFilter = nil
+		return nil
+	}
+	raw, err := protojson.Marshal(filter)
+	if err != nil {
+		return errs.WrapErr(err, "marshal search filter")
+	}
+	s.SearchFilter = raw
+	return nil
+}
+
+func (s *ScenarioDao) searchFilter() *pb.SearchFilter {
+	if len(s.SearchFilter) == 0 {
+		return nil
+	}
+	var filter pb.SearchFilter
+	if err := protojson.Unmarshal(s.SearchFilter, &filter); err != nil {
+		log.Warn().Err(errs.WrapErr(err)).Int64("scenarioID", s.ID).Msg("unmarshal search filter")
+		return nil
+	}
+	return &filter
+}
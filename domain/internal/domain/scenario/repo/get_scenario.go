@@ -10,7 +10,7 @@ import (
 const getScenarioByID = `
 	select id, title, user_id, domain_id, context_size, use_multiquery, n_queries, query_model_name, use_rerank, 
 		reranker_model_name, reranker_max_length, reranker_top_k, llm_model_name, temperature, top_k, top_p, 
-		system_prompt, top_n, threshold, search_by_query, created_at, updated_at
+		system_prompt, top_n, threshold, search_by_query, search_filter, created_at, updated_at
 	from domain.scenario
 	where id = $1
 		and user_id = $2;
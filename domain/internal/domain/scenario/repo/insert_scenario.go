@@ -10,8 +10,8 @@ import (
 const insertScenario = `
 	insert into domain.scenario(title, user_id, domain_id, context_size, use_multiquery, n_queries, query_model_name, use_rerank, 
 	                            reranker_model_name, reranker_max_length, reranker_top_k, llm_model_name, temperature, 
-	                            top_k, top_p, system_prompt, top_n, threshold, search_by_query)
-	values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+	                            top_k, top_p, system_prompt, top_n, threshold, search_by_query, search_filter)
+	values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
 	returning id;
 `
 
@@ -41,6 +41,7 @@ func (r *Repo) InsertScenario(ctx context.Context, s model.ScenarioDao) (int64,
 		s.TopN,
 		s.Threshold,
 		s.SearchByQuery,
+		s.SearchFilter,
 	)
 	if err != nil {
 		return 0, errs.WrapErr(err, "insert scenario")
@@ -26,7 +26,8 @@ const updateScenario = `
 	    threshold=$16,
 	    search_by_query=$17,
 		title=$18,
-		context_size=$19
+		context_size=$19,
+		search_filter=$20
 	where id = $1
 		and user_id = $2;
 `
@@ -55,6 +56,7 @@ func (r *Repo) UpdateScenario(ctx context.Context, s model.ScenarioDao, userID i
 		s.SearchByQuery,
 		s.Title,
 		s.ContextSize,
+		s.SearchFilter,
 	)
 	if err != nil {
 		return errs.WrapErr(err, "update scenario")
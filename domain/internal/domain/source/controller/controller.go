@@ -11,20 +11,24 @@ import (
 	authpb "github.com/larek-tech/diploma/domain/internal/auth/pb"
 	"github.com/larek-tech/diploma/domain/internal/domain/source/model"
 	"github.com/larek-tech/diploma/domain/pkg/kafka"
+	"github.com/rs/zerolog/log"
 	"github.com/yogenyslav/pkg/errs"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
 const (
-	sourceTopic   = "source"
-	statusTopic   = "status"
-	traceIDHeader = "x-trace-id"
+	sourceTopic = "source"
+	// StatusTopic is a topic with parsing statuses reported by Data service.
+	StatusTopic       = "status"
+	sourceDeleteTopic = "source_delete"
+	sourceUpdateTopic = "source_update"
+	// SourceDeletedTopic is a topic with results of purging deleted sources reported by Data service.
+	SourceDeletedTopic = "source_deleted"
+	traceIDHeader      = "x-trace-id"
 )
 
 var (
-	// ErrUpdateSourceStatus is an error when source status updating failed.
-	ErrUpdateSourceStatus = errors.New("failed to update source status while parsing")
 	// ErrNoAccessToSource is an error when user can't edit source.
 	ErrNoAccessToSource = errors.New("user has no access to edit source")
 )
@@ -34,7 +38,10 @@ type sourceRepo interface {
 	GetSourceByID(ctx context.Context, id, userID int64, roleIDs []int64) (model.SourceDao, error)
 	GetSourceIDs(ctx context.Context, sourceID, userID int64, roleIDs []int64) (uuid.UUID, error)
 	UpdateSource(ctx context.Context, s model.SourceDao, userID int64, roleIDs []int64) error
-	DeleteSource(ctx context.Context, id, userID int64, roleIDs []int64) error
+	DeleteSource(ctx context.Context, id, userID int64, roleIDs []int64) (string, error)
+	UpdateDeletionStatus(ctx context.Context, externalID string, status model.DeletionStatus, errMsg string) error
+	UpdateSourceStatus(ctx context.Context, id int64, status model.ParsingStatus) (bool, error)
+	RequestSourceDeletion(ctx context.Context, externalID string, id int64) (bool, error)
 	ListSources(ctx context.Context, userID int64, roleIDs []int64, offset, limit uint64) ([]model.SourceDao, error)
 	ListSourcesByDomain(ctx context.Context, userID, domainID int64, roleIDs []int64, offset, limit uint64) ([]model.SourceDao, error)
 	GetPermittedUsers(ctx context.Context, sourceID int64) ([]int64, error)
@@ -48,26 +55,28 @@ type Controller struct {
 	sr       sourceRepo
 	tracer   trace.Tracer
 	producer *kafka.AsyncProducer
-	consumer *kafka.Consumer
-	statusCh chan *sarama.ConsumerMessage
-	errCh    chan error
 }
 
 // New creates new Controller.
-func New(ctx context.Context, sr sourceRepo, tracer trace.Tracer, producer *kafka.AsyncProducer, consumer *kafka.Consumer) (*Controller, error) {
-	statusCh, errCh, err := consumer.Subscribe(ctx, statusTopic)
-	if err != nil {
-		return nil, errs.WrapErr(err, "subscribe to status topic")
-	}
-
+func New(sr sourceRepo, tracer trace.Tracer, producer *kafka.AsyncProducer) *Controller {
 	return &Controller{
 		sr:       sr,
 		tracer:   tracer,
 		producer: producer,
-		consumer: consumer,
-		statusCh: statusCh,
-		errCh:    errCh,
-	}, nil
+	}
+}
+
+// HandleMessage routes messages of the topics reported by Data service to their handlers.
+func (ctrl *Controller) HandleMessage(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	switch msg.Topic {
+	case StatusTopic:
+		return ctrl.HandleStatus(ctx, msg)
+	case SourceDeletedTopic:
+		return ctrl.HandleDeletionResult(ctx, msg)
+	default:
+		log.Warn().Str("topic", msg.Topic).Msg("skip message of unknown topic")
+		return nil
+	}
 }
 
 func (ctrl *Controller) checkSourceCreator(ctx context.Context, sourceID int64, meta *authpb.UserAuthMetadata) error {
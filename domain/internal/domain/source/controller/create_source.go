@@ -10,7 +10,6 @@ import (
 	authpb "github.com/larek-tech/diploma/domain/internal/auth/pb"
 	"github.com/larek-tech/diploma/domain/internal/domain/pb"
 	"github.com/larek-tech/diploma/domain/internal/domain/source/model"
-	"github.com/rs/zerolog/log"
 	"github.com/yogenyslav/pkg/errs"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -50,14 +49,14 @@ func (ctrl *Controller) CreateSource(ctx context.Context, req *pb.CreateSourceRe
 	}
 	source.ID = sourceID
 
-	if err = ctrl.saveSourceData(ctx, source, meta); err != nil {
+	if err = ctrl.saveSourceData(ctx, source, model.ChunkingParamsFromProto(req.GetChunking())); err != nil {
 		return nil, errs.WrapErr(err, "save source data")
 	}
 
 	return source.ToProto(), nil
 }
 
-func (ctrl *Controller) saveSourceData(ctx context.Context, source model.SourceDao, meta *authpb.UserAuthMetadata) error {
+func (ctrl *Controller) saveSourceData(ctx context.Context, source model.SourceDao, chunking *model.ChunkingParams) error {
 	_, span := ctrl.tracer.Start(ctx, "Controller.saveSourceData")
 	defer span.End()
 
@@ -67,6 +66,7 @@ func (ctrl *Controller) saveSourceData(ctx context.Context, source model.SourceD
 		Type:         source.Type,
 		Credentials:  source.Credentials,
 		UpdateParams: source.AssembleUpdateParams(),
+		Chunking:     chunking,
 	}
 
 	data, err := json.Marshal(dataMsg)
@@ -87,83 +87,5 @@ func (ctrl *Controller) saveSourceData(ctx context.Context, source model.SourceD
 		Timestamp: time.Now(),
 	})
 
-	go ctrl.getParsingResult(source, meta)
-
 	return nil
 }
-
-func (ctrl *Controller) getParsingResult(source model.SourceDao, meta *authpb.UserAuthMetadata) {
-	ctx, span := ctrl.tracer.Start(
-		context.Background(),
-		"Controller.getParsingResult",
-		trace.WithAttributes(
-			attribute.Int64("source internal id", source.ID),
-			attribute.String("title", source.Title),
-			attribute.Int("source type", int(source.Type)),
-		),
-	)
-	defer span.End()
-
-	var (
-		startedParsing bool
-		failed         bool
-	)
-
-consumeLoop:
-	for {
-		select {
-		case err := <-ctrl.errCh:
-			log.Err(errs.WrapErr(err)).Msg("consuming source status topic failed")
-			break consumeLoop
-		case <-time.After(time.Minute):
-			if !startedParsing {
-				log.Err(errs.WrapErr(ErrUpdateSourceStatus)).Msg("failed to start parsing")
-				break consumeLoop
-			}
-		case msg := <-ctrl.statusCh:
-			log.Debug().Str("msg", string(msg.Value)).Msg("got parsing status message")
-
-			if string(msg.Key) != strconv.FormatInt(source.ID, 10) {
-				ctrl.statusCh <- msg
-				continue
-			}
-
-			var (
-				resp   model.ParsingStatus
-				status model.SourceStatus
-			)
-
-			if err := json.Unmarshal(msg.Value, &resp); err != nil {
-				log.Err(errs.WrapErr(err)).Msg("unmarshal parsing status message")
-				status = model.StatusFailed
-			} else if resp.Status != model.StatusUndefined {
-				status = resp.Status
-				source.ExtID = resp.SourceID
-			}
-
-			if status == model.StatusFailed {
-				failed = true
-			}
-
-			if (!startedParsing && status == model.StatusParsing) || status == model.StatusReady || failed {
-				startedParsing = true
-
-				source.Status = status
-				if err := ctrl.sr.UpdateSource(ctx, source, meta.GetUserId(), meta.GetRoles()); err != nil {
-					log.Warn().Err(errs.WrapErr(err)).Msg("can't properly update source status")
-				}
-			}
-
-			if status == model.StatusReady {
-				log.Info().Str("sourceID", resp.SourceID).Msg("processed successfully")
-				break consumeLoop
-			}
-
-			if failed {
-				log.Err(errs.WrapErr(ErrUpdateSourceStatus)).Msg("get parsing result")
-				break consumeLoop
-			}
-		}
-	}
-	log.Info().Int64("sourceID", source.ID).Msg("finished consumer loop for parsing source")
-}
@@ -2,14 +2,19 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
+	"github.com/IBM/sarama"
 	authpb "github.com/larek-tech/diploma/domain/internal/auth/pb"
+	"github.com/larek-tech/diploma/domain/internal/domain/source/model"
+	"github.com/rs/zerolog/log"
 	"github.com/yogenyslav/pkg/errs"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
-// DeleteSource deletes source by id.
+// DeleteSource deletes source by id and asks Data service to purge its data.
 func (ctrl *Controller) DeleteSource(ctx context.Context, sourceID int64, meta *authpb.UserAuthMetadata) error {
 	ctx, span := ctrl.tracer.Start(
 		ctx,
@@ -21,9 +26,68 @@ func (ctrl *Controller) DeleteSource(ctx context.Context, sourceID int64, meta *
 	)
 	defer span.End()
 
-	if err := ctrl.sr.DeleteSource(ctx, sourceID, meta.GetUserId(), meta.GetRoles()); err != nil {
+	externalID, err := ctrl.sr.DeleteSource(ctx, sourceID, meta.GetUserId(), meta.GetRoles())
+	if err != nil {
 		return errs.WrapErr(err)
 	}
 
+	if externalID == "" {
+		log.Warn().Int64("sourceID", sourceID).Msg("deleted source has no external id, nothing to purge")
+		return nil
+	}
+
+	if err = ctrl.sendDeleteMessage(ctx, externalID); err != nil {
+		return errs.WrapErr(err, "send delete message")
+	}
+
+	return nil
+}
+
+func (ctrl *Controller) sendDeleteMessage(ctx context.Context, externalID string) error {
+	_, span := ctrl.tracer.Start(ctx, "Controller.sendDeleteMessage")
+	defer span.End()
+
+	data, err := json.Marshal(model.DeleteMessage{SourceID: externalID})
+	if err != nil {
+		return errs.WrapErr(err, "marshal delete message for kafka")
+	}
+
+	ctrl.producer.SendAsyncMessage(&sarama.ProducerMessage{
+		Topic: sourceDeleteTopic,
+		Headers: []sarama.RecordHeader{
+			{
+				Key:   []byte(traceIDHeader),
+				Value: []byte(span.SpanContext().TraceID().String()),
+			},
+		},
+		Key:       sarama.StringEncoder(externalID),
+		Value:     sarama.ByteEncoder(data),
+		Timestamp: time.Now(),
+	})
+
+	return nil
+}
+
+// HandleDeletionResult stores result of purging deleted source reported by Data service.
+// Returned error means the result wasn't saved and the message has to be handled again.
+func (ctrl *Controller) HandleDeletionResult(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	ctx, span := ctrl.tracer.Start(
+		ctx,
+		"Controller.HandleDeletionResult",
+		trace.WithAttributes(
+			attribute.String("key", string(msg.Key)),
+		),
+	)
+	defer span.End()
+
+	var res model.DeletionResult
+	if err := json.Unmarshal(msg.Value, &res); err != nil {
+		log.Warn().Err(errs.WrapErr(err)).Msg("skip invalid deletion result message")
+		return nil
+	}
+	if err := ctrl.sr.UpdateDeletionStatus(ctx, res.SourceID, res.Status, res.Error); err != nil {
+		return errs.WrapErr(err, "update deletion status")
+	}
+	log.Info().Str("sourceID", res.SourceID).Uint8("status", uint8(res.Status)).Msg("source deletion finished")
 	return nil
 }
@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/IBM/sarama"
+	"github.com/larek-tech/diploma/domain/internal/domain/source/model"
+	"github.com/rs/zerolog/log"
+	"github.com/yogenyslav/pkg/errs"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// HandleStatus applies parsing status reported by Data service to the source, message key is the source internal id.
+// Repeated messages don't change the result, so it is safe to handle them again after restart.
+// Returned error means the status wasn't saved and the message has to be handled again.
+func (ctrl *Controller) HandleStatus(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	ctx, span := ctrl.tracer.Start(
+		ctx,
+		"Controller.HandleStatus",
+		trace.WithAttributes(
+			attribute.String("key", string(msg.Key)),
+		),
+	)
+	defer span.End()
+
+	sourceID, err := strconv.ParseInt(string(msg.Key), 10, 64)
+	if err != nil {
+		log.Warn().Err(errs.WrapErr(err)).Str("key", string(msg.Key)).Msg("skip parsing status with invalid key")
+		return nil
+	}
+
+	var status model.ParsingStatus
+	if err = json.Unmarshal(msg.Value, &status); err != nil {
+		log.Warn().Err(errs.WrapErr(err)).Int64("sourceID", sourceID).Msg("skip invalid parsing status message")
+		return nil
+	}
+
+	found, err := ctrl.sr.UpdateSourceStatus(ctx, sourceID, status)
+	if err != nil {
+		return errs.WrapErr(err, "update source status")
+	}
+	if found {
+		log.Debug().Int64("sourceID", sourceID).Uint8("status", uint8(status.Status)).Msg("source status updated")
+		return nil
+	}
+
+	if status.SourceID == "" {
+		return nil
+	}
+	// source was deleted before Data service reported its id
+	requested, err := ctrl.sr.RequestSourceDeletion(ctx, status.SourceID, sourceID)
+	if err != nil {
+		return errs.WrapErr(err, "request source deletion")
+	}
+	if requested {
+		if err = ctrl.sendDeleteMessage(ctx, status.SourceID); err != nil {
+			return errs.WrapErr(err, "send delete message")
+		}
+	}
+	return nil
+}
@@ -1,11 +1,16 @@
 package controller
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"strconv"
 	"time"
 
+	"github.com/IBM/sarama"
 	authpb "github.com/larek-tech/diploma/domain/internal/auth/pb"
 	"github.com/larek-tech/diploma/domain/internal/domain/pb"
+	"github.com/larek-tech/diploma/domain/internal/domain/source/model"
 	"github.com/yogenyslav/pkg/errs"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -29,18 +34,90 @@ func (ctrl *Controller) UpdateSource(ctx context.Context, req *pb.UpdateSourceRe
 		return nil, errs.WrapErr(err)
 	}
 
-	source.Title = req.GetTitle()
-	source.Content = req.GetContent()
-	source.Credentials = req.GetCredentials()
+	var contentChanged, settingsChanged bool
+	if req.Title != nil && source.Title != req.GetTitle() {
+		source.Title = req.GetTitle()
+		settingsChanged = true
+	}
+	if req.Content != nil && !bytes.Equal(source.Content, req.GetContent()) {
+		source.Content = req.GetContent()
+		contentChanged = true
+	}
+	if req.Credentials != nil && !bytes.Equal(source.Credentials, req.GetCredentials()) {
+		source.Credentials = req.GetCredentials()
+		contentChanged = true
+	}
 	if source.Credentials == nil {
 		source.Credentials = make([]byte, 0)
 	}
-	source.FillUpdateParams(req.UpdateParams)
+	if req.UpdateParams != nil {
+		source.FillUpdateParams(req.GetUpdateParams())
+		settingsChanged = true
+	}
 	source.UpdatedAt = time.Now()
 
+	// index keeps serving the previous content until Data service reports the new generation is ready
+	reingest := contentChanged && source.ExtID != ""
+	if reingest {
+		source.Status = model.StatusParsing
+	}
+
 	if err = ctrl.sr.UpdateSource(ctx, source, meta.GetUserId(), meta.GetRoles()); err != nil {
 		return nil, errs.WrapErr(err)
 	}
 
+	switch {
+	case reingest:
+		if err = ctrl.sendUpdateMessage(ctx, source, false, req.GetUpdateParams() != nil); err != nil {
+			return nil, errs.WrapErr(err, "send update message")
+		}
+	case settingsChanged && source.ExtID != "":
+		// title and schedule are kept by Data service too, but don't require a new generation
+		if err = ctrl.sendUpdateMessage(ctx, source, true, req.GetUpdateParams() != nil); err != nil {
+			return nil, errs.WrapErr(err, "send settings update message")
+		}
+	}
+
 	return source.ToProto(), nil
 }
+
+func (ctrl *Controller) sendUpdateMessage(ctx context.Context, source model.SourceDao, settingsOnly, withUpdateParams bool) error {
+	_, span := ctrl.tracer.Start(ctx, "Controller.sendUpdateMessage")
+	defer span.End()
+
+	updateMsg := model.UpdateMessage{
+		SourceID:     source.ExtID,
+		SettingsOnly: settingsOnly,
+		DataMessage: model.DataMessage{
+			Title: source.Title,
+			Type:  source.Type,
+		},
+	}
+	if !settingsOnly {
+		updateMsg.Content = source.Content
+		updateMsg.Credentials = source.Credentials
+	}
+	if withUpdateParams {
+		updateMsg.UpdateParams = source.AssembleUpdateParams()
+	}
+
+	data, err := json.Marshal(updateMsg)
+	if err != nil {
+		return errs.WrapErr(err, "marshal update message for kafka")
+	}
+
+	ctrl.producer.SendAsyncMessage(&sarama.ProducerMessage{
+		Topic: sourceUpdateTopic,
+		Headers: []sarama.RecordHeader{
+			{
+				Key:   []byte(traceIDHeader),
+				Value: []byte(span.SpanContext().TraceID().String()),
+			},
+		},
+		Key:       sarama.StringEncoder(strconv.FormatInt(source.ID, 10)),
+		Value:     sarama.ByteEncoder(data),
+		Timestamp: time.Now(),
+	})
+
+	return nil
+}
@@ -24,6 +24,11 @@ func (h *Handler) CreateSource(ctx context.Context, req *pb.CreateSourceRequest)
 		return nil, status.Error(codes.InvalidArgument, "invalid update params value")
 	}
 
+	if err = validateChunkingParams(req.GetChunking()); err != nil {
+		log.Err(errs.WrapErr(err)).Msg("validate chunking params")
+		return nil, status.Error(codes.InvalidArgument, "invalid chunking params value")
+	}
+
 	resp, err := h.sc.CreateSource(ctx, req, meta)
 	if err != nil {
 		log.Err(errs.WrapErr(err)).Msg("create source")
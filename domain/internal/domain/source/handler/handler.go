@@ -15,6 +15,8 @@ var (
 	ErrUpdateParamsPositive = errors.New("update period must be positive")
 	// ErrCronUpdateParamsNonNegative is an error when provided negative cron-format update params.
 	ErrCronUpdateParamsNonNegative = errors.New("cron update params must be non-negative")
+	// ErrChunkingParamsInvalid is an error when provided chunk size or overlap are out of range.
+	ErrChunkingParamsInvalid = errors.New("chunk size must be non-negative and greater than overlap")
 )
 
 type sourceController interface {
@@ -60,3 +62,14 @@ func validateUpdateParams(updateParams *pb.UpdateParams) error {
 	}
 	return nil
 }
+
+func validateChunkingParams(chunking *pb.ChunkingParams) error {
+	if chunking == nil {
+		return nil
+	}
+	if chunking.GetChunkSize() < 0 || chunking.GetChunkOverlap() < 0 ||
+		(chunking.GetChunkSize() > 0 && chunking.GetChunkOverlap() >= chunking.GetChunkSize()) {
+		return errs.WrapErr(ErrChunkingParamsInvalid)
+	}
+	return nil
+}
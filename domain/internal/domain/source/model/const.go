@@ -29,3 +29,17 @@ const (
 	// StatusFailed source parsing failed.
 	StatusFailed
 )
+
+// DeletionStatus enum defining the state of source removal in Data service.
+type DeletionStatus uint8
+
+const (
+	// DeletionUndefined undefined deletion status.
+	DeletionUndefined DeletionStatus = iota
+	// DeletionPending delete event is sent, Data service hasn't confirmed it yet.
+	DeletionPending
+	// DeletionDone source data is purged from Data service.
+	DeletionDone
+	// DeletionFailed Data service failed to purge source data.
+	DeletionFailed
+)
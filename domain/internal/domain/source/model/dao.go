@@ -28,6 +28,7 @@ type SourceDao struct {
 }
 
 // ToProto converts dao model into protobuf format.
+// Credentials are write-only and never returned to clients.
 func (s *SourceDao) ToProto() *pb.Source {
 	var updateParams *pb.UpdateParams = nil
 	updateParamsDto := s.AssembleUpdateParams()
@@ -42,7 +43,6 @@ func (s *SourceDao) ToProto() *pb.Source {
 		Content:      s.Content,
 		Typ:          pb.SourceType(s.Type),
 		UpdateParams: updateParams,
-		Credentials:  s.Credentials,
 		Status:       pb.SourceStatus(s.Status),
 		CreatedAt:    timestamppb.New(s.CreatedAt),
 		UpdatedAt:    timestamppb.New(s.UpdatedAt),
@@ -64,7 +64,7 @@ func (s *SourceDao) FillUpdateParams(updateParams *pb.UpdateParams) {
 	if updateParams != nil {
 		if updateParams.EveryPeriod != nil {
 			s.UpdateEveryPeriod = updateParams.GetEveryPeriod()
-		} else {
+		} else if updateParams.Cron != nil {
 			cron := updateParams.GetCron()
 			s.CronWeekDay = cron.GetDayOfWeek()
 			s.CronMonth = cron.GetMonth()
@@ -82,7 +82,7 @@ func (s *SourceDao) AssembleUpdateParams() *UpdateParams {
 	switch {
 	case s.UpdateEveryPeriod != -1:
 		updateParams.EveryPeriod = &s.UpdateEveryPeriod
-	case s.UpdateEveryPeriod != -1 && s.CronWeekDay != -1 && s.CronMonth != -1 && s.CronDay != -1 && s.CronHour != -1 && s.CronMinute != -1:
+	case s.CronWeekDay != -1 || s.CronMonth != -1 || s.CronDay != -1 || s.CronHour != -1 || s.CronMinute != -1:
 		updateParams.Cron = &Cron{
 			WeekDay: s.CronWeekDay,
 			Month:   s.CronMonth,
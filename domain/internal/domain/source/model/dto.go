@@ -6,11 +6,11 @@ import (
 
 // Cron contains cron-format parameters for source updates.
 type Cron struct {
-	WeekDay int32 `db:"cron_week_day"`
-	Month   int32 `db:"cron_month"`
-	Day     int32 `db:"cron_day"`
-	Hour    int32 `db:"cron_hour"`
-	Minute  int32 `db:"cron_minute"`
+	WeekDay int32 `db:"cron_week_day" json:"week_day"`
+	Month   int32 `db:"cron_month" json:"month"`
+	Day     int32 `db:"cron_day" json:"day"`
+	Hour    int32 `db:"cron_hour" json:"hour"`
+	Minute  int32 `db:"cron_minute" json:"minute"`
 }
 
 // ToProto converts dto model to protobuf format.
@@ -44,13 +44,54 @@ func (up *UpdateParams) ToProto() *pb.UpdateParams {
 	}
 }
 
+var (
+	chunkingStrategies = map[pb.ChunkingStrategy]string{
+		pb.ChunkingStrategy_CHUNKING_RECURSIVE: "recursive",
+		pb.ChunkingStrategy_CHUNKING_MARKDOWN:  "markdown",
+	}
+	chunkSizeUnits = map[pb.ChunkSizeUnit]string{
+		pb.ChunkSizeUnit_UNIT_CHARS:  "chars",
+		pb.ChunkSizeUnit_UNIT_TOKENS: "tokens",
+	}
+)
+
+// ChunkingParams sets how documents of the source are split into chunks, empty values mean defaults of Data service.
+type ChunkingParams struct {
+	Strategy string `json:"strategy,omitempty"`
+	Unit     string `json:"unit,omitempty"`
+	Size     int32  `json:"size,omitempty"`
+	Overlap  int32  `json:"overlap,omitempty"`
+}
+
+// ChunkingParamsFromProto converts protobuf chunking params into dto model.
+func ChunkingParamsFromProto(chunking *pb.ChunkingParams) *ChunkingParams {
+	if chunking == nil {
+		return nil
+	}
+	return &ChunkingParams{
+		Strategy: chunkingStrategies[chunking.GetStrategy()],
+		Unit:     chunkSizeUnits[chunking.GetUnit()],
+		Size:     chunking.GetChunkSize(),
+		Overlap:  chunking.GetChunkOverlap(),
+	}
+}
+
 // DataMessage contains information about new Source and is sent to Data service to be processed.
 type DataMessage struct {
-	Title        string        `json:"title"`
-	Content      []byte        `json:"content"` // byte encoded url or file content
-	Type         SourceType    `json:"type"`
-	Credentials  []byte        `json:"credentials,omitempty"`
-	UpdateParams *UpdateParams `json:"update_params,omitempty"`
+	Title        string          `json:"title"`
+	Content      []byte          `json:"content"` // byte encoded url or file content
+	Type         SourceType      `json:"type"`
+	Credentials  []byte          `json:"credentials,omitempty"`
+	UpdateParams *UpdateParams   `json:"update_params,omitempty"`
+	Chunking     *ChunkingParams `json:"chunking,omitempty"`
+}
+
+// UpdateMessage asks Data service to ingest new content of the source into a new index generation.
+// With SettingsOnly set only title and update params are applied, the index is left as is.
+type UpdateMessage struct {
+	SourceID     string `json:"source"`
+	SettingsOnly bool   `json:"settings_only,omitempty"`
+	DataMessage
 }
 
 // ParsingStatus status of processing source.
@@ -61,3 +102,15 @@ type ParsingStatus struct {
 	Total     int          `json:"total"`
 	Status    SourceStatus `json:"status"`
 }
+
+// DeleteMessage asks Data service to purge everything ingested for the source.
+type DeleteMessage struct {
+	SourceID string `json:"source"`
+}
+
+// DeletionResult is reported by Data service after the source is purged.
+type DeletionResult struct {
+	SourceID string         `json:"source"`
+	Status   DeletionStatus `json:"status"`
+	Error    string         `json:"error,omitempty"`
+}
@@ -2,8 +2,10 @@ package repo
 
 import (
 	"context"
+	"errors"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
 	"github.com/yogenyslav/pkg/errs"
 )
 
@@ -13,19 +15,50 @@ const deleteSource = `
 	    select internal_source_id
 	    from domain.get_permitted_sources($2, $3)
 	    where internal_source_id = $1
-	);
+	)
+	returning external_id;
 `
 
-// DeleteSource deletes source by ID.
-func (r *Repo) DeleteSource(ctx context.Context, id, userID int64, roleIDs []int64) error {
-	rows, err := r.pg.Exec(ctx, deleteSource, id, userID, roleIDs)
+const insertSourceDeletion = `
+	insert into domain.source_deletion (external_id, internal_id)
+	values ($1, $2)
+	on conflict (external_id) do update
+	set status = excluded.status,
+	    error = '',
+	    updated_at = current_timestamp;
+`
+
+// DeleteSource deletes source by ID and registers pending deletion of its data.
+// Returns external id of deleted source, it is empty if Data service hasn't reported it yet.
+func (r *Repo) DeleteSource(ctx context.Context, id, userID int64, roleIDs []int64) (string, error) {
+	var externalID string
+
+	ctx, err := r.pg.BeginSerializable(ctx)
 	if err != nil {
-		return errs.WrapErr(err, "delete source")
+		return "", errs.WrapErr(err, "start tx")
+	}
+	defer func() {
+		if e := r.pg.RollbackTx(ctx); e != nil {
+			log.Warn().Err(errs.WrapErr(err)).Msg("rollback tx")
+		}
+	}()
+
+	if err = r.pg.QueryTx(ctx, &externalID, deleteSource, id, userID, roleIDs); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", errs.WrapErr(pgx.ErrNoRows, "source not found")
+		}
+		return "", errs.WrapErr(err, "delete source")
+	}
+
+	if externalID != "" {
+		if _, err = r.pg.ExecTx(ctx, insertSourceDeletion, externalID, id); err != nil {
+			return "", errs.WrapErr(err, "insert source deletion")
+		}
 	}
 
-	if rows == 0 {
-		return errs.WrapErr(pgx.ErrNoRows, "source not found")
+	if err = r.pg.CommitTx(ctx); err != nil {
+		return "", errs.WrapErr(err, "commit tx")
 	}
 
-	return nil
+	return externalID, nil
 }
@@ -0,0 +1,24 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/larek-tech/diploma/domain/internal/domain/source/model"
+	"github.com/yogenyslav/pkg/errs"
+)
+
+const updateDeletionStatus = `
+	update domain.source_deletion
+	set status = $2,
+	    error = $3,
+	    updated_at = current_timestamp
+	where external_id = $1;
+`
+
+// UpdateDeletionStatus sets result of purging source data reported by Data service.
+func (r *Repo) UpdateDeletionStatus(ctx context.Context, externalID string, status model.DeletionStatus, errMsg string) error {
+	if _, err := r.pg.Exec(ctx, updateDeletionStatus, externalID, status, errMsg); err != nil {
+		return errs.WrapErr(err, "update deletion status")
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/larek-tech/diploma/domain/internal/domain/source/model"
+	"github.com/yogenyslav/pkg/errs"
+)
+
+// undefined status only reports progress and keeps the current one,
+// counters never decrease within the same job, so repeated messages don't change the result.
+// Messages of one job come from different Data workers and may arrive out of order:
+// a parsing status doesn't override ready or failed status of the same job, a new job starts over.
+const updateSourceStatus = `
+	update domain.source
+	set status = case
+	        when $2 = 0 then status
+	        when $2 = $7 and $4 <> '' and job_id = $4 and status in ($8, $9) then status
+	        else $2
+	    end,
+	    external_id = coalesce(nullif($3, ''), external_id),
+	    processed = case when job_id = $4 then greatest(processed, $5) else $5 end,
+	    total = case when job_id = $4 then greatest(total, $6) else $6 end,
+	    job_id = $4,
+	    status_updated_at = current_timestamp
+	where internal_id = $1;
+`
+
+const requestSourceDeletion = `
+	insert into domain.source_deletion (external_id, internal_id)
+	values ($1, $2)
+	on conflict (external_id) do nothing;
+`
+
+// UpdateSourceStatus applies parsing status reported by Data service.
+// Returns false if the source doesn't exist anymore.
+func (r *Repo) UpdateSourceStatus(ctx context.Context, id int64, status model.ParsingStatus) (bool, error) {
+	rows, err := r.pg.Exec(
+		ctx,
+		updateSourceStatus,
+		id,
+		status.Status,
+		status.SourceID,
+		status.JobID,
+		status.Processed,
+		status.Total,
+		model.StatusParsing,
+		model.StatusReady,
+		model.StatusFailed,
+	)
+	if err != nil {
+		return false, errs.WrapErr(err, "update source status")
+	}
+	return rows > 0, nil
+}
+
+// RequestSourceDeletion registers pending deletion of data for source that was deleted before
+// Data service reported its external id. Returns false if deletion is already registered.
+func (r *Repo) RequestSourceDeletion(ctx context.Context, externalID string, id int64) (bool, error) {
+	rows, err := r.pg.Exec(ctx, requestSourceDeletion, externalID, id)
+	if err != nil {
+		return false, errs.WrapErr(err, "request source deletion")
+	}
+	return rows > 0, nil
+}
@@ -6,6 +6,7 @@ type Config struct {
 	Topics         []Topic  `yaml:"topics"`
 	OffsetNewest   bool     `yaml:"offset_newest"`
 	CommitInterval int      `yaml:"commit_interval"`
+	GroupID        string   `yaml:"group_id"`
 }
 
 // Broker is the struct for Kafka broker.
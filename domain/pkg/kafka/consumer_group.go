@@ -0,0 +1,149 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+var (
+	// ErrNewConsumerGroup is an error when new Kafka consumer group can't be created.
+	ErrNewConsumerGroup = errors.New("creating new consumer group failed")
+	// ErrCloseConsumerGroup is an error when consumer group wasn't closed properly.
+	ErrCloseConsumerGroup = errors.New("can't close consumer group properly")
+)
+
+// retryDelay is a pause before the message is handled again after handler error.
+const retryDelay = time.Second
+
+// MessageHandler processes a single message. Returned error means the message must be handled again,
+// so handlers should return nil for messages that can never be processed.
+type MessageHandler func(ctx context.Context, msg *sarama.ConsumerMessage) error
+
+// ConsumerGroup is a Kafka consumer group member, committed offsets survive restarts.
+type ConsumerGroup struct {
+	Config *Config
+	group  sarama.ConsumerGroup
+	errCh  chan error
+}
+
+// NewConsumerGroup creates a new member of the Kafka consumer group.
+func NewConsumerGroup(config *Config, groupID string) (*ConsumerGroup, chan error, error) {
+	cfg := sarama.NewConfig()
+	cfg.Consumer.Return.Errors = true
+	cfg.Consumer.Offsets.AutoCommit.Enable = true
+	if config.CommitInterval != 0 {
+		cfg.Consumer.Offsets.AutoCommit.Interval = time.Second * time.Duration(config.CommitInterval)
+	}
+
+	if config.OffsetNewest {
+		cfg.Consumer.Offsets.Initial = sarama.OffsetNewest
+	} else {
+		cfg.Consumer.Offsets.Initial = sarama.OffsetOldest
+	}
+
+	brokers := make([]string, len(config.Brokers))
+	for idx, broker := range config.Brokers {
+		brokers[idx] = net.JoinHostPort(broker.Host, strconv.Itoa(broker.Port))
+	}
+
+	group, err := sarama.NewConsumerGroup(brokers, groupID, cfg)
+	if err != nil {
+		return nil, nil, errors.Join(ErrNewConsumerGroup, err)
+	}
+
+	errCh := make(chan error)
+	go func() {
+		for e := range group.Errors() {
+			errCh <- e
+		}
+	}()
+
+	return &ConsumerGroup{
+		Config: config,
+		group:  group,
+		errCh:  errCh,
+	}, errCh, nil
+}
+
+// Consume handles messages of the topics until ctx is done. Message offset is committed
+// only after the handler succeeds, failed messages are retried.
+func (cg *ConsumerGroup) Consume(ctx context.Context, topics []string, handler MessageHandler) error {
+	h := &groupHandler{
+		handler: handler,
+		errCh:   cg.errCh,
+	}
+	for {
+		if err := cg.group.Consume(ctx, topics, h); err != nil {
+			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+				return nil
+			}
+			return err
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// Close closes the Kafka consumer group.
+func (cg *ConsumerGroup) Close() error {
+	if err := cg.group.Close(); err != nil {
+		return errors.Join(ErrCloseConsumerGroup, err)
+	}
+	return nil
+}
+
+type groupHandler struct {
+	handler MessageHandler
+	errCh   chan<- error
+}
+
+func (h *groupHandler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *groupHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *groupHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case <-sess.Context().Done():
+			return nil
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			if !h.handle(sess, msg) {
+				return nil
+			}
+			sess.MarkMessage(msg, "")
+		}
+	}
+}
+
+// handle retries the message until it is processed, returns false if the session ended first.
+func (h *groupHandler) handle(sess sarama.ConsumerGroupSession, msg *sarama.ConsumerMessage) bool {
+	for {
+		err := h.handler(sess.Context(), msg)
+		if err == nil {
+			return true
+		}
+		select {
+		case h.errCh <- err:
+		case <-sess.Context().Done():
+			return false
+		}
+		select {
+		case <-time.After(retryDelay):
+		case <-sess.Context().Done():
+			return false
+		}
+	}
+}
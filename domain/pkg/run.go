@@ -36,6 +36,8 @@ import (
 
 const (
 	configPath = "./config/config.yaml"
+	// defaultGroupID is used when kafka consumer group isn't set in config.
+	defaultGroupID = "domain"
 )
 
 // Run setup application and run it.
@@ -108,20 +110,32 @@ func Run() error {
 	}()
 	defer kafkaProducer.Close()
 
-	kafkaConsumer, err := kafka.NewConsumer(&cfg.Kafka)
-	if err != nil {
-		return errs.WrapErr(err, "create kafka consumer")
-	}
-	defer kafkaConsumer.SingleConsumer.Close()
-
 	srv := server.New(cfg.Server)
 
 	// Setup source module
 	sourceRepo := sr.New(pg)
-	sourceController, err := sc.New(ctx, sourceRepo, tracer, kafkaProducer, kafkaConsumer)
+	sourceController := sc.New(sourceRepo, tracer, kafkaProducer)
+
+	groupID := cfg.Kafka.GroupID
+	if groupID == "" {
+		groupID = defaultGroupID
+	}
+	sourceConsumer, sourceErrCh, err := kafka.NewConsumerGroup(&cfg.Kafka, groupID)
 	if err != nil {
-		return errs.WrapErr(err, "create source controller")
+		return errs.WrapErr(err, "create kafka consumer group")
 	}
+	defer sourceConsumer.Close()
+	go func() {
+		for e := range sourceErrCh {
+			log.Warn().Err(errs.WrapErr(e)).Msg("kafka source consumer error")
+		}
+	}()
+	go func() {
+		if e := sourceConsumer.Consume(ctx, []string{sc.StatusTopic, sc.SourceDeletedTopic}, sourceController.HandleMessage); e != nil {
+			log.Error().Err(errs.WrapErr(e)).Msg("consuming source topics failed")
+		}
+	}()
+
 	sourceHandler := sh.New(sourceController, tracer)
 	pb.RegisterSourceServiceServer(srv.GetSrv(), sourceHandler)
 
@@ -44,6 +44,19 @@ export interface ChatSessionResponse {
     queryId: number;
     status: number;
     updatedAt: Time;
+    citations?: Citation[];
+}
+
+export interface Citation {
+    id?: number;
+    responseId?: number;
+    documentId?: string;
+    documentName?: string;
+    url?: string;
+    chunkId?: string;
+    snippet?: string;
+    similarity?: number;
+    page?: number;
 }
 
 export interface DisplayedChat {
@@ -86,12 +99,14 @@ export interface WSMessage {
     scenarioID?: number;
     queryMetadata?: QueryMetadata;
     error?: string;
+    citations?: Citation[];
 }
 
 export enum WSMessageType {
     Auth = 'auth',
     Query = 'query',
     Chunk = 'chunk',
+    Citations = 'citations',
     Error = 'error',
 }
 
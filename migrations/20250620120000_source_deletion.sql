@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+create table domain.source_deletion(
+    external_id text primary key,
+    internal_id bigint not null,
+    status int4 not null default 1,
+    error text not null default '',
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table domain.source_deletion;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table domain.source
+    add column job_id text not null default '',
+    add column processed int4 not null default 0,
+    add column total int4 not null default 0,
+    add column status_updated_at timestamp;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table domain.source
+    drop column job_id,
+    drop column processed,
+    drop column total,
+    drop column status_updated_at;
+-- +goose StatementEnd
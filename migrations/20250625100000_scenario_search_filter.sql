@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table domain.scenario
+    add column search_filter jsonb;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table domain.scenario
+    drop column search_filter;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+create table chat.citation(
+    id bigserial primary key,
+    response_id bigint not null references chat.response(id) on delete cascade,
+    document_id text not null default '',
+    document_name text not null default '',
+    url text not null default '',
+    chunk_id text not null default '',
+    snippet text not null default '',
+    similarity real not null default 0,
+    page int,
+    created_at timestamp not null default current_timestamp
+);
+
+create index citation_response_id_idx on chat.citation(response_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index chat.citation_response_id_idx;
+drop table chat.citation;
+-- +goose StatementEnd
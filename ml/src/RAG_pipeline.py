@@ -1,5 +1,7 @@
 from collections.abc import AsyncGenerator
+from dataclasses import dataclass
 
+import data.v1.model_pb2 as data_pb2_model
 import ml.v1.model_pb2 as ml_pb2_model
 from config import (
     DATA_SERVICE_HOST,
@@ -14,6 +16,49 @@ from multi_query import get_multi_questions
 from ollama_client import AsyncOllamaClient
 from rerank import Reranker
 
+NOT_FOUND_CONTENT = "Контент не найден"
+
+
+@dataclass(frozen=True)
+class RetrievedChunk:
+    """Чанк из выдачи сервиса данных с данными для цитирования."""
+
+    chunk_id: str
+    document_id: str
+    document_name: str
+    url: str
+    content: str
+    similarity: float
+
+
+def chunk_contents(chunks: list[RetrievedChunk]) -> list[str]:
+    """Возвращает тексты чанков для контекста модели."""
+    if not chunks:
+        return [NOT_FOUND_CONTENT]
+    return [chunk.content for chunk in chunks]
+
+
+def search_filter(
+    scenario: ml_pb2_model.Scenario,
+) -> data_pb2_model.SearchFilter | None:
+    """Переводит фильтры сценария в фильтр векторного поиска."""
+    if not scenario.vectorSearch.HasField("filter"):
+        return None
+    src = scenario.vectorSearch.filter
+    dst = data_pb2_model.SearchFilter(
+        types=src.types,
+        extensions=src.extensions,
+        urlPrefixes=src.urlPrefixes,
+        languages=src.languages,
+        documentPredicates=src.documentPredicates,
+        chunkPredicates=src.chunkPredicates,
+    )
+    if src.HasField("dateFrom"):
+        dst.dateFrom.CopyFrom(src.dateFrom)
+    if src.HasField("dateTo"):
+        dst.dateTo.CopyFrom(src.dateTo)
+    return dst
+
 
 class RAGPipeline:
     def __init__(self) -> None:
@@ -31,7 +76,7 @@ class RAGPipeline:
 
     async def _prepare_chunks(
         self, request: ml_pb2_model.ProcessQueryRequest
-    ) -> list[str]:
+    ) -> list[RetrievedChunk]:
         questions = [request.query.content]
         if request.scenario.multiQuery.useMultiquery:
             questions += await get_multi_questions(
@@ -43,6 +88,7 @@ class RAGPipeline:
                 else request.scenario.model.modelName,
             )
 
+        scenario_filter = search_filter(request.scenario)
         chunk_dict = {}
         for question in questions:
             search_result = await self.data_client.vector_search(
@@ -51,22 +97,24 @@ class RAGPipeline:
                 top_k=request.scenario.vectorSearch.topN,
                 threshold=request.scenario.vectorSearch.threshold,
                 use_questions=request.scenario.vectorSearch.searchByQuery,
+                search_filter=scenario_filter,
             )
             for chunk in search_result.chunks:
-                chunk_dict[chunk.id] = {
-                    "content": chunk.content,
-                    "similarity": chunk.similarity,
-                }
-        chunks = [
-            chunk["content"]
-            for chunk in sorted(
-                chunk_dict.values(),
-                key=lambda x: x["similarity"],
-                reverse=True,
-            )
-        ]
+                chunk_dict[chunk.id] = RetrievedChunk(
+                    chunk_id=chunk.id,
+                    document_id=chunk.documentId,
+                    document_name=chunk.documentName,
+                    url=chunk.documentUrl,
+                    content=chunk.content,
+                    similarity=chunk.similarity,
+                )
+        chunks = sorted(
+            chunk_dict.values(),
+            key=lambda x: x.similarity,
+            reverse=True,
+        )
         if not chunks:
-            return ["Контент не найден"]
+            return []
         if request.scenario.reranker.useRerank:
             if (
                 request.scenario.reranker.rerankerModel
@@ -79,22 +127,27 @@ class RAGPipeline:
                     reranker_model_name=self.reranker_model_name,
                     device=DEVICE,
                 )
-            chunks = self.reranker.rerank_documents(
+            # реранкер работает с текстами, чанки восстанавливаются по тексту
+            by_content = {chunk.content: chunk for chunk in reversed(chunks)}
+            reranked = self.reranker.rerank_documents(
                 query=request.query.content,
-                documents=chunks,
+                documents=[chunk.content for chunk in chunks],
                 top_k=request.scenario.reranker.topK,
                 max_length=request.scenario.reranker.rerankerMaxLength,
             )
+            chunks = [by_content[content] for content in reranked]
         return chunks
 
     async def generate_stream(
         self,
         request: ml_pb2_model.ProcessQueryRequest,
-    ) -> AsyncGenerator[tuple[str, list[str]], None]:
+    ) -> AsyncGenerator[tuple[str, list[RetrievedChunk]], None]:
         chunks = await self._prepare_chunks(request)
 
         stream = await self.ollama_client.generate(
-            prompt=RAG_PROMPT.format(query=request.query.content, docs=chunks),
+            prompt=RAG_PROMPT.format(
+                query=request.query.content, docs=chunk_contents(chunks)
+            ),
             model=request.scenario.model.modelName,
             stream=True,
             temprature=request.scenario.model.temperature,
@@ -108,11 +161,13 @@ class RAGPipeline:
     async def generate(
         self,
         request: ml_pb2_model.ProcessQueryRequest,
-    ) -> tuple[str, list[str]]:
+    ) -> tuple[str, list[RetrievedChunk]]:
         chunks = await self._prepare_chunks(request)
 
         response = await self.ollama_client.generate(
-            prompt=RAG_PROMPT.format(query=request.query.content, docs=chunks),
+            prompt=RAG_PROMPT.format(
+                query=request.query.content, docs=chunk_contents(chunks)
+            ),
             model=request.scenario.model.modelName,
             stream=False,
             temprature=request.scenario.model.temperature,
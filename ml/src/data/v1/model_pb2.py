@@ -22,9 +22,10 @@ _runtime_version.ValidateProtobufRuntimeVersion(
 _sym_db = _symbol_database.Default()
 
 
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x13\x64\x61ta/v1/model.proto\x12\x07\x64\x61ta.v1\"n\n\x13VectorSearchRequest\x12\r\n\x05query\x18\x01 \x01(\t\x12\x11\n\tsourceIds\x18\x02 \x03(\t\x12\x0c\n\x04topK\x18\x03 \x01(\x04\x12\x11\n\tthreshold\x18\x04 \x01(\x02\x12\x14\n\x0cuseQuestions\x18\x05 \x01(\x08\"a\n\rDocumentChunk\x12\n\n\x02id\x18\x01 \x01(\t\x12\r\n\x05index\x18\x02 \x01(\x03\x12\x0f\n\x07\x63ontent\x18\x03 \x01(\t\x12\x10\n\x08metadata\x18\x04 \x01(\x0c\x12\x12\n\nsimilarity\x18\x05 \x01(\x02\">\n\x14VectorSearchResponse\x12&\n\x06\x63hunks\x18\x01 \x03(\x0b\x32\x16.data.v1.DocumentChunk\">\n\x0eGetDocumentsIn\x12\x10\n\x08sourceId\x18\x01 \x01(\t\x12\x0c\n\x04size\x18\x02 \x01(\r\x12\x0c\n\x04page\x18\x03 \x01(\r\"Y\n\x08\x44ocument\x12\n\n\x02id\x18\x01 \x01(\t\x12\x10\n\x08sourceId\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x0f\n\x07\x63ontent\x18\x04 \x01(\t\x12\x10\n\x08metadata\x18\x05 \x01(\t\"b\n\x0fGetDocumentsOut\x12\x0c\n\x04size\x18\x01 \x01(\r\x12\x0c\n\x04page\x18\x02 \x01(\r\x12\r\n\x05total\x18\x03 \x01(\r\x12$\n\tdocuments\x18\x04 \x03(\x0b\x32\x11.data.v1.DocumentB\x12Z\x10internal/data/pbb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x13\x64\x61ta/v1/model.proto\x12\x07\x64\x61ta.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"]\n\x0cHybridSearch\x12\x14\n\x0cvectorWeight\x18\x01 \x01(\x02\x12\x15\n\rlexicalWeight\x18\x02 \x01(\x02\x12\x0c\n\x04rrfK\x18\x03 \x01(\r\x12\x12\n\ncandidates\x18\x04 \x01(\r\"#\n\x11NeighborExpansion\x12\x0e\n\x06window\x18\x01 \x01(\r\"]\n\x0f\x44iversification\x12\x13\n\x06lambda\x18\x01 \x01(\x02H\x00\x88\x01\x01\x12\x16\n\x0emaxPerDocument\x18\x02 \x01(\r\x12\x12\n\ncandidates\x18\x03 \x01(\rB\t\n\x07_lambda\"\x8a\x02\n\x0cSearchFilter\x12\r\n\x05types\x18\x01 \x03(\t\x12\x12\n\nextensions\x18\x02 \x03(\t\x12\x13\n\x0burlPrefixes\x18\x03 \x03(\t\x12\x31\n\x08\x64\x61teFrom\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x00\x88\x01\x01\x12/\n\x06\x64\x61teTo\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x01\x88\x01\x01\x12\x11\n\tlanguages\x18\x06 \x03(\t\x12\x1a\n\x12\x64ocumentPredicates\x18\x07 \x03(\t\x12\x17\n\x0f\x63hunkPredicates\x18\x08 \x03(\tB\x0b\n\t_dateFromB\t\n\x07_dateTo\"\xd8\x02\n\x13VectorSearchRequest\x12\r\n\x05query\x18\x01 \x01(\t\x12\x11\n\tsourceIds\x18\x02 \x03(\t\x12\x0c\n\x04topK\x18\x03 \x01(\x04\x12\x11\n\tthreshold\x18\x04 \x01(\x02\x12\x14\n\x0cuseQuestions\x18\x05 \x01(\x08\x12*\n\x06hybrid\x18\x06 \x01(\x0b\x32\x15.data.v1.HybridSearchH\x00\x88\x01\x01\x12*\n\x06\x66ilter\x18\x07 \x01(\x0b\x32\x15.data.v1.SearchFilterH\x01\x88\x01\x01\x12\x30\n\tdiversify\x18\x08 \x01(\x0b\x32\x18.data.v1.DiversificationH\x02\x88\x01\x01\x12/\n\x06\x65xpand\x18\t \x01(\x0b\x32\x1a.data.v1.NeighborExpansionH\x03\x88\x01\x01\x42\t\n\x07_hybridB\t\n\x07_filterB\x0c\n\n_diversifyB\t\n\x07_expand\"\xd3\x01\n\rDocumentChunk\x12\n\n\x02id\x18\x01 \x01(\t\x12\r\n\x05index\x18\x02 \x01(\x03\x12\x0f\n\x07\x63ontent\x18\x03 \x01(\t\x12\x10\n\x08metadata\x18\x04 \x01(\x0c\x12\x12\n\nsimilarity\x18\x05 \x01(\x02\x12\r\n\x05score\x18\x06 \x01(\x02\x12\x12\n\ndocumentId\x18\x07 \x01(\t\x12\x10\n\x08\x65ndIndex\x18\x08 \x01(\x03\x12\x10\n\x08\x63hunkIds\x18\t \x03(\t\x12\x14\n\x0c\x64ocumentName\x18\n \x01(\t\x12\x13\n\x0b\x64ocumentUrl\x18\x0b \x01(\t\">\n\x14VectorSearchResponse\x12&\n\x06\x63hunks\x18\x01 \x03(\x0b\x32\x16.data.v1.DocumentChunk\"\xed\x02\n\x18\x42\x61tchVectorSearchRequest\x12\x0f\n\x07queries\x18\x01 \x03(\t\x12\x11\n\tsourceIds\x18\x02 \x03(\t\x12\x0c\n\x04topK\x18\x03 \x01(\x04\x12\x11\n\tthreshold\x18\x04 \x01(\x02\x12\x14\n\x0cuseQuestions\x18\x05 \x01(\x08\x12*\n\x06hybrid\x18\x06 \x01(\x0b\x32\x15.data.v1.HybridSearchH\x00\x88\x01\x01\x12*\n\x06\x66ilter\x18\x07 \x01(\x0b\x32\x15.data.v1.SearchFilterH\x01\x88\x01\x01\x12\x30\n\tdiversify\x18\x08 \x01(\x0b\x32\x18.data.v1.DiversificationH\x02\x88\x01\x01\x12/\n\x06\x65xpand\x18\t \x01(\x0b\x32\x1a.data.v1.NeighborExpansionH\x03\x88\x01\x01\x12\x0c\n\x04rrfK\x18\n \x01(\rB\t\n\x07_hybridB\t\n\x07_filterB\x0c\n\n_diversifyB\t\n\x07_expand\";\n\x08QueryHit\x12\r\n\x05query\x18\x01 \x01(\r\x12\x0c\n\x04rank\x18\x02 \x01(\r\x12\x12\n\nsimilarity\x18\x03 \x01(\x02\"Z\n\x10\x42\x61tchSearchChunk\x12%\n\x05\x63hunk\x18\x01 \x01(\x0b\x32\x16.data.v1.DocumentChunk\x12\x1f\n\x04hits\x18\x02 \x03(\x0b\x32\x11.data.v1.QueryHit\"F\n\x19\x42\x61tchVectorSearchResponse\x12)\n\x06\x63hunks\x18\x01 \x03(\x0b\x32\x19.data.v1.BatchSearchChunk\">\n\x0eGetDocumentsIn\x12\x10\n\x08sourceId\x18\x01 \x01(\t\x12\x0c\n\x04size\x18\x02 \x01(\r\x12\x0c\n\x04page\x18\x03 \x01(\r\"\xb9\x02\n\x08\x44ocument\x12\n\n\x02id\x18\x01 \x01(\t\x12\x10\n\x08sourceId\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x0f\n\x07\x63ontent\x18\x04 \x01(\t\x12\x10\n\x08metadata\x18\x05 \x01(\t\x12\x10\n\x08objectId\x18\x06 \x01(\t\x12\x12\n\nobjectType\x18\x07 \x01(\t\x12\x11\n\textension\x18\x08 \x01(\t\x12\x0b\n\x03url\x18\t \x01(\t\x12\x10\n\x08language\x18\n \x01(\t\x12(\n\x04\x64\x61te\x18\x0b \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\tcreatedAt\x18\x0c \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\tupdatedAt\x18\r \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"3\n\x12GetDocumentRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\tsourceIds\x18\x02 \x03(\t\"B\n\x10GetChunksRequest\x12\x0b\n\x03ids\x18\x01 \x03(\t\x12\x11\n\tsourceIds\x18\x02 \x03(\t\x12\x0e\n\x06window\x18\x03 \x01(\r\"O\n\x11GetChunksResponse\x12&\n\x06\x63hunks\x18\x01 \x03(\x0b\x32\x16.data.v1.DocumentChunk\x12\x12\n\nmissingIds\x18\x02 \x03(\t\"@\n\x17\x44ownloadOriginalRequest\x12\x12\n\ndocumentId\x18\x01 \x01(\t\x12\x11\n\tsourceIds\x18\x02 \x03(\t\"W\n\x0cOriginalInfo\x12\x10\n\x08\x66ilename\x18\x01 \x01(\t\x12\x13\n\x0b\x63ontentType\x18\x02 \x01(\t\x12\x0c\n\x04size\x18\x03 \x01(\x03\x12\x12\n\nobjectType\x18\x04 \x01(\t\"\\\n\x18\x44ownloadOriginalResponse\x12%\n\x04info\x18\x01 \x01(\x0b\x32\x15.data.v1.OriginalInfoH\x00\x12\x0e\n\x04\x64\x61ta\x18\x02 \x01(\x0cH\x00\x42\t\n\x07payload\"b\n\x0fGetDocumentsOut\x12\x0c\n\x04size\x18\x01 \x01(\r\x12\x0c\n\x04page\x18\x02 \x01(\r\x12\r\n\x05total\x18\x03 \x01(\r\x12$\n\tdocuments\x18\x04 \x03(\x0b\x32\x11.data.v1.Document\")\n\x0bTableColumn\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\"\x8b\x01\n\x0fStructuredTable\x12\n\n\x02id\x18\x01 \x01(\t\x12\x10\n\x08sourceId\x18\x02 \x01(\t\x12\x12\n\ndocumentId\x18\x03 \x01(\t\x12\r\n\x05sheet\x18\x04 \x01(\t\x12%\n\x07\x63olumns\x18\x05 \x03(\x0b\x32\x14.data.v1.TableColumn\x12\x10\n\x08rowCount\x18\x06 \x01(\x04\"&\n\x11ListTablesRequest\x12\x11\n\tsourceIds\x18\x01 \x03(\t\">\n\x12ListTablesResponse\x12(\n\x06tables\x18\x01 \x03(\x0b\x32\x18.data.v1.StructuredTable\"K\n\x0b\x41ggregation\x12,\n\x08\x66unction\x18\x01 \x01(\x0e\x32\x1a.data.v1.AggregateFunction\x12\x0e\n\x06\x63olumn\x18\x02 \x01(\t\"X\n\x0bTableFilter\x12\x0e\n\x06\x63olumn\x18\x01 \x01(\t\x12)\n\x08operator\x18\x02 \x01(\x0e\x32\x17.data.v1.FilterOperator\x12\x0e\n\x06values\x18\x03 \x03(\t\"\xae\x01\n\x15\x41ggregateTableRequest\x12\x0f\n\x07tableId\x18\x01 \x01(\t\x12*\n\x0c\x61ggregations\x18\x02 \x03(\x0b\x32\x14.data.v1.Aggregation\x12\x0f\n\x07groupBy\x18\x03 \x03(\t\x12%\n\x07\x66ilters\x18\x04 \x03(\x0b\x32\x14.data.v1.TableFilter\x12\r\n\x05limit\x18\x05 \x01(\r\x12\x11\n\tsourceIds\x18\x06 \x03(\t\"\x1e\n\x0c\x41ggregateRow\x12\x0e\n\x06values\x18\x01 \x03(\t\"a\n\x16\x41ggregateTableResponse\x12\x0f\n\x07\x63olumns\x18\x01 \x03(\t\x12#\n\x04rows\x18\x02 \x03(\x0b\x32\x15.data.v1.AggregateRow\x12\x11\n\ttruncated\x18\x03 \x01(\x08\"\x80\x02\n\x0fIngestionObject\x12\n\n\x02id\x18\x01 \x01(\t\x12*\n\x04type\x18\x02 \x01(\x0e\x32\x1c.data.v1.IngestionObjectType\x12\x0c\n\x04name\x18\x03 \x01(\t\x12&\n\x05state\x18\x04 \x01(\x0e\x32\x17.data.v1.IngestionState\x12\r\n\x05\x65rror\x18\x05 \x01(\t\x12\x12\n\nchunkCount\x18\x06 \x01(\r\x12-\n\tcreatedAt\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\tupdatedAt\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"L\n\x13IngestionStateCount\x12&\n\x05state\x18\x01 \x01(\x0e\x32\x17.data.v1.IngestionState\x12\r\n\x05\x63ount\x18\x02 \x01(\r\"\x9e\x01\n\x19GetIngestionReportRequest\x12\x10\n\x08sourceId\x18\x01 \x01(\t\x12\'\n\x06states\x18\x02 \x03(\x0e\x32\x17.data.v1.IngestionState\x12*\n\x04type\x18\x03 \x01(\x0e\x32\x1c.data.v1.IngestionObjectType\x12\x0c\n\x04size\x18\x04 \x01(\r\x12\x0c\n\x04page\x18\x05 \x01(\r\"\xb2\x01\n\x1aGetIngestionReportResponse\x12\x10\n\x08sourceId\x18\x01 \x01(\t\x12\x0c\n\x04size\x18\x02 \x01(\r\x12\x0c\n\x04page\x18\x03 \x01(\r\x12\r\n\x05total\x18\x04 \x01(\r\x12,\n\x06\x63ounts\x18\x05 \x03(\x0b\x32\x1c.data.v1.IngestionStateCount\x12)\n\x07objects\x18\x06 \x03(\x0b\x32\x18.data.v1.IngestionObject\"\x9e\x02\n\nDeadLetter\x12\n\n\x02id\x18\x01 \x01(\t\x12\r\n\x05queue\x18\x02 \x01(\t\x12\x0f\n\x07payload\x18\x03 \x01(\t\x12\x33\n\x08metadata\x18\x04 \x03(\x0b\x32!.data.v1.DeadLetter.MetadataEntry\x12\r\n\x05\x65rror\x18\x05 \x01(\t\x12\x10\n\x08\x61ttempts\x18\x06 \x01(\r\x12-\n\tcreatedAt\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nreplayedAt\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"C\n\x16ListDeadLettersRequest\x12\r\n\x05queue\x18\x01 \x01(\t\x12\x0c\n\x04size\x18\x02 \x01(\r\x12\x0c\n\x04page\x18\x03 \x01(\r\"n\n\x17ListDeadLettersResponse\x12\x0c\n\x04size\x18\x01 \x01(\r\x12\x0c\n\x04page\x18\x02 \x01(\r\x12\r\n\x05total\x18\x03 \x01(\r\x12(\n\x0b\x64\x65\x61\x64Letters\x18\x04 \x03(\x0b\x32\x13.data.v1.DeadLetter\"1\n\x14GetDeadLetterRequest\x12\r\n\x05queue\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\"6\n\x18ReplayDeadLettersRequest\x12\r\n\x05queue\x18\x01 \x01(\t\x12\x0b\n\x03ids\x18\x02 \x03(\t\"-\n\x19ReplayDeadLettersResponse\x12\x10\n\x08replayed\x18\x01 \x01(\r\"\xbc\x02\n\x0e\x45mbeddingModel\x12\n\n\x02id\x18\x01 \x01(\x03\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\x12\n\ndimensions\x18\x04 \x01(\r\x12\x11\n\tindexType\x18\x05 \x01(\t\x12\x0e\n\x06\x61\x63tive\x18\x06 \x01(\x08\x12\x12\n\nconfigured\x18\x07 \x01(\x08\x12\x0e\n\x06\x63hunks\x18\x08 \x01(\x04\x12\x16\n\x0e\x65mbeddedChunks\x18\t \x01(\x04\x12\x11\n\tquestions\x18\n \x01(\x04\x12\x19\n\x11\x65mbeddedQuestions\x18\x0b \x01(\x04\x12-\n\tcreatedAt\x18\x0c \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12/\n\x0b\x61\x63tivatedAt\x18\r \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\x1c\n\x1aListEmbeddingModelsRequest\"F\n\x1bListEmbeddingModelsResponse\x12\'\n\x06models\x18\x01 \x03(\x0b\x32\x17.data.v1.EmbeddingModel\"_\n\x17StartReembeddingRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\x03\x12\x15\n\x08sourceId\x18\x02 \x01(\tH\x00\x88\x01\x01\x12\x0f\n\x07\x63utOver\x18\x03 \x01(\x08\x42\x0b\n\t_sourceId\")\n\x18StartReembeddingResponse\x12\r\n\x05jobId\x18\x01 \x01(\t\"?\n\x1d\x41\x63tivateEmbeddingModelRequest\x12\x0f\n\x07modelId\x18\x01 \x01(\x03\x12\r\n\x05\x66orce\x18\x02 \x01(\x08*\x8d\x01\n\x11\x41ggregateFunction\x12\x17\n\x13\x41GGREGATE_UNDEFINED\x10\x00\x12\x13\n\x0f\x41GGREGATE_COUNT\x10\x01\x12\x11\n\rAGGREGATE_SUM\x10\x02\x12\x11\n\rAGGREGATE_AVG\x10\x03\x12\x11\n\rAGGREGATE_MIN\x10\x04\x12\x11\n\rAGGREGATE_MAX\x10\x05*\x91\x01\n\x0e\x46ilterOperator\x12\x14\n\x10\x46ILTER_UNDEFINED\x10\x00\x12\r\n\tFILTER_EQ\x10\x01\x12\r\n\tFILTER_NE\x10\x02\x12\r\n\tFILTER_GT\x10\x03\x12\x0e\n\nFILTER_GTE\x10\x04\x12\r\n\tFILTER_LT\x10\x05\x12\x0e\n\nFILTER_LTE\x10\x06\x12\r\n\tFILTER_IN\x10\x07*\xb1\x01\n\x0eIngestionState\x12\x17\n\x13INGESTION_UNDEFINED\x10\x00\x12\x14\n\x10INGESTION_QUEUED\x10\x01\x12\x15\n\x11INGESTION_FETCHED\x10\x02\x12\x14\n\x10INGESTION_PARSED\x10\x03\x12\x16\n\x12INGESTION_EMBEDDED\x10\x04\x12\x15\n\x11INGESTION_SKIPPED\x10\x05\x12\x14\n\x10INGESTION_FAILED\x10\x06*M\n\x13IngestionObjectType\x12\x14\n\x10OBJECT_UNDEFINED\x10\x00\x12\x0f\n\x0bOBJECT_PAGE\x10\x01\x12\x0f\n\x0bOBJECT_FILE\x10\x02\x42\x12Z\x10internal/data/pbb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -32,16 +33,106 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'data.v1.model_pb2', _global
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z\020internal/data/pb'
-  _globals['_VECTORSEARCHREQUEST']._serialized_start=32
-  _globals['_VECTORSEARCHREQUEST']._serialized_end=142
-  _globals['_DOCUMENTCHUNK']._serialized_start=144
-  _globals['_DOCUMENTCHUNK']._serialized_end=241
-  _globals['_VECTORSEARCHRESPONSE']._serialized_start=243
-  _globals['_VECTORSEARCHRESPONSE']._serialized_end=305
-  _globals['_GETDOCUMENTSIN']._serialized_start=307
-  _globals['_GETDOCUMENTSIN']._serialized_end=369
-  _globals['_DOCUMENT']._serialized_start=371
-  _globals['_DOCUMENT']._serialized_end=460
-  _globals['_GETDOCUMENTSOUT']._serialized_start=462
-  _globals['_GETDOCUMENTSOUT']._serialized_end=560
+  _globals['_DEADLETTER_METADATAENTRY']._loaded_options = None
+  _globals['_DEADLETTER_METADATAENTRY']._serialized_options = b'8\001'
+  _globals['_AGGREGATEFUNCTION']._serialized_start=5404
+  _globals['_AGGREGATEFUNCTION']._serialized_end=5545
+  _globals['_FILTEROPERATOR']._serialized_start=5548
+  _globals['_FILTEROPERATOR']._serialized_end=5693
+  _globals['_INGESTIONSTATE']._serialized_start=5696
+  _globals['_INGESTIONSTATE']._serialized_end=5873
+  _globals['_INGESTIONOBJECTTYPE']._serialized_start=5875
+  _globals['_INGESTIONOBJECTTYPE']._serialized_end=5952
+  _globals['_HYBRIDSEARCH']._serialized_start=65
+  _globals['_HYBRIDSEARCH']._serialized_end=158
+  _globals['_NEIGHBOREXPANSION']._serialized_start=160
+  _globals['_NEIGHBOREXPANSION']._serialized_end=195
+  _globals['_DIVERSIFICATION']._serialized_start=197
+  _globals['_DIVERSIFICATION']._serialized_end=290
+  _globals['_SEARCHFILTER']._serialized_start=293
+  _globals['_SEARCHFILTER']._serialized_end=559
+  _globals['_VECTORSEARCHREQUEST']._serialized_start=562
+  _globals['_VECTORSEARCHREQUEST']._serialized_end=906
+  _globals['_DOCUMENTCHUNK']._serialized_start=909
+  _globals['_DOCUMENTCHUNK']._serialized_end=1120
+  _globals['_VECTORSEARCHRESPONSE']._serialized_start=1122
+  _globals['_VECTORSEARCHRESPONSE']._serialized_end=1184
+  _globals['_BATCHVECTORSEARCHREQUEST']._serialized_start=1187
+  _globals['_BATCHVECTORSEARCHREQUEST']._serialized_end=1552
+  _globals['_QUERYHIT']._serialized_start=1554
+  _globals['_QUERYHIT']._serialized_end=1613
+  _globals['_BATCHSEARCHCHUNK']._serialized_start=1615
+  _globals['_BATCHSEARCHCHUNK']._serialized_end=1705
+  _globals['_BATCHVECTORSEARCHRESPONSE']._serialized_start=1707
+  _globals['_BATCHVECTORSEARCHRESPONSE']._serialized_end=1777
+  _globals['_GETDOCUMENTSIN']._serialized_start=1779
+  _globals['_GETDOCUMENTSIN']._serialized_end=1841
+  _globals['_DOCUMENT']._serialized_start=1844
+  _globals['_DOCUMENT']._serialized_end=2157
+  _globals['_GETDOCUMENTREQUEST']._serialized_start=2159
+  _globals['_GETDOCUMENTREQUEST']._serialized_end=2210
+  _globals['_GETCHUNKSREQUEST']._serialized_start=2212
+  _globals['_GETCHUNKSREQUEST']._serialized_end=2278
+  _globals['_GETCHUNKSRESPONSE']._serialized_start=2280
+  _globals['_GETCHUNKSRESPONSE']._serialized_end=2359
+  _globals['_DOWNLOADORIGINALREQUEST']._serialized_start=2361
+  _globals['_DOWNLOADORIGINALREQUEST']._serialized_end=2425
+  _globals['_ORIGINALINFO']._serialized_start=2427
+  _globals['_ORIGINALINFO']._serialized_end=2514
+  _globals['_DOWNLOADORIGINALRESPONSE']._serialized_start=2516
+  _globals['_DOWNLOADORIGINALRESPONSE']._serialized_end=2608
+  _globals['_GETDOCUMENTSOUT']._serialized_start=2610
+  _globals['_GETDOCUMENTSOUT']._serialized_end=2708
+  _globals['_TABLECOLUMN']._serialized_start=2710
+  _globals['_TABLECOLUMN']._serialized_end=2751
+  _globals['_STRUCTUREDTABLE']._serialized_start=2754
+  _globals['_STRUCTUREDTABLE']._serialized_end=2893
+  _globals['_LISTTABLESREQUEST']._serialized_start=2895
+  _globals['_LISTTABLESREQUEST']._serialized_end=2933
+  _globals['_LISTTABLESRESPONSE']._serialized_start=2935
+  _globals['_LISTTABLESRESPONSE']._serialized_end=2997
+  _globals['_AGGREGATION']._serialized_start=2999
+  _globals['_AGGREGATION']._serialized_end=3074
+  _globals['_TABLEFILTER']._serialized_start=3076
+  _globals['_TABLEFILTER']._serialized_end=3164
+  _globals['_AGGREGATETABLEREQUEST']._serialized_start=3167
+  _globals['_AGGREGATETABLEREQUEST']._serialized_end=3341
+  _globals['_AGGREGATEROW']._serialized_start=3343
+  _globals['_AGGREGATEROW']._serialized_end=3373
+  _globals['_AGGREGATETABLERESPONSE']._serialized_start=3375
+  _globals['_AGGREGATETABLERESPONSE']._serialized_end=3472
+  _globals['_INGESTIONOBJECT']._serialized_start=3475
+  _globals['_INGESTIONOBJECT']._serialized_end=3731
+  _globals['_INGESTIONSTATECOUNT']._serialized_start=3733
+  _globals['_INGESTIONSTATECOUNT']._serialized_end=3809
+  _globals['_GETINGESTIONREPORTREQUEST']._serialized_start=3812
+  _globals['_GETINGESTIONREPORTREQUEST']._serialized_end=3970
+  _globals['_GETINGESTIONREPORTRESPONSE']._serialized_start=3973
+  _globals['_GETINGESTIONREPORTRESPONSE']._serialized_end=4151
+  _globals['_DEADLETTER']._serialized_start=4154
+  _globals['_DEADLETTER']._serialized_end=4440
+  _globals['_DEADLETTER_METADATAENTRY']._serialized_start=4393
+  _globals['_DEADLETTER_METADATAENTRY']._serialized_end=4440
+  _globals['_LISTDEADLETTERSREQUEST']._serialized_start=4442
+  _globals['_LISTDEADLETTERSREQUEST']._serialized_end=4509
+  _globals['_LISTDEADLETTERSRESPONSE']._serialized_start=4511
+  _globals['_LISTDEADLETTERSRESPONSE']._serialized_end=4621
+  _globals['_GETDEADLETTERREQUEST']._serialized_start=4623
+  _globals['_GETDEADLETTERREQUEST']._serialized_end=4672
+  _globals['_REPLAYDEADLETTERSREQUEST']._serialized_start=4674
+  _globals['_REPLAYDEADLETTERSREQUEST']._serialized_end=4728
+  _globals['_REPLAYDEADLETTERSRESPONSE']._serialized_start=4730
+  _globals['_REPLAYDEADLETTERSRESPONSE']._serialized_end=4775
+  _globals['_EMBEDDINGMODEL']._serialized_start=4778
+  _globals['_EMBEDDINGMODEL']._serialized_end=5094
+  _globals['_LISTEMBEDDINGMODELSREQUEST']._serialized_start=5096
+  _globals['_LISTEMBEDDINGMODELSREQUEST']._serialized_end=5124
+  _globals['_LISTEMBEDDINGMODELSRESPONSE']._serialized_start=5126
+  _globals['_LISTEMBEDDINGMODELSRESPONSE']._serialized_end=5196
+  _globals['_STARTREEMBEDDINGREQUEST']._serialized_start=5198
+  _globals['_STARTREEMBEDDINGREQUEST']._serialized_end=5293
+  _globals['_STARTREEMBEDDINGRESPONSE']._serialized_start=5295
+  _globals['_STARTREEMBEDDINGRESPONSE']._serialized_end=5336
+  _globals['_ACTIVATEEMBEDDINGMODELREQUEST']._serialized_start=5338
+  _globals['_ACTIVATEEMBEDDINGMODELREQUEST']._serialized_end=5401
 # @@protoc_insertion_point(module_scope)
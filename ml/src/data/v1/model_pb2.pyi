@@ -1,37 +1,167 @@
+from google.protobuf import timestamp_pb2 as _timestamp_pb2
 from google.protobuf.internal import containers as _containers
+from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
 from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Mapping, Optional as _Optional, Union as _Union
 
 DESCRIPTOR: _descriptor.FileDescriptor
 
+class AggregateFunction(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    AGGREGATE_UNDEFINED: _ClassVar[AggregateFunction]
+    AGGREGATE_COUNT: _ClassVar[AggregateFunction]
+    AGGREGATE_SUM: _ClassVar[AggregateFunction]
+    AGGREGATE_AVG: _ClassVar[AggregateFunction]
+    AGGREGATE_MIN: _ClassVar[AggregateFunction]
+    AGGREGATE_MAX: _ClassVar[AggregateFunction]
+
+class FilterOperator(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    FILTER_UNDEFINED: _ClassVar[FilterOperator]
+    FILTER_EQ: _ClassVar[FilterOperator]
+    FILTER_NE: _ClassVar[FilterOperator]
+    FILTER_GT: _ClassVar[FilterOperator]
+    FILTER_GTE: _ClassVar[FilterOperator]
+    FILTER_LT: _ClassVar[FilterOperator]
+    FILTER_LTE: _ClassVar[FilterOperator]
+    FILTER_IN: _ClassVar[FilterOperator]
+
+class IngestionState(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    INGESTION_UNDEFINED: _ClassVar[IngestionState]
+    INGESTION_QUEUED: _ClassVar[IngestionState]
+    INGESTION_FETCHED: _ClassVar[IngestionState]
+    INGESTION_PARSED: _ClassVar[IngestionState]
+    INGESTION_EMBEDDED: _ClassVar[IngestionState]
+    INGESTION_SKIPPED: _ClassVar[IngestionState]
+    INGESTION_FAILED: _ClassVar[IngestionState]
+
+class IngestionObjectType(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    OBJECT_UNDEFINED: _ClassVar[IngestionObjectType]
+    OBJECT_PAGE: _ClassVar[IngestionObjectType]
+    OBJECT_FILE: _ClassVar[IngestionObjectType]
+AGGREGATE_UNDEFINED: AggregateFunction
+AGGREGATE_COUNT: AggregateFunction
+AGGREGATE_SUM: AggregateFunction
+AGGREGATE_AVG: AggregateFunction
+AGGREGATE_MIN: AggregateFunction
+AGGREGATE_MAX: AggregateFunction
+FILTER_UNDEFINED: FilterOperator
+FILTER_EQ: FilterOperator
+FILTER_NE: FilterOperator
+FILTER_GT: FilterOperator
+FILTER_GTE: FilterOperator
+FILTER_LT: FilterOperator
+FILTER_LTE: FilterOperator
+FILTER_IN: FilterOperator
+INGESTION_UNDEFINED: IngestionState
+INGESTION_QUEUED: IngestionState
+INGESTION_FETCHED: IngestionState
+INGESTION_PARSED: IngestionState
+INGESTION_EMBEDDED: IngestionState
+INGESTION_SKIPPED: IngestionState
+INGESTION_FAILED: IngestionState
+OBJECT_UNDEFINED: IngestionObjectType
+OBJECT_PAGE: IngestionObjectType
+OBJECT_FILE: IngestionObjectType
+
+class HybridSearch(_message.Message):
+    __slots__ = ("vectorWeight", "lexicalWeight", "rrfK", "candidates")
+    VECTORWEIGHT_FIELD_NUMBER: _ClassVar[int]
+    LEXICALWEIGHT_FIELD_NUMBER: _ClassVar[int]
+    RRFK_FIELD_NUMBER: _ClassVar[int]
+    CANDIDATES_FIELD_NUMBER: _ClassVar[int]
+    vectorWeight: float
+    lexicalWeight: float
+    rrfK: int
+    candidates: int
+    def __init__(self, vectorWeight: _Optional[float] = ..., lexicalWeight: _Optional[float] = ..., rrfK: _Optional[int] = ..., candidates: _Optional[int] = ...) -> None: ...
+
+class NeighborExpansion(_message.Message):
+    __slots__ = ("window",)
+    WINDOW_FIELD_NUMBER: _ClassVar[int]
+    window: int
+    def __init__(self, window: _Optional[int] = ...) -> None: ...
+
+class Diversification(_message.Message):
+    __slots__ = ("lambda", "maxPerDocument", "candidates")
+    LAMBDA_FIELD_NUMBER: _ClassVar[int]
+    MAXPERDOCUMENT_FIELD_NUMBER: _ClassVar[int]
+    CANDIDATES_FIELD_NUMBER: _ClassVar[int]
+    lambda: float
+    maxPerDocument: int
+    candidates: int
+    def __init__(self, lambda: _Optional[float] = ..., maxPerDocument: _Optional[int] = ..., candidates: _Optional[int] = ...) -> None: ...
+
+class SearchFilter(_message.Message):
+    __slots__ = ("types", "extensions", "urlPrefixes", "dateFrom", "dateTo", "languages", "documentPredicates", "chunkPredicates")
+    TYPES_FIELD_NUMBER: _ClassVar[int]
+    EXTENSIONS_FIELD_NUMBER: _ClassVar[int]
+    URLPREFIXES_FIELD_NUMBER: _ClassVar[int]
+    DATEFROM_FIELD_NUMBER: _ClassVar[int]
+    DATETO_FIELD_NUMBER: _ClassVar[int]
+    LANGUAGES_FIELD_NUMBER: _ClassVar[int]
+    DOCUMENTPREDICATES_FIELD_NUMBER: _ClassVar[int]
+    CHUNKPREDICATES_FIELD_NUMBER: _ClassVar[int]
+    types: _containers.RepeatedScalarFieldContainer[str]
+    extensions: _containers.RepeatedScalarFieldContainer[str]
+    urlPrefixes: _containers.RepeatedScalarFieldContainer[str]
+    dateFrom: _timestamp_pb2.Timestamp
+    dateTo: _timestamp_pb2.Timestamp
+    languages: _containers.RepeatedScalarFieldContainer[str]
+    documentPredicates: _containers.RepeatedScalarFieldContainer[str]
+    chunkPredicates: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, types: _Optional[_Iterable[str]] = ..., extensions: _Optional[_Iterable[str]] = ..., urlPrefixes: _Optional[_Iterable[str]] = ..., dateFrom: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., dateTo: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., languages: _Optional[_Iterable[str]] = ..., documentPredicates: _Optional[_Iterable[str]] = ..., chunkPredicates: _Optional[_Iterable[str]] = ...) -> None: ...
+
 class VectorSearchRequest(_message.Message):
-    __slots__ = ("query", "sourceIds", "topK", "threshold", "useQuestions")
+    __slots__ = ("query", "sourceIds", "topK", "threshold", "useQuestions", "hybrid", "filter", "diversify", "expand")
     QUERY_FIELD_NUMBER: _ClassVar[int]
     SOURCEIDS_FIELD_NUMBER: _ClassVar[int]
     TOPK_FIELD_NUMBER: _ClassVar[int]
     THRESHOLD_FIELD_NUMBER: _ClassVar[int]
     USEQUESTIONS_FIELD_NUMBER: _ClassVar[int]
+    HYBRID_FIELD_NUMBER: _ClassVar[int]
+    FILTER_FIELD_NUMBER: _ClassVar[int]
+    DIVERSIFY_FIELD_NUMBER: _ClassVar[int]
+    EXPAND_FIELD_NUMBER: _ClassVar[int]
     query: str
     sourceIds: _containers.RepeatedScalarFieldContainer[str]
     topK: int
     threshold: float
     useQuestions: bool
-    def __init__(self, query: _Optional[str] = ..., sourceIds: _Optional[_Iterable[str]] = ..., topK: _Optional[int] = ..., threshold: _Optional[float] = ..., useQuestions: bool = ...) -> None: ...
+    hybrid: HybridSearch
+    filter: SearchFilter
+    diversify: Diversification
+    expand: NeighborExpansion
+    def __init__(self, query: _Optional[str] = ..., sourceIds: _Optional[_Iterable[str]] = ..., topK: _Optional[int] = ..., threshold: _Optional[float] = ..., useQuestions: bool = ..., hybrid: _Optional[_Union[HybridSearch, _Mapping]] = ..., filter: _Optional[_Union[SearchFilter, _Mapping]] = ..., diversify: _Optional[_Union[Diversification, _Mapping]] = ..., expand: _Optional[_Union[NeighborExpansion, _Mapping]] = ...) -> None: ...
 
 class DocumentChunk(_message.Message):
-    __slots__ = ("id", "index", "content", "metadata", "similarity")
+    __slots__ = ("id", "index", "content", "metadata", "similarity", "score", "documentId", "endIndex", "chunkIds", "documentName", "documentUrl")
     ID_FIELD_NUMBER: _ClassVar[int]
     INDEX_FIELD_NUMBER: _ClassVar[int]
     CONTENT_FIELD_NUMBER: _ClassVar[int]
     METADATA_FIELD_NUMBER: _ClassVar[int]
     SIMILARITY_FIELD_NUMBER: _ClassVar[int]
+    SCORE_FIELD_NUMBER: _ClassVar[int]
+    DOCUMENTID_FIELD_NUMBER: _ClassVar[int]
+    ENDINDEX_FIELD_NUMBER: _ClassVar[int]
+    CHUNKIDS_FIELD_NUMBER: _ClassVar[int]
+    DOCUMENTNAME_FIELD_NUMBER: _ClassVar[int]
+    DOCUMENTURL_FIELD_NUMBER: _ClassVar[int]
     id: str
     index: int
     content: str
     metadata: bytes
     similarity: float
-    def __init__(self, id: _Optional[str] = ..., index: _Optional[int] = ..., content: _Optional[str] = ..., metadata: _Optional[bytes] = ..., similarity: _Optional[float] = ...) -> None: ...
+    score: float
+    documentId: str
+    endIndex: int
+    chunkIds: _containers.RepeatedScalarFieldContainer[str]
+    documentName: str
+    documentUrl: str
+    def __init__(self, id: _Optional[str] = ..., index: _Optional[int] = ..., content: _Optional[str] = ..., metadata: _Optional[bytes] = ..., similarity: _Optional[float] = ..., score: _Optional[float] = ..., documentId: _Optional[str] = ..., endIndex: _Optional[int] = ..., chunkIds: _Optional[_Iterable[str]] = ..., documentName: _Optional[str] = ..., documentUrl: _Optional[str] = ...) -> None: ...
 
 class VectorSearchResponse(_message.Message):
     __slots__ = ("chunks",)
@@ -39,6 +169,54 @@ class VectorSearchResponse(_message.Message):
     chunks: _containers.RepeatedCompositeFieldContainer[DocumentChunk]
     def __init__(self, chunks: _Optional[_Iterable[_Union[DocumentChunk, _Mapping]]] = ...) -> None: ...
 
+class BatchVectorSearchRequest(_message.Message):
+    __slots__ = ("queries", "sourceIds", "topK", "threshold", "useQuestions", "hybrid", "filter", "diversify", "expand", "rrfK")
+    QUERIES_FIELD_NUMBER: _ClassVar[int]
+    SOURCEIDS_FIELD_NUMBER: _ClassVar[int]
+    TOPK_FIELD_NUMBER: _ClassVar[int]
+    THRESHOLD_FIELD_NUMBER: _ClassVar[int]
+    USEQUESTIONS_FIELD_NUMBER: _ClassVar[int]
+    HYBRID_FIELD_NUMBER: _ClassVar[int]
+    FILTER_FIELD_NUMBER: _ClassVar[int]
+    DIVERSIFY_FIELD_NUMBER: _ClassVar[int]
+    EXPAND_FIELD_NUMBER: _ClassVar[int]
+    RRFK_FIELD_NUMBER: _ClassVar[int]
+    queries: _containers.RepeatedScalarFieldContainer[str]
+    sourceIds: _containers.RepeatedScalarFieldContainer[str]
+    topK: int
+    threshold: float
+    useQuestions: bool
+    hybrid: HybridSearch
+    filter: SearchFilter
+    diversify: Diversification
+    expand: NeighborExpansion
+    rrfK: int
+    def __init__(self, queries: _Optional[_Iterable[str]] = ..., sourceIds: _Optional[_Iterable[str]] = ..., topK: _Optional[int] = ..., threshold: _Optional[float] = ..., useQuestions: bool = ..., hybrid: _Optional[_Union[HybridSearch, _Mapping]] = ..., filter: _Optional[_Union[SearchFilter, _Mapping]] = ..., diversify: _Optional[_Union[Diversification, _Mapping]] = ..., expand: _Optional[_Union[NeighborExpansion, _Mapping]] = ..., rrfK: _Optional[int] = ...) -> None: ...
+
+class QueryHit(_message.Message):
+    __slots__ = ("query", "rank", "similarity")
+    QUERY_FIELD_NUMBER: _ClassVar[int]
+    RANK_FIELD_NUMBER: _ClassVar[int]
+    SIMILARITY_FIELD_NUMBER: _ClassVar[int]
+    query: int
+    rank: int
+    similarity: float
+    def __init__(self, query: _Optional[int] = ..., rank: _Optional[int] = ..., similarity: _Optional[float] = ...) -> None: ...
+
+class BatchSearchChunk(_message.Message):
+    __slots__ = ("chunk", "hits")
+    CHUNK_FIELD_NUMBER: _ClassVar[int]
+    HITS_FIELD_NUMBER: _ClassVar[int]
+    chunk: DocumentChunk
+    hits: _containers.RepeatedCompositeFieldContainer[QueryHit]
+    def __init__(self, chunk: _Optional[_Union[DocumentChunk, _Mapping]] = ..., hits: _Optional[_Iterable[_Union[QueryHit, _Mapping]]] = ...) -> None: ...
+
+class BatchVectorSearchResponse(_message.Message):
+    __slots__ = ("chunks",)
+    CHUNKS_FIELD_NUMBER: _ClassVar[int]
+    chunks: _containers.RepeatedCompositeFieldContainer[BatchSearchChunk]
+    def __init__(self, chunks: _Optional[_Iterable[_Union[BatchSearchChunk, _Mapping]]] = ...) -> None: ...
+
 class GetDocumentsIn(_message.Message):
     __slots__ = ("sourceId", "size", "page")
     SOURCEID_FIELD_NUMBER: _ClassVar[int]
@@ -50,18 +228,88 @@ class GetDocumentsIn(_message.Message):
     def __init__(self, sourceId: _Optional[str] = ..., size: _Optional[int] = ..., page: _Optional[int] = ...) -> None: ...
 
 class Document(_message.Message):
-    __slots__ = ("id", "sourceId", "name", "content", "metadata")
+    __slots__ = ("id", "sourceId", "name", "content", "metadata", "objectId", "objectType", "extension", "url", "language", "date", "createdAt", "updatedAt")
     ID_FIELD_NUMBER: _ClassVar[int]
     SOURCEID_FIELD_NUMBER: _ClassVar[int]
     NAME_FIELD_NUMBER: _ClassVar[int]
     CONTENT_FIELD_NUMBER: _ClassVar[int]
     METADATA_FIELD_NUMBER: _ClassVar[int]
+    OBJECTID_FIELD_NUMBER: _ClassVar[int]
+    OBJECTTYPE_FIELD_NUMBER: _ClassVar[int]
+    EXTENSION_FIELD_NUMBER: _ClassVar[int]
+    URL_FIELD_NUMBER: _ClassVar[int]
+    LANGUAGE_FIELD_NUMBER: _ClassVar[int]
+    DATE_FIELD_NUMBER: _ClassVar[int]
+    CREATEDAT_FIELD_NUMBER: _ClassVar[int]
+    UPDATEDAT_FIELD_NUMBER: _ClassVar[int]
     id: str
     sourceId: str
     name: str
     content: str
     metadata: str
-    def __init__(self, id: _Optional[str] = ..., sourceId: _Optional[str] = ..., name: _Optional[str] = ..., content: _Optional[str] = ..., metadata: _Optional[str] = ...) -> None: ...
+    objectId: str
+    objectType: str
+    extension: str
+    url: str
+    language: str
+    date: _timestamp_pb2.Timestamp
+    createdAt: _timestamp_pb2.Timestamp
+    updatedAt: _timestamp_pb2.Timestamp
+    def __init__(self, id: _Optional[str] = ..., sourceId: _Optional[str] = ..., name: _Optional[str] = ..., content: _Optional[str] = ..., metadata: _Optional[str] = ..., objectId: _Optional[str] = ..., objectType: _Optional[str] = ..., extension: _Optional[str] = ..., url: _Optional[str] = ..., language: _Optional[str] = ..., date: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., createdAt: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., updatedAt: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
+
+class GetDocumentRequest(_message.Message):
+    __slots__ = ("id", "sourceIds")
+    ID_FIELD_NUMBER: _ClassVar[int]
+    SOURCEIDS_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    sourceIds: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, id: _Optional[str] = ..., sourceIds: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class GetChunksRequest(_message.Message):
+    __slots__ = ("ids", "sourceIds", "window")
+    IDS_FIELD_NUMBER: _ClassVar[int]
+    SOURCEIDS_FIELD_NUMBER: _ClassVar[int]
+    WINDOW_FIELD_NUMBER: _ClassVar[int]
+    ids: _containers.RepeatedScalarFieldContainer[str]
+    sourceIds: _containers.RepeatedScalarFieldContainer[str]
+    window: int
+    def __init__(self, ids: _Optional[_Iterable[str]] = ..., sourceIds: _Optional[_Iterable[str]] = ..., window: _Optional[int] = ...) -> None: ...
+
+class GetChunksResponse(_message.Message):
+    __slots__ = ("chunks", "missingIds")
+    CHUNKS_FIELD_NUMBER: _ClassVar[int]
+    MISSINGIDS_FIELD_NUMBER: _ClassVar[int]
+    chunks: _containers.RepeatedCompositeFieldContainer[DocumentChunk]
+    missingIds: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, chunks: _Optional[_Iterable[_Union[DocumentChunk, _Mapping]]] = ..., missingIds: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class DownloadOriginalRequest(_message.Message):
+    __slots__ = ("documentId", "sourceIds")
+    DOCUMENTID_FIELD_NUMBER: _ClassVar[int]
+    SOURCEIDS_FIELD_NUMBER: _ClassVar[int]
+    documentId: str
+    sourceIds: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, documentId: _Optional[str] = ..., sourceIds: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class OriginalInfo(_message.Message):
+    __slots__ = ("filename", "contentType", "size", "objectType")
+    FILENAME_FIELD_NUMBER: _ClassVar[int]
+    CONTENTTYPE_FIELD_NUMBER: _ClassVar[int]
+    SIZE_FIELD_NUMBER: _ClassVar[int]
+    OBJECTTYPE_FIELD_NUMBER: _ClassVar[int]
+    filename: str
+    contentType: str
+    size: int
+    objectType: str
+    def __init__(self, filename: _Optional[str] = ..., contentType: _Optional[str] = ..., size: _Optional[int] = ..., objectType: _Optional[str] = ...) -> None: ...
+
+class DownloadOriginalResponse(_message.Message):
+    __slots__ = ("info", "data")
+    INFO_FIELD_NUMBER: _ClassVar[int]
+    DATA_FIELD_NUMBER: _ClassVar[int]
+    info: OriginalInfo
+    data: bytes
+    def __init__(self, info: _Optional[_Union[OriginalInfo, _Mapping]] = ..., data: _Optional[bytes] = ...) -> None: ...
 
 class GetDocumentsOut(_message.Message):
     __slots__ = ("size", "page", "total", "documents")
@@ -74,3 +322,282 @@ class GetDocumentsOut(_message.Message):
     total: int
     documents: _containers.RepeatedCompositeFieldContainer[Document]
     def __init__(self, size: _Optional[int] = ..., page: _Optional[int] = ..., total: _Optional[int] = ..., documents: _Optional[_Iterable[_Union[Document, _Mapping]]] = ...) -> None: ...
+
+class TableColumn(_message.Message):
+    __slots__ = ("name", "type")
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    TYPE_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    type: str
+    def __init__(self, name: _Optional[str] = ..., type: _Optional[str] = ...) -> None: ...
+
+class StructuredTable(_message.Message):
+    __slots__ = ("id", "sourceId", "documentId", "sheet", "columns", "rowCount")
+    ID_FIELD_NUMBER: _ClassVar[int]
+    SOURCEID_FIELD_NUMBER: _ClassVar[int]
+    DOCUMENTID_FIELD_NUMBER: _ClassVar[int]
+    SHEET_FIELD_NUMBER: _ClassVar[int]
+    COLUMNS_FIELD_NUMBER: _ClassVar[int]
+    ROWCOUNT_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    sourceId: str
+    documentId: str
+    sheet: str
+    columns: _containers.RepeatedCompositeFieldContainer[TableColumn]
+    rowCount: int
+    def __init__(self, id: _Optional[str] = ..., sourceId: _Optional[str] = ..., documentId: _Optional[str] = ..., sheet: _Optional[str] = ..., columns: _Optional[_Iterable[_Union[TableColumn, _Mapping]]] = ..., rowCount: _Optional[int] = ...) -> None: ...
+
+class ListTablesRequest(_message.Message):
+    __slots__ = ("sourceIds",)
+    SOURCEIDS_FIELD_NUMBER: _ClassVar[int]
+    sourceIds: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, sourceIds: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class ListTablesResponse(_message.Message):
+    __slots__ = ("tables",)
+    TABLES_FIELD_NUMBER: _ClassVar[int]
+    tables: _containers.RepeatedCompositeFieldContainer[StructuredTable]
+    def __init__(self, tables: _Optional[_Iterable[_Union[StructuredTable, _Mapping]]] = ...) -> None: ...
+
+class Aggregation(_message.Message):
+    __slots__ = ("function", "column")
+    FUNCTION_FIELD_NUMBER: _ClassVar[int]
+    COLUMN_FIELD_NUMBER: _ClassVar[int]
+    function: AggregateFunction
+    column: str
+    def __init__(self, function: _Optional[_Union[AggregateFunction, str]] = ..., column: _Optional[str] = ...) -> None: ...
+
+class TableFilter(_message.Message):
+    __slots__ = ("column", "operator", "values")
+    COLUMN_FIELD_NUMBER: _ClassVar[int]
+    OPERATOR_FIELD_NUMBER: _ClassVar[int]
+    VALUES_FIELD_NUMBER: _ClassVar[int]
+    column: str
+    operator: FilterOperator
+    values: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, column: _Optional[str] = ..., operator: _Optional[_Union[FilterOperator, str]] = ..., values: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class AggregateTableRequest(_message.Message):
+    __slots__ = ("tableId", "aggregations", "groupBy", "filters", "limit", "sourceIds")
+    TABLEID_FIELD_NUMBER: _ClassVar[int]
+    AGGREGATIONS_FIELD_NUMBER: _ClassVar[int]
+    GROUPBY_FIELD_NUMBER: _ClassVar[int]
+    FILTERS_FIELD_NUMBER: _ClassVar[int]
+    LIMIT_FIELD_NUMBER: _ClassVar[int]
+    SOURCEIDS_FIELD_NUMBER: _ClassVar[int]
+    tableId: str
+    aggregations: _containers.RepeatedCompositeFieldContainer[Aggregation]
+    groupBy: _containers.RepeatedScalarFieldContainer[str]
+    filters: _containers.RepeatedCompositeFieldContainer[TableFilter]
+    limit: int
+    sourceIds: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, tableId: _Optional[str] = ..., aggregations: _Optional[_Iterable[_Union[Aggregation, _Mapping]]] = ..., groupBy: _Optional[_Iterable[str]] = ..., filters: _Optional[_Iterable[_Union[TableFilter, _Mapping]]] = ..., limit: _Optional[int] = ..., sourceIds: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class AggregateRow(_message.Message):
+    __slots__ = ("values",)
+    VALUES_FIELD_NUMBER: _ClassVar[int]
+    values: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, values: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class AggregateTableResponse(_message.Message):
+    __slots__ = ("columns", "rows", "truncated")
+    COLUMNS_FIELD_NUMBER: _ClassVar[int]
+    ROWS_FIELD_NUMBER: _ClassVar[int]
+    TRUNCATED_FIELD_NUMBER: _ClassVar[int]
+    columns: _containers.RepeatedScalarFieldContainer[str]
+    rows: _containers.RepeatedCompositeFieldContainer[AggregateRow]
+    truncated: bool
+    def __init__(self, columns: _Optional[_Iterable[str]] = ..., rows: _Optional[_Iterable[_Union[AggregateRow, _Mapping]]] = ..., truncated: bool = ...) -> None: ...
+
+class IngestionObject(_message.Message):
+    __slots__ = ("id", "type", "name", "state", "error", "chunkCount", "createdAt", "updatedAt")
+    ID_FIELD_NUMBER: _ClassVar[int]
+    TYPE_FIELD_NUMBER: _ClassVar[int]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    STATE_FIELD_NUMBER: _ClassVar[int]
+    ERROR_FIELD_NUMBER: _ClassVar[int]
+    CHUNKCOUNT_FIELD_NUMBER: _ClassVar[int]
+    CREATEDAT_FIELD_NUMBER: _ClassVar[int]
+    UPDATEDAT_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    type: IngestionObjectType
+    name: str
+    state: IngestionState
+    error: str
+    chunkCount: int
+    createdAt: _timestamp_pb2.Timestamp
+    updatedAt: _timestamp_pb2.Timestamp
+    def __init__(self, id: _Optional[str] = ..., type: _Optional[_Union[IngestionObjectType, str]] = ..., name: _Optional[str] = ..., state: _Optional[_Union[IngestionState, str]] = ..., error: _Optional[str] = ..., chunkCount: _Optional[int] = ..., createdAt: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., updatedAt: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
+
+class IngestionStateCount(_message.Message):
+    __slots__ = ("state", "count")
+    STATE_FIELD_NUMBER: _ClassVar[int]
+    COUNT_FIELD_NUMBER: _ClassVar[int]
+    state: IngestionState
+    count: int
+    def __init__(self, state: _Optional[_Union[IngestionState, str]] = ..., count: _Optional[int] = ...) -> None: ...
+
+class GetIngestionReportRequest(_message.Message):
+    __slots__ = ("sourceId", "states", "type", "size", "page")
+    SOURCEID_FIELD_NUMBER: _ClassVar[int]
+    STATES_FIELD_NUMBER: _ClassVar[int]
+    TYPE_FIELD_NUMBER: _ClassVar[int]
+    SIZE_FIELD_NUMBER: _ClassVar[int]
+    PAGE_FIELD_NUMBER: _ClassVar[int]
+    sourceId: str
+    states: _containers.RepeatedScalarFieldContainer[IngestionState]
+    type: IngestionObjectType
+    size: int
+    page: int
+    def __init__(self, sourceId: _Optional[str] = ..., states: _Optional[_Iterable[_Union[IngestionState, str]]] = ..., type: _Optional[_Union[IngestionObjectType, str]] = ..., size: _Optional[int] = ..., page: _Optional[int] = ...) -> None: ...
+
+class GetIngestionReportResponse(_message.Message):
+    __slots__ = ("sourceId", "size", "page", "total", "counts", "objects")
+    SOURCEID_FIELD_NUMBER: _ClassVar[int]
+    SIZE_FIELD_NUMBER: _ClassVar[int]
+    PAGE_FIELD_NUMBER: _ClassVar[int]
+    TOTAL_FIELD_NUMBER: _ClassVar[int]
+    COUNTS_FIELD_NUMBER: _ClassVar[int]
+    OBJECTS_FIELD_NUMBER: _ClassVar[int]
+    sourceId: str
+    size: int
+    page: int
+    total: int
+    counts: _containers.RepeatedCompositeFieldContainer[IngestionStateCount]
+    objects: _containers.RepeatedCompositeFieldContainer[IngestionObject]
+    def __init__(self, sourceId: _Optional[str] = ..., size: _Optional[int] = ..., page: _Optional[int] = ..., total: _Optional[int] = ..., counts: _Optional[_Iterable[_Union[IngestionStateCount, _Mapping]]] = ..., objects: _Optional[_Iterable[_Union[IngestionObject, _Mapping]]] = ...) -> None: ...
+
+class DeadLetter(_message.Message):
+    __slots__ = ("id", "queue", "payload", "metadata", "error", "attempts", "createdAt", "replayedAt")
+    class MetadataEntry(_message.Message):
+        __slots__ = ("key", "value")
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: str
+        def __init__(self, key: _Optional[str] = ..., value: _Optional[str] = ...) -> None: ...
+    ID_FIELD_NUMBER: _ClassVar[int]
+    QUEUE_FIELD_NUMBER: _ClassVar[int]
+    PAYLOAD_FIELD_NUMBER: _ClassVar[int]
+    METADATA_FIELD_NUMBER: _ClassVar[int]
+    ERROR_FIELD_NUMBER: _ClassVar[int]
+    ATTEMPTS_FIELD_NUMBER: _ClassVar[int]
+    CREATEDAT_FIELD_NUMBER: _ClassVar[int]
+    REPLAYEDAT_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    queue: str
+    payload: str
+    metadata: _containers.ScalarMap[str, str]
+    error: str
+    attempts: int
+    createdAt: _timestamp_pb2.Timestamp
+    replayedAt: _timestamp_pb2.Timestamp
+    def __init__(self, id: _Optional[str] = ..., queue: _Optional[str] = ..., payload: _Optional[str] = ..., metadata: _Optional[_Mapping[str, str]] = ..., error: _Optional[str] = ..., attempts: _Optional[int] = ..., createdAt: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., replayedAt: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
+
+class ListDeadLettersRequest(_message.Message):
+    __slots__ = ("queue", "size", "page")
+    QUEUE_FIELD_NUMBER: _ClassVar[int]
+    SIZE_FIELD_NUMBER: _ClassVar[int]
+    PAGE_FIELD_NUMBER: _ClassVar[int]
+    queue: str
+    size: int
+    page: int
+    def __init__(self, queue: _Optional[str] = ..., size: _Optional[int] = ..., page: _Optional[int] = ...) -> None: ...
+
+class ListDeadLettersResponse(_message.Message):
+    __slots__ = ("size", "page", "total", "deadLetters")
+    SIZE_FIELD_NUMBER: _ClassVar[int]
+    PAGE_FIELD_NUMBER: _ClassVar[int]
+    TOTAL_FIELD_NUMBER: _ClassVar[int]
+    DEADLETTERS_FIELD_NUMBER: _ClassVar[int]
+    size: int
+    page: int
+    total: int
+    deadLetters: _containers.RepeatedCompositeFieldContainer[DeadLetter]
+    def __init__(self, size: _Optional[int] = ..., page: _Optional[int] = ..., total: _Optional[int] = ..., deadLetters: _Optional[_Iterable[_Union[DeadLetter, _Mapping]]] = ...) -> None: ...
+
+class GetDeadLetterRequest(_message.Message):
+    __slots__ = ("queue", "id")
+    QUEUE_FIELD_NUMBER: _ClassVar[int]
+    ID_FIELD_NUMBER: _ClassVar[int]
+    queue: str
+    id: str
+    def __init__(self, queue: _Optional[str] = ..., id: _Optional[str] = ...) -> None: ...
+
+class ReplayDeadLettersRequest(_message.Message):
+    __slots__ = ("queue", "ids")
+    QUEUE_FIELD_NUMBER: _ClassVar[int]
+    IDS_FIELD_NUMBER: _ClassVar[int]
+    queue: str
+    ids: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, queue: _Optional[str] = ..., ids: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class ReplayDeadLettersResponse(_message.Message):
+    __slots__ = ("replayed",)
+    REPLAYED_FIELD_NUMBER: _ClassVar[int]
+    replayed: int
+    def __init__(self, replayed: _Optional[int] = ...) -> None: ...
+
+class EmbeddingModel(_message.Message):
+    __slots__ = ("id", "name", "version", "dimensions", "indexType", "active", "configured", "chunks", "embeddedChunks", "questions", "embeddedQuestions", "createdAt", "activatedAt")
+    ID_FIELD_NUMBER: _ClassVar[int]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    VERSION_FIELD_NUMBER: _ClassVar[int]
+    DIMENSIONS_FIELD_NUMBER: _ClassVar[int]
+    INDEXTYPE_FIELD_NUMBER: _ClassVar[int]
+    ACTIVE_FIELD_NUMBER: _ClassVar[int]
+    CONFIGURED_FIELD_NUMBER: _ClassVar[int]
+    CHUNKS_FIELD_NUMBER: _ClassVar[int]
+    EMBEDDEDCHUNKS_FIELD_NUMBER: _ClassVar[int]
+    QUESTIONS_FIELD_NUMBER: _ClassVar[int]
+    EMBEDDEDQUESTIONS_FIELD_NUMBER: _ClassVar[int]
+    CREATEDAT_FIELD_NUMBER: _ClassVar[int]
+    ACTIVATEDAT_FIELD_NUMBER: _ClassVar[int]
+    id: int
+    name: str
+    version: str
+    dimensions: int
+    indexType: str
+    active: bool
+    configured: bool
+    chunks: int
+    embeddedChunks: int
+    questions: int
+    embeddedQuestions: int
+    createdAt: _timestamp_pb2.Timestamp
+    activatedAt: _timestamp_pb2.Timestamp
+    def __init__(self, id: _Optional[int] = ..., name: _Optional[str] = ..., version: _Optional[str] = ..., dimensions: _Optional[int] = ..., indexType: _Optional[str] = ..., active: bool = ..., configured: bool = ..., chunks: _Optional[int] = ..., embeddedChunks: _Optional[int] = ..., questions: _Optional[int] = ..., embeddedQuestions: _Optional[int] = ..., createdAt: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., activatedAt: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
+
+class ListEmbeddingModelsRequest(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
+class ListEmbeddingModelsResponse(_message.Message):
+    __slots__ = ("models",)
+    MODELS_FIELD_NUMBER: _ClassVar[int]
+    models: _containers.RepeatedCompositeFieldContainer[EmbeddingModel]
+    def __init__(self, models: _Optional[_Iterable[_Union[EmbeddingModel, _Mapping]]] = ...) -> None: ...
+
+class StartReembeddingRequest(_message.Message):
+    __slots__ = ("modelId", "sourceId", "cutOver")
+    MODELID_FIELD_NUMBER: _ClassVar[int]
+    SOURCEID_FIELD_NUMBER: _ClassVar[int]
+    CUTOVER_FIELD_NUMBER: _ClassVar[int]
+    modelId: int
+    sourceId: str
+    cutOver: bool
+    def __init__(self, modelId: _Optional[int] = ..., sourceId: _Optional[str] = ..., cutOver: bool = ...) -> None: ...
+
+class StartReembeddingResponse(_message.Message):
+    __slots__ = ("jobId",)
+    JOBID_FIELD_NUMBER: _ClassVar[int]
+    jobId: str
+    def __init__(self, jobId: _Optional[str] = ...) -> None: ...
+
+class ActivateEmbeddingModelRequest(_message.Message):
+    __slots__ = ("modelId", "force")
+    MODELID_FIELD_NUMBER: _ClassVar[int]
+    FORCE_FIELD_NUMBER: _ClassVar[int]
+    modelId: int
+    force: bool
+    def __init__(self, modelId: _Optional[int] = ..., force: bool = ...) -> None: ...
@@ -25,7 +25,7 @@ _sym_db = _symbol_database.Default()
 from data.v1 import model_pb2 as data_dot_v1_dot_model__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x15\x64\x61ta/v1/service.proto\x12\x07\x64\x61ta.v1\x1a\x13\x64\x61ta/v1/model.proto2\xa1\x01\n\x0b\x44\x61taService\x12M\n\x0cVectorSearch\x12\x1c.data.v1.VectorSearchRequest\x1a\x1d.data.v1.VectorSearchResponse\"\x00\x12\x43\n\x0cGetDocuments\x12\x17.data.v1.GetDocumentsIn\x1a\x18.data.v1.GetDocumentsOut\"\x00\x42\x12Z\x10internal/data/pbb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x15\x64\x61ta/v1/service.proto\x12\x07\x64\x61ta.v1\x1a\x13\x64\x61ta/v1/model.proto2\xfb\t\n\x0b\x44\x61taService\x12M\n\x0cVectorSearch\x12\x1c.data.v1.VectorSearchRequest\x1a\x1d.data.v1.VectorSearchResponse\"\x00\x12\\\n\x11\x42\x61tchVectorSearch\x12!.data.v1.BatchVectorSearchRequest\x1a\".data.v1.BatchVectorSearchResponse\"\x00\x12\x43\n\x0cGetDocuments\x12\x17.data.v1.GetDocumentsIn\x1a\x18.data.v1.GetDocumentsOut\"\x00\x12?\n\x0bGetDocument\x12\x1b.data.v1.GetDocumentRequest\x1a\x11.data.v1.Document\"\x00\x12\x44\n\tGetChunks\x12\x19.data.v1.GetChunksRequest\x1a\x1a.data.v1.GetChunksResponse\"\x00\x12[\n\x10\x44ownloadOriginal\x12 .data.v1.DownloadOriginalRequest\x1a!.data.v1.DownloadOriginalResponse\"\x00\x30\x01\x12G\n\nListTables\x12\x1a.data.v1.ListTablesRequest\x1a\x1b.data.v1.ListTablesResponse\"\x00\x12S\n\x0e\x41ggregateTable\x12\x1e.data.v1.AggregateTableRequest\x1a\x1f.data.v1.AggregateTableResponse\"\x00\x12_\n\x12GetIngestionReport\x12\".data.v1.GetIngestionReportRequest\x1a#.data.v1.GetIngestionReportResponse\"\x00\x12V\n\x0fListDeadLetters\x12\x1f.data.v1.ListDeadLettersRequest\x1a .data.v1.ListDeadLettersResponse\"\x00\x12\x45\n\rGetDeadLetter\x12\x1d.data.v1.GetDeadLetterRequest\x1a\x13.data.v1.DeadLetter\"\x00\x12\\\n\x11ReplayDeadLetters\x12!.data.v1.ReplayDeadLettersRequest\x1a\".data.v1.ReplayDeadLettersResponse\"\x00\x12\x62\n\x13ListEmbeddingModels\x12#.data.v1.ListEmbeddingModelsRequest\x1a$.data.v1.ListEmbeddingModelsResponse\"\x00\x12Y\n\x10StartReembedding\x12 .data.v1.StartReembeddingRequest\x1a!.data.v1.StartReembeddingResponse\"\x00\x12[\n\x16\x41\x63tivateEmbeddingModel\x12&.data.v1.ActivateEmbeddingModelRequest\x1a\x17.data.v1.EmbeddingModel\"\x00\x42\x12Z\x10internal/data/pbb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -34,5 +34,5 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z\020internal/data/pb'
   _globals['_DATASERVICE']._serialized_start=56
-  _globals['_DATASERVICE']._serialized_end=217
+  _globals['_DATASERVICE']._serialized_end=1331
 # @@protoc_insertion_point(module_scope)
@@ -39,11 +39,76 @@ class DataServiceStub(object):
                 request_serializer=data_dot_v1_dot_model__pb2.VectorSearchRequest.SerializeToString,
                 response_deserializer=data_dot_v1_dot_model__pb2.VectorSearchResponse.FromString,
                 _registered_method=True)
+        self.BatchVectorSearch = channel.unary_unary(
+                '/data.v1.DataService/BatchVectorSearch',
+                request_serializer=data_dot_v1_dot_model__pb2.BatchVectorSearchRequest.SerializeToString,
+                response_deserializer=data_dot_v1_dot_model__pb2.BatchVectorSearchResponse.FromString,
+                _registered_method=True)
         self.GetDocuments = channel.unary_unary(
                 '/data.v1.DataService/GetDocuments',
                 request_serializer=data_dot_v1_dot_model__pb2.GetDocumentsIn.SerializeToString,
                 response_deserializer=data_dot_v1_dot_model__pb2.GetDocumentsOut.FromString,
                 _registered_method=True)
+        self.GetDocument = channel.unary_unary(
+                '/data.v1.DataService/GetDocument',
+                request_serializer=data_dot_v1_dot_model__pb2.GetDocumentRequest.SerializeToString,
+                response_deserializer=data_dot_v1_dot_model__pb2.Document.FromString,
+                _registered_method=True)
+        self.GetChunks = channel.unary_unary(
+                '/data.v1.DataService/GetChunks',
+                request_serializer=data_dot_v1_dot_model__pb2.GetChunksRequest.SerializeToString,
+                response_deserializer=data_dot_v1_dot_model__pb2.GetChunksResponse.FromString,
+                _registered_method=True)
+        self.DownloadOriginal = channel.unary_stream(
+                '/data.v1.DataService/DownloadOriginal',
+                request_serializer=data_dot_v1_dot_model__pb2.DownloadOriginalRequest.SerializeToString,
+                response_deserializer=data_dot_v1_dot_model__pb2.DownloadOriginalResponse.FromString,
+                _registered_method=True)
+        self.ListTables = channel.unary_unary(
+                '/data.v1.DataService/ListTables',
+                request_serializer=data_dot_v1_dot_model__pb2.ListTablesRequest.SerializeToString,
+                response_deserializer=data_dot_v1_dot_model__pb2.ListTablesResponse.FromString,
+                _registered_method=True)
+        self.AggregateTable = channel.unary_unary(
+                '/data.v1.DataService/AggregateTable',
+                request_serializer=data_dot_v1_dot_model__pb2.AggregateTableRequest.SerializeToString,
+                response_deserializer=data_dot_v1_dot_model__pb2.AggregateTableResponse.FromString,
+                _registered_method=True)
+        self.GetIngestionReport = channel.unary_unary(
+                '/data.v1.DataService/GetIngestionReport',
+                request_serializer=data_dot_v1_dot_model__pb2.GetIngestionReportRequest.SerializeToString,
+                response_deserializer=data_dot_v1_dot_model__pb2.GetIngestionReportResponse.FromString,
+                _registered_method=True)
+        self.ListDeadLetters = channel.unary_unary(
+                '/data.v1.DataService/ListDeadLetters',
+                request_serializer=data_dot_v1_dot_model__pb2.ListDeadLettersRequest.SerializeToString,
+                response_deserializer=data_dot_v1_dot_model__pb2.ListDeadLettersResponse.FromString,
+                _registered_method=True)
+        self.GetDeadLetter = channel.unary_unary(
+                '/data.v1.DataService/GetDeadLetter',
+                request_serializer=data_dot_v1_dot_model__pb2.GetDeadLetterRequest.SerializeToString,
+                response_deserializer=data_dot_v1_dot_model__pb2.DeadLetter.FromString,
+                _registered_method=True)
+        self.ReplayDeadLetters = channel.unary_unary(
+                '/data.v1.DataService/ReplayDeadLetters',
+                request_serializer=data_dot_v1_dot_model__pb2.ReplayDeadLettersRequest.SerializeToString,
+                response_deserializer=data_dot_v1_dot_model__pb2.ReplayDeadLettersResponse.FromString,
+                _registered_method=True)
+        self.ListEmbeddingModels = channel.unary_unary(
+                '/data.v1.DataService/ListEmbeddingModels',
+                request_serializer=data_dot_v1_dot_model__pb2.ListEmbeddingModelsRequest.SerializeToString,
+                response_deserializer=data_dot_v1_dot_model__pb2.ListEmbeddingModelsResponse.FromString,
+                _registered_method=True)
+        self.StartReembedding = channel.unary_unary(
+                '/data.v1.DataService/StartReembedding',
+                request_serializer=data_dot_v1_dot_model__pb2.StartReembeddingRequest.SerializeToString,
+                response_deserializer=data_dot_v1_dot_model__pb2.StartReembeddingResponse.FromString,
+                _registered_method=True)
+        self.ActivateEmbeddingModel = channel.unary_unary(
+                '/data.v1.DataService/ActivateEmbeddingModel',
+                request_serializer=data_dot_v1_dot_model__pb2.ActivateEmbeddingModelRequest.SerializeToString,
+                response_deserializer=data_dot_v1_dot_model__pb2.EmbeddingModel.FromString,
+                _registered_method=True)
 
 
 class DataServiceServicer(object):
@@ -55,12 +120,90 @@ class DataServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def BatchVectorSearch(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetDocuments(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetDocument(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetChunks(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DownloadOriginal(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListTables(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def AggregateTable(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetIngestionReport(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListDeadLetters(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetDeadLetter(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReplayDeadLetters(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListEmbeddingModels(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def StartReembedding(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ActivateEmbeddingModel(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_DataServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -69,11 +212,76 @@ def add_DataServiceServicer_to_server(servicer, server):
                     request_deserializer=data_dot_v1_dot_model__pb2.VectorSearchRequest.FromString,
                     response_serializer=data_dot_v1_dot_model__pb2.VectorSearchResponse.SerializeToString,
             ),
+            'BatchVectorSearch': grpc.unary_unary_rpc_method_handler(
+                    servicer.BatchVectorSearch,
+                    request_deserializer=data_dot_v1_dot_model__pb2.BatchVectorSearchRequest.FromString,
+                    response_serializer=data_dot_v1_dot_model__pb2.BatchVectorSearchResponse.SerializeToString,
+            ),
             'GetDocuments': grpc.unary_unary_rpc_method_handler(
                     servicer.GetDocuments,
                     request_deserializer=data_dot_v1_dot_model__pb2.GetDocumentsIn.FromString,
                     response_serializer=data_dot_v1_dot_model__pb2.GetDocumentsOut.SerializeToString,
             ),
+            'GetDocument': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetDocument,
+                    request_deserializer=data_dot_v1_dot_model__pb2.GetDocumentRequest.FromString,
+                    response_serializer=data_dot_v1_dot_model__pb2.Document.SerializeToString,
+            ),
+            'GetChunks': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetChunks,
+                    request_deserializer=data_dot_v1_dot_model__pb2.GetChunksRequest.FromString,
+                    response_serializer=data_dot_v1_dot_model__pb2.GetChunksResponse.SerializeToString,
+            ),
+            'DownloadOriginal': grpc.unary_stream_rpc_method_handler(
+                    servicer.DownloadOriginal,
+                    request_deserializer=data_dot_v1_dot_model__pb2.DownloadOriginalRequest.FromString,
+                    response_serializer=data_dot_v1_dot_model__pb2.DownloadOriginalResponse.SerializeToString,
+            ),
+            'ListTables': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListTables,
+                    request_deserializer=data_dot_v1_dot_model__pb2.ListTablesRequest.FromString,
+                    response_serializer=data_dot_v1_dot_model__pb2.ListTablesResponse.SerializeToString,
+            ),
+            'AggregateTable': grpc.unary_unary_rpc_method_handler(
+                    servicer.AggregateTable,
+                    request_deserializer=data_dot_v1_dot_model__pb2.AggregateTableRequest.FromString,
+                    response_serializer=data_dot_v1_dot_model__pb2.AggregateTableResponse.SerializeToString,
+            ),
+            'GetIngestionReport': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetIngestionReport,
+                    request_deserializer=data_dot_v1_dot_model__pb2.GetIngestionReportRequest.FromString,
+                    response_serializer=data_dot_v1_dot_model__pb2.GetIngestionReportResponse.SerializeToString,
+            ),
+            'ListDeadLetters': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListDeadLetters,
+                    request_deserializer=data_dot_v1_dot_model__pb2.ListDeadLettersRequest.FromString,
+                    response_serializer=data_dot_v1_dot_model__pb2.ListDeadLettersResponse.SerializeToString,
+            ),
+            'GetDeadLetter': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetDeadLetter,
+                    request_deserializer=data_dot_v1_dot_model__pb2.GetDeadLetterRequest.FromString,
+                    response_serializer=data_dot_v1_dot_model__pb2.DeadLetter.SerializeToString,
+            ),
+            'ReplayDeadLetters': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReplayDeadLetters,
+                    request_deserializer=data_dot_v1_dot_model__pb2.ReplayDeadLettersRequest.FromString,
+                    response_serializer=data_dot_v1_dot_model__pb2.ReplayDeadLettersResponse.SerializeToString,
+            ),
+            'ListEmbeddingModels': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListEmbeddingModels,
+                    request_deserializer=data_dot_v1_dot_model__pb2.ListEmbeddingModelsRequest.FromString,
+                    response_serializer=data_dot_v1_dot_model__pb2.ListEmbeddingModelsResponse.SerializeToString,
+            ),
+            'StartReembedding': grpc.unary_unary_rpc_method_handler(
+                    servicer.StartReembedding,
+                    request_deserializer=data_dot_v1_dot_model__pb2.StartReembeddingRequest.FromString,
+                    response_serializer=data_dot_v1_dot_model__pb2.StartReembeddingResponse.SerializeToString,
+            ),
+            'ActivateEmbeddingModel': grpc.unary_unary_rpc_method_handler(
+                    servicer.ActivateEmbeddingModel,
+                    request_deserializer=data_dot_v1_dot_model__pb2.ActivateEmbeddingModelRequest.FromString,
+                    response_serializer=data_dot_v1_dot_model__pb2.EmbeddingModel.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'data.v1.DataService', rpc_method_handlers)
@@ -112,6 +320,33 @@ class DataService(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def BatchVectorSearch(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/data.v1.DataService/BatchVectorSearch',
+            data_dot_v1_dot_model__pb2.BatchVectorSearchRequest.SerializeToString,
+            data_dot_v1_dot_model__pb2.BatchVectorSearchResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def GetDocuments(request,
             target,
@@ -138,3 +373,327 @@ class DataService(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def GetDocument(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/data.v1.DataService/GetDocument',
+            data_dot_v1_dot_model__pb2.GetDocumentRequest.SerializeToString,
+            data_dot_v1_dot_model__pb2.Document.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetChunks(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/data.v1.DataService/GetChunks',
+            data_dot_v1_dot_model__pb2.GetChunksRequest.SerializeToString,
+            data_dot_v1_dot_model__pb2.GetChunksResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DownloadOriginal(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/data.v1.DataService/DownloadOriginal',
+            data_dot_v1_dot_model__pb2.DownloadOriginalRequest.SerializeToString,
+            data_dot_v1_dot_model__pb2.DownloadOriginalResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListTables(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/data.v1.DataService/ListTables',
+            data_dot_v1_dot_model__pb2.ListTablesRequest.SerializeToString,
+            data_dot_v1_dot_model__pb2.ListTablesResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def AggregateTable(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/data.v1.DataService/AggregateTable',
+            data_dot_v1_dot_model__pb2.AggregateTableRequest.SerializeToString,
+            data_dot_v1_dot_model__pb2.AggregateTableResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetIngestionReport(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/data.v1.DataService/GetIngestionReport',
+            data_dot_v1_dot_model__pb2.GetIngestionReportRequest.SerializeToString,
+            data_dot_v1_dot_model__pb2.GetIngestionReportResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListDeadLetters(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/data.v1.DataService/ListDeadLetters',
+            data_dot_v1_dot_model__pb2.ListDeadLettersRequest.SerializeToString,
+            data_dot_v1_dot_model__pb2.ListDeadLettersResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetDeadLetter(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/data.v1.DataService/GetDeadLetter',
+            data_dot_v1_dot_model__pb2.GetDeadLetterRequest.SerializeToString,
+            data_dot_v1_dot_model__pb2.DeadLetter.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ReplayDeadLetters(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/data.v1.DataService/ReplayDeadLetters',
+            data_dot_v1_dot_model__pb2.ReplayDeadLettersRequest.SerializeToString,
+            data_dot_v1_dot_model__pb2.ReplayDeadLettersResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListEmbeddingModels(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/data.v1.DataService/ListEmbeddingModels',
+            data_dot_v1_dot_model__pb2.ListEmbeddingModelsRequest.SerializeToString,
+            data_dot_v1_dot_model__pb2.ListEmbeddingModelsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def StartReembedding(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/data.v1.DataService/StartReembedding',
+            data_dot_v1_dot_model__pb2.StartReembeddingRequest.SerializeToString,
+            data_dot_v1_dot_model__pb2.StartReembeddingResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ActivateEmbeddingModel(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/data.v1.DataService/ActivateEmbeddingModel',
+            data_dot_v1_dot_model__pb2.ActivateEmbeddingModelRequest.SerializeToString,
+            data_dot_v1_dot_model__pb2.EmbeddingModel.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
@@ -35,6 +35,7 @@ class AsyncDataServiceClient:
         threshold: float,
         *,
         use_questions: bool,
+        search_filter: pb.SearchFilter | None = None,
     ) -> pb.VectorSearchResponse:
         """Выполняет векторный поиск на сервере.
 
@@ -50,6 +51,8 @@ class AsyncDataServiceClient:
             Минимальный порог схожести для результатов
         use_questions : bool
             Использовать поиск по вопросам
+        search_filter : pb.SearchFilter, optional
+            Ограничения поиска по типу, расширению, url, дате и языку
 
         Returns
         -------
@@ -62,6 +65,7 @@ class AsyncDataServiceClient:
             topK=top_k,
             threshold=threshold,
             useQuestions=use_questions,
+            filter=search_filter,
         )
         return await self.stub.VectorSearch(request)
 
@@ -25,7 +25,7 @@ _sym_db = _symbol_database.Default()
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x11ml/v1/model.proto\x12\x05pb.ml\x1a\x1fgoogle/protobuf/timestamp.proto\"e\n\nMultiQuery\x12\x15\n\ruseMultiquery\x18\x01 \x01(\x08\x12\x10\n\x08nQueries\x18\x02 \x01(\x03\x12\x1b\n\x0equeryModelName\x18\x03 \x01(\tH\x00\x88\x01\x01\x42\x11\n\x0f_queryModelName\"]\n\x08Reranker\x12\x11\n\tuseRerank\x18\x01 \x01(\x08\x12\x15\n\rrerankerModel\x18\x02 \x01(\t\x12\x19\n\x11rerankerMaxLength\x18\x03 \x01(\x03\x12\x0c\n\x04topK\x18\x04 \x01(\x03\"d\n\x08LlmModel\x12\x11\n\tmodelName\x18\x01 \x01(\t\x12\x13\n\x0btemperature\x18\x02 \x01(\x02\x12\x0c\n\x04topK\x18\x03 \x01(\x03\x12\x0c\n\x04topP\x18\x04 \x01(\x02\x12\x14\n\x0csystemPrompt\x18\x05 \x01(\t\"F\n\x0cVectorSearch\x12\x0c\n\x04topN\x18\x01 \x01(\x03\x12\x11\n\tthreshold\x18\x02 \x01(\x02\x12\x15\n\rsearchByQuery\x18\x03 \x01(\x08\"\xfb\x02\n\x08Scenario\x12\n\n\x02id\x18\x01 \x01(\x03\x12*\n\nmultiQuery\x18\x02 \x01(\x0b\x32\x11.pb.ml.MultiQueryH\x00\x88\x01\x01\x12&\n\x08reranker\x18\x03 \x01(\x0b\x32\x0f.pb.ml.RerankerH\x01\x88\x01\x01\x12.\n\x0cvectorSearch\x18\x04 \x01(\x0b\x32\x13.pb.ml.VectorSearchH\x02\x88\x01\x01\x12\x1e\n\x05model\x18\x05 \x01(\x0b\x32\x0f.pb.ml.LlmModel\x12-\n\tcreatedAt\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\tupdatedAt\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05title\x18\x08 \x01(\t\x12\x10\n\x08\x64omainId\x18\t \x01(\x03\x12\x13\n\x0b\x63ontextSize\x18\n \x01(\x03\x42\r\n\x0b_multiQueryB\x0b\n\t_rerankerB\x0f\n\r_vectorSearch\"4\n\x05Query\x12\n\n\x02id\x18\x01 \x01(\x03\x12\x0e\n\x06userId\x18\x02 \x01(\x03\x12\x0f\n\x07\x63ontent\x18\x03 \x01(\t\"z\n\x13ProcessQueryRequest\x12\x1b\n\x05query\x18\x01 \x01(\x0b\x32\x0c.pb.ml.Query\x12&\n\x08scenario\x18\x02 \x01(\x0b\x32\x0f.pb.ml.ScenarioH\x00\x88\x01\x01\x12\x11\n\tsourceIds\x18\x03 \x03(\tB\x0b\n\t_scenario\"\x18\n\x05\x43hunk\x12\x0f\n\x07\x63ontent\x18\x01 \x01(\t\"F\n\x14ProcessQueryResponse\x12\x1b\n\x05\x63hunk\x18\x01 \x01(\x0b\x32\x0c.pb.ml.Chunk\x12\x11\n\tsourceIds\x18\x02 \x03(\t\"\xde\x01\n\x0bModelParams\x12*\n\nmultiQuery\x18\x01 \x01(\x0b\x32\x11.pb.ml.MultiQueryH\x00\x88\x01\x01\x12&\n\x08reranker\x18\x02 \x01(\x0b\x32\x0f.pb.ml.RerankerH\x01\x88\x01\x01\x12.\n\x0cvectorSearch\x18\x03 \x01(\x0b\x32\x13.pb.ml.VectorSearchH\x02\x88\x01\x01\x12\x1e\n\x05model\x18\x04 \x01(\x0b\x32\x0f.pb.ml.LlmModelB\r\n\x0b_multiQueryB\x0b\n\t_rerankerB\x0f\n\r_vectorSearch\",\n\x17GetOptimalParamsRequest\x12\x11\n\tsourceIds\x18\x01 \x03(\t\")\n\x18ProcessFirstQueryRequest\x12\r\n\x05query\x18\x01 \x01(\t\"*\n\x19ProcessFirstQueryResponse\x12\r\n\x05query\x18\x01 \x01(\tB\x14Z\x12internal/domain/pbb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x11ml/v1/model.proto\x12\x05pb.ml\x1a\x1fgoogle/protobuf/timestamp.proto\"e\n\nMultiQuery\x12\x15\n\ruseMultiquery\x18\x01 \x01(\x08\x12\x10\n\x08nQueries\x18\x02 \x01(\x03\x12\x1b\n\x0equeryModelName\x18\x03 \x01(\tH\x00\x88\x01\x01\x42\x11\n\x0f_queryModelName\"]\n\x08Reranker\x12\x11\n\tuseRerank\x18\x01 \x01(\x08\x12\x15\n\rrerankerModel\x18\x02 \x01(\t\x12\x19\n\x11rerankerMaxLength\x18\x03 \x01(\x03\x12\x0c\n\x04topK\x18\x04 \x01(\x03\"d\n\x08LlmModel\x12\x11\n\tmodelName\x18\x01 \x01(\t\x12\x13\n\x0btemperature\x18\x02 \x01(\x02\x12\x0c\n\x04topK\x18\x03 \x01(\x03\x12\x0c\n\x04topP\x18\x04 \x01(\x02\x12\x14\n\x0csystemPrompt\x18\x05 \x01(\t\"\x8a\x02\n\x0cSearchFilter\x12\r\n\x05types\x18\x01 \x03(\t\x12\x12\n\nextensions\x18\x02 \x03(\t\x12\x13\n\x0burlPrefixes\x18\x03 \x03(\t\x12\x31\n\x08\x64\x61teFrom\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x00\x88\x01\x01\x12/\n\x06\x64\x61teTo\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x01\x88\x01\x01\x12\x11\n\tlanguages\x18\x06 \x03(\t\x12\x1a\n\x12\x64ocumentPredicates\x18\x07 \x03(\t\x12\x17\n\x0f\x63hunkPredicates\x18\x08 \x03(\tB\x0b\n\t_dateFromB\t\n\x07_dateTo\"{\n\x0cVectorSearch\x12\x0c\n\x04topN\x18\x01 \x01(\x03\x12\x11\n\tthreshold\x18\x02 \x01(\x02\x12\x15\n\rsearchByQuery\x18\x03 \x01(\x08\x12(\n\x06\x66ilter\x18\x04 \x01(\x0b\x32\x13.pb.ml.SearchFilterH\x00\x88\x01\x01\x42\t\n\x07_filter\"\xfb\x02\n\x08Scenario\x12\n\n\x02id\x18\x01 \x01(\x03\x12*\n\nmultiQuery\x18\x02 \x01(\x0b\x32\x11.pb.ml.MultiQueryH\x00\x88\x01\x01\x12&\n\x08reranker\x18\x03 \x01(\x0b\x32\x0f.pb.ml.RerankerH\x01\x88\x01\x01\x12.\n\x0cvectorSearch\x18\x04 \x01(\x0b\x32\x13.pb.ml.VectorSearchH\x02\x88\x01\x01\x12\x1e\n\x05model\x18\x05 \x01(\x0b\x32\x0f.pb.ml.LlmModel\x12-\n\tcreatedAt\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\tupdatedAt\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05title\x18\x08 \x01(\t\x12\x10\n\x08\x64omainId\x18\t \x01(\x03\x12\x13\n\x0b\x63ontextSize\x18\n \x01(\x03\x42\r\n\x0b_multiQueryB\x0b\n\t_rerankerB\x0f\n\r_vectorSearch\"4\n\x05Query\x12\n\n\x02id\x18\x01 \x01(\x03\x12\x0e\n\x06userId\x18\x02 \x01(\x03\x12\x0f\n\x07\x63ontent\x18\x03 \x01(\t\"z\n\x13ProcessQueryRequest\x12\x1b\n\x05query\x18\x01 \x01(\x0b\x32\x0c.pb.ml.Query\x12&\n\x08scenario\x18\x02 \x01(\x0b\x32\x0f.pb.ml.ScenarioH\x00\x88\x01\x01\x12\x11\n\tsourceIds\x18\x03 \x03(\tB\x0b\n\t_scenario\"\x18\n\x05\x43hunk\x12\x0f\n\x07\x63ontent\x18\x01 \x01(\t\"\x93\x01\n\x08\x43itation\x12\x12\n\ndocumentId\x18\x01 \x01(\t\x12\x14\n\x0c\x64ocumentName\x18\x02 \x01(\t\x12\x0b\n\x03url\x18\x03 \x01(\t\x12\x0f\n\x07\x63hunkId\x18\x04 \x01(\t\x12\x0f\n\x07snippet\x18\x05 \x01(\t\x12\x12\n\nsimilarity\x18\x06 \x01(\x02\x12\x11\n\x04page\x18\x07 \x01(\x05H\x00\x88\x01\x01\x42\x07\n\x05_page\"j\n\x14ProcessQueryResponse\x12\x1b\n\x05\x63hunk\x18\x01 \x01(\x0b\x32\x0c.pb.ml.Chunk\x12\x11\n\tsourceIds\x18\x02 \x03(\t\x12\"\n\tcitations\x18\x03 \x03(\x0b\x32\x0f.pb.ml.Citation\"\xde\x01\n\x0bModelParams\x12*\n\nmultiQuery\x18\x01 \x01(\x0b\x32\x11.pb.ml.MultiQueryH\x00\x88\x01\x01\x12&\n\x08reranker\x18\x02 \x01(\x0b\x32\x0f.pb.ml.RerankerH\x01\x88\x01\x01\x12.\n\x0cvectorSearch\x18\x03 \x01(\x0b\x32\x13.pb.ml.VectorSearchH\x02\x88\x01\x01\x12\x1e\n\x05model\x18\x04 \x01(\x0b\x32\x0f.pb.ml.LlmModelB\r\n\x0b_multiQueryB\x0b\n\t_rerankerB\x0f\n\r_vectorSearch\",\n\x17GetOptimalParamsRequest\x12\x11\n\tsourceIds\x18\x01 \x03(\t\")\n\x18ProcessFirstQueryRequest\x12\r\n\x05query\x18\x01 \x01(\t\"*\n\x19ProcessFirstQueryResponse\x12\r\n\x05query\x18\x01 \x01(\tB\x14Z\x12internal/domain/pbb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -39,24 +39,28 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_RERANKER']._serialized_end=257
   _globals['_LLMMODEL']._serialized_start=259
   _globals['_LLMMODEL']._serialized_end=359
-  _globals['_VECTORSEARCH']._serialized_start=361
-  _globals['_VECTORSEARCH']._serialized_end=431
-  _globals['_SCENARIO']._serialized_start=434
-  _globals['_SCENARIO']._serialized_end=813
-  _globals['_QUERY']._serialized_start=815
-  _globals['_QUERY']._serialized_end=867
-  _globals['_PROCESSQUERYREQUEST']._serialized_start=869
-  _globals['_PROCESSQUERYREQUEST']._serialized_end=991
-  _globals['_CHUNK']._serialized_start=993
-  _globals['_CHUNK']._serialized_end=1017
-  _globals['_PROCESSQUERYRESPONSE']._serialized_start=1019
-  _globals['_PROCESSQUERYRESPONSE']._serialized_end=1089
-  _globals['_MODELPARAMS']._serialized_start=1092
-  _globals['_MODELPARAMS']._serialized_end=1314
-  _globals['_GETOPTIMALPARAMSREQUEST']._serialized_start=1316
-  _globals['_GETOPTIMALPARAMSREQUEST']._serialized_end=1360
-  _globals['_PROCESSFIRSTQUERYREQUEST']._serialized_start=1362
-  _globals['_PROCESSFIRSTQUERYREQUEST']._serialized_end=1403
-  _globals['_PROCESSFIRSTQUERYRESPONSE']._serialized_start=1405
-  _globals['_PROCESSFIRSTQUERYRESPONSE']._serialized_end=1447
+  _globals['_SEARCHFILTER']._serialized_start=362
+  _globals['_SEARCHFILTER']._serialized_end=628
+  _globals['_VECTORSEARCH']._serialized_start=630
+  _globals['_VECTORSEARCH']._serialized_end=753
+  _globals['_SCENARIO']._serialized_start=756
+  _globals['_SCENARIO']._serialized_end=1135
+  _globals['_QUERY']._serialized_start=1137
+  _globals['_QUERY']._serialized_end=1189
+  _globals['_PROCESSQUERYREQUEST']._serialized_start=1191
+  _globals['_PROCESSQUERYREQUEST']._serialized_end=1313
+  _globals['_CHUNK']._serialized_start=1315
+  _globals['_CHUNK']._serialized_end=1339
+  _globals['_CITATION']._serialized_start=1342
+  _globals['_CITATION']._serialized_end=1489
+  _globals['_PROCESSQUERYRESPONSE']._serialized_start=1491
+  _globals['_PROCESSQUERYRESPONSE']._serialized_end=1597
+  _globals['_MODELPARAMS']._serialized_start=1600
+  _globals['_MODELPARAMS']._serialized_end=1822
+  _globals['_GETOPTIMALPARAMSREQUEST']._serialized_start=1824
+  _globals['_GETOPTIMALPARAMSREQUEST']._serialized_end=1868
+  _globals['_PROCESSFIRSTQUERYREQUEST']._serialized_start=1870
+  _globals['_PROCESSFIRSTQUERYREQUEST']._serialized_end=1911
+  _globals['_PROCESSFIRSTQUERYRESPONSE']._serialized_start=1913
+  _globals['_PROCESSFIRSTQUERYRESPONSE']._serialized_end=1955
 # @@protoc_insertion_point(module_scope)
@@ -42,15 +42,37 @@ class LlmModel(_message.Message):
     systemPrompt: str
     def __init__(self, modelName: _Optional[str] = ..., temperature: _Optional[float] = ..., topK: _Optional[int] = ..., topP: _Optional[float] = ..., systemPrompt: _Optional[str] = ...) -> None: ...
 
+class SearchFilter(_message.Message):
+    __slots__ = ("types", "extensions", "urlPrefixes", "dateFrom", "dateTo", "languages", "documentPredicates", "chunkPredicates")
+    TYPES_FIELD_NUMBER: _ClassVar[int]
+    EXTENSIONS_FIELD_NUMBER: _ClassVar[int]
+    URLPREFIXES_FIELD_NUMBER: _ClassVar[int]
+    DATEFROM_FIELD_NUMBER: _ClassVar[int]
+    DATETO_FIELD_NUMBER: _ClassVar[int]
+    LANGUAGES_FIELD_NUMBER: _ClassVar[int]
+    DOCUMENTPREDICATES_FIELD_NUMBER: _ClassVar[int]
+    CHUNKPREDICATES_FIELD_NUMBER: _ClassVar[int]
+    types: _containers.RepeatedScalarFieldContainer[str]
+    extensions: _containers.RepeatedScalarFieldContainer[str]
+    urlPrefixes: _containers.RepeatedScalarFieldContainer[str]
+    dateFrom: _timestamp_pb2.Timestamp
+    dateTo: _timestamp_pb2.Timestamp
+    languages: _containers.RepeatedScalarFieldContainer[str]
+    documentPredicates: _containers.RepeatedScalarFieldContainer[str]
+    chunkPredicates: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, types: _Optional[_Iterable[str]] = ..., extensions: _Optional[_Iterable[str]] = ..., urlPrefixes: _Optional[_Iterable[str]] = ..., dateFrom: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., dateTo: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., languages: _Optional[_Iterable[str]] = ..., documentPredicates: _Optional[_Iterable[str]] = ..., chunkPredicates: _Optional[_Iterable[str]] = ...) -> None: ...
+
 class VectorSearch(_message.Message):
-    __slots__ = ("topN", "threshold", "searchByQuery")
+    __slots__ = ("topN", "threshold", "searchByQuery", "filter")
     TOPN_FIELD_NUMBER: _ClassVar[int]
     THRESHOLD_FIELD_NUMBER: _ClassVar[int]
     SEARCHBYQUERY_FIELD_NUMBER: _ClassVar[int]
+    FILTER_FIELD_NUMBER: _ClassVar[int]
     topN: int
     threshold: float
     searchByQuery: bool
-    def __init__(self, topN: _Optional[int] = ..., threshold: _Optional[float] = ..., searchByQuery: bool = ...) -> None: ...
+    filter: SearchFilter
+    def __init__(self, topN: _Optional[int] = ..., threshold: _Optional[float] = ..., searchByQuery: bool = ..., filter: _Optional[_Union[SearchFilter, _Mapping]] = ...) -> None: ...
 
 class Scenario(_message.Message):
     __slots__ = ("id", "multiQuery", "reranker", "vectorSearch", "model", "createdAt", "updatedAt", "title", "domainId", "contextSize")
@@ -102,13 +124,33 @@ class Chunk(_message.Message):
     content: str
     def __init__(self, content: _Optional[str] = ...) -> None: ...
 
+class Citation(_message.Message):
+    __slots__ = ("documentId", "documentName", "url", "chunkId", "snippet", "similarity", "page")
+    DOCUMENTID_FIELD_NUMBER: _ClassVar[int]
+    DOCUMENTNAME_FIELD_NUMBER: _ClassVar[int]
+    URL_FIELD_NUMBER: _ClassVar[int]
+    CHUNKID_FIELD_NUMBER: _ClassVar[int]
+    SNIPPET_FIELD_NUMBER: _ClassVar[int]
+    SIMILARITY_FIELD_NUMBER: _ClassVar[int]
+    PAGE_FIELD_NUMBER: _ClassVar[int]
+    documentId: str
+    documentName: str
+    url: str
+    chunkId: str
+    snippet: str
+    similarity: float
+    page: int
+    def __init__(self, documentId: _Optional[str] = ..., documentName: _Optional[str] = ..., url: _Optional[str] = ..., chunkId: _Optional[str] = ..., snippet: _Optional[str] = ..., similarity: _Optional[float] = ..., page: _Optional[int] = ...) -> None: ...
+
 class ProcessQueryResponse(_message.Message):
-    __slots__ = ("chunk", "sourceIds")
+    __slots__ = ("chunk", "sourceIds", "citations")
     CHUNK_FIELD_NUMBER: _ClassVar[int]
     SOURCEIDS_FIELD_NUMBER: _ClassVar[int]
+    CITATIONS_FIELD_NUMBER: _ClassVar[int]
     chunk: Chunk
     sourceIds: _containers.RepeatedScalarFieldContainer[str]
-    def __init__(self, chunk: _Optional[_Union[Chunk, _Mapping]] = ..., sourceIds: _Optional[_Iterable[str]] = ...) -> None: ...
+    citations: _containers.RepeatedCompositeFieldContainer[Citation]
+    def __init__(self, chunk: _Optional[_Union[Chunk, _Mapping]] = ..., sourceIds: _Optional[_Iterable[str]] = ..., citations: _Optional[_Iterable[_Union[Citation, _Mapping]]] = ...) -> None: ...
 
 class ModelParams(_message.Message):
     __slots__ = ("multiQuery", "reranker", "vectorSearch", "model")
@@ -25,7 +25,7 @@ from config import (
     OLLAMA_BASE_URL,
 )
 from data_client import AsyncDataServiceClient
-from RAG_pipeline import RAGPipeline
+from RAG_pipeline import RAGPipeline, chunk_contents
 from utils.logger import logger
 
 optuna.logging.enable_propagation()
@@ -163,7 +163,9 @@ class OptunaPipeline:
             request = self.build_request(entry, params)
             try:
 
-                chunks = await self.rag_pipeline._prepare_chunks(request)
+                chunks = chunk_contents(
+                    await self.rag_pipeline._prepare_chunks(request)
+                )
 
                 logger.debug(
                     "Threashold: %s", params["vectorSearch"]["threshold"]
@@ -18,11 +18,28 @@ from config import (
     OLLAMA_BASE_MODEL,
 )
 from optuna_pipline import OptunaPipeline
-from RAG_pipeline import RAGPipeline
+from RAG_pipeline import RAGPipeline, RetrievedChunk
 from sample_generate import generate_dataset
 from utils.logger import logger
 
 
+def to_citations(
+    chunks: list[RetrievedChunk] | None,
+) -> list[ml_pb2_model.Citation]:
+    """Преобразует чанки контекста в цитаты ответа."""
+    return [
+        ml_pb2_model.Citation(
+            documentId=chunk.document_id,
+            documentName=chunk.document_name,
+            url=chunk.url,
+            chunkId=chunk.chunk_id,
+            snippet=chunk.content,
+            similarity=chunk.similarity,
+        )
+        for chunk in chunks or []
+    ]
+
+
 class MLServiceServicer(ml_pb2_grpc.MLServiceServicer):
     def __init__(self) -> None:
         super().__init__()
@@ -57,8 +74,12 @@ class MLServiceServicer(ml_pb2_grpc.MLServiceServicer):
 
                 logger.debug(f"Sending chunk for request {request_id}")
                 yield response
-            logger.info(chunks)
-            yield ml_pb2_model.ProcessQueryResponse(sourceIds=chunks)
+            logger.info(
+                f"Sources for request {request_id}: {len(chunks or [])}"
+            )
+            yield ml_pb2_model.ProcessQueryResponse(
+                citations=to_citations(chunks)
+            )
         except grpc.RpcError as e:
             logger.error(
                 f"gRPC error processing request {request_id}:"